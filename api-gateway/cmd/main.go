@@ -63,7 +63,9 @@ func main() {
 	}
 
 	// Initialize handler
-	h := handler.NewHandler(grpcClients, authService, emailService, redisClient)
+	idempotencyStore := service.NewIdempotencyStore(redisClient, cfg.Idempotency.TTL)
+
+	h := handler.NewHandler(grpcClients, authService, emailService, redisClient, idempotencyStore)
 
 	// Set up router with middleware
 	router := gin.New()
@@ -4,6 +4,7 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -32,6 +33,10 @@ type Config struct {
 		Password string
 		DB       int
 	}
+	Idempotency struct {
+		// TTL is how long responses are kept for replay to retries
+		TTL time.Duration
+	}
 	Email struct {
 		From     string
 		Password string
@@ -70,6 +75,13 @@ func LoadConfig() *Config {
 	}
 	config.Redis.DB = redisDB
 
+	// Idempotency configuration
+	idempotencyHours, err := strconv.Atoi(getEnv("IDEMPOTENCY_TTL_HOURS", "24"))
+	if err != nil || idempotencyHours <= 0 {
+		idempotencyHours = 24
+	}
+	config.Idempotency.TTL = time.Duration(idempotencyHours) * time.Hour
+
 	// Email configuration
 	config.Email.From = getEnv("EMAIL_FROM", "bike-store@example.com")
 	config.Email.Password = getEnv("EMAIL_PASSWORD", "")
@@ -1,35 +1,47 @@
 package handler
 
 import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"log"
+	"math"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-redis/redis/v8"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"api-gateway/middleware"
 	"api-gateway/service"
 	inventorypb "proto/inventory"
+	moneypb "proto/money"
 	orderpb "proto/order"
 	userpb "proto/user"
 )
 
 type Handler struct {
-	grpcClients  *service.GrpcClients
-	authService  service.AuthService
-	emailService service.EmailService
-	redisClient  *redis.Client
+	grpcClients      *service.GrpcClients
+	authService      service.AuthService
+	emailService     service.EmailService
+	redisClient      *redis.Client
+	idempotencyStore service.IdempotencyStore
 }
 
 func NewHandler(grpcClients *service.GrpcClients, authService service.AuthService,
-	emailService service.EmailService, redisClient *redis.Client) *Handler {
+	emailService service.EmailService, redisClient *redis.Client, idempotencyStore service.IdempotencyStore) *Handler {
 	return &Handler{
-		grpcClients:  grpcClients,
-		authService:  authService,
-		emailService: emailService,
-		redisClient:  redisClient,
+		grpcClients:      grpcClients,
+		authService:      authService,
+		emailService:     emailService,
+		redisClient:      redisClient,
+		idempotencyStore: idempotencyStore,
 	}
 }
 
@@ -194,6 +206,13 @@ func (h *Handler) Login(c *gin.Context) {
 		// Continue with login even if we can't check verification status
 	}
 
+	// Bring along what the user put in the cart before signing in
+	if sessionID := c.GetHeader(CartSessionHeader); sessionID != "" {
+		if _, err := h.grpcClients.MergeCarts(ctx, sessionID, authResponse.UserId); err != nil {
+			log.Printf("Failed to merge guest cart into cart of user %s: %v", authResponse.UserId, err)
+		}
+	}
+
 	// Map proto role to string for response
 	var roleStr string
 	switch authResponse.Role {
@@ -253,6 +272,153 @@ func (h *Handler) GetUserProfile(c *gin.Context) {
 	})
 }
 
+// Address handlers
+
+// addressFields are the parts of an address needed to deliver to it, as sent for the address
+// book and for a one-off shipping address on an order
+type addressFields struct {
+	RecipientName string `json:"recipient_name" binding:"required"`
+	Line1         string `json:"line1" binding:"required"`
+	Line2         string `json:"line2"`
+	City          string `json:"city" binding:"required"`
+	Region        string `json:"region"`
+	PostalCode    string `json:"postal_code" binding:"required"`
+	// Two-letter ISO country code, e.g. "DE"
+	Country string `json:"country" binding:"required,len=2"`
+	Phone   string `json:"phone"`
+}
+
+func (a *addressFields) toProto() *orderpb.ShippingAddress {
+	if a == nil {
+		return nil
+	}
+	return &orderpb.ShippingAddress{
+		RecipientName: a.RecipientName,
+		Line1:         a.Line1,
+		Line2:         a.Line2,
+		City:          a.City,
+		Region:        a.Region,
+		PostalCode:    a.PostalCode,
+		Country:       a.Country,
+		Phone:         a.Phone,
+	}
+}
+
+type addressBody struct {
+	Label string `json:"label"`
+	addressFields
+	IsDefault bool `json:"is_default"`
+}
+
+func (b addressBody) toRequest(userID, addressID string) *userpb.AddressRequest {
+	return &userpb.AddressRequest{
+		Id:            addressID,
+		UserId:        userID,
+		Label:         b.Label,
+		RecipientName: b.RecipientName,
+		Line1:         b.Line1,
+		Line2:         b.Line2,
+		City:          b.City,
+		Region:        b.Region,
+		PostalCode:    b.PostalCode,
+		Country:       b.Country,
+		Phone:         b.Phone,
+		IsDefault:     b.IsDefault,
+	}
+}
+
+func (h *Handler) ListAddresses(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	resp, err := h.grpcClients.ListAddresses(c.Request.Context(), userID.(string))
+	if err != nil {
+		respondAddressError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"addresses": resp.Addresses})
+}
+
+func (h *Handler) AddAddress(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req addressBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	address, err := h.grpcClients.AddAddress(c.Request.Context(), req.toRequest(userID.(string), ""))
+	if err != nil {
+		respondAddressError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, address)
+}
+
+func (h *Handler) UpdateAddress(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req addressBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	address, err := h.grpcClients.UpdateAddress(c.Request.Context(), req.toRequest(userID.(string), c.Param("id")))
+	if err != nil {
+		respondAddressError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, address)
+}
+
+func (h *Handler) DeleteAddress(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	resp, err := h.grpcClients.DeleteAddress(c.Request.Context(), userID.(string), c.Param("id"))
+	if err != nil {
+		respondAddressError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": resp.Message})
+}
+
+func (h *Handler) SetDefaultAddress(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	address, err := h.grpcClients.SetDefaultAddress(c.Request.Context(), userID.(string), c.Param("id"))
+	if err != nil {
+		respondAddressError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, address)
+}
+
 // Product handlers
 
 func (h *Handler) CreateProduct(c *gin.Context) {
@@ -278,6 +444,7 @@ func (h *Handler) CreateProduct(c *gin.Context) {
 		Name:        req.Name,
 		Description: req.Description,
 		Price:       req.Price,
+		PriceMoney:  moneyFromFloat(req.Price, ""),
 		Stock:       req.Stock,
 		CategoryId:  req.CategoryID,
 	})
@@ -316,6 +483,7 @@ func (h *Handler) UpdateProduct(c *gin.Context) {
 		Name:        req.Name,
 		Description: req.Description,
 		Price:       req.Price,
+		PriceMoney:  moneyFromFloat(req.Price, ""),
 		Stock:       req.Stock,
 		CategoryId:  req.CategoryID,
 	})
@@ -331,6 +499,9 @@ func (h *Handler) UpdateProduct(c *gin.Context) {
 func (h *Handler) ListProducts(c *gin.Context) {
 	var filter inventorypb.ProductFilter
 
+	// Prices, and the price filters, are in the display currency
+	currency := c.Query("currency")
+
 	if categoryID := c.Query("category_id"); categoryID != "" {
 		filter.CategoryId = categoryID
 	}
@@ -338,12 +509,14 @@ func (h *Handler) ListProducts(c *gin.Context) {
 	if minPrice := c.Query("min_price"); minPrice != "" {
 		if minPriceFloat, err := strconv.ParseFloat(minPrice, 64); err == nil {
 			filter.MinPrice = minPriceFloat
+			filter.MinPriceMoney = moneyFromFloat(minPriceFloat, currency)
 		}
 	}
 
 	if maxPrice := c.Query("max_price"); maxPrice != "" {
 		if maxPriceFloat, err := strconv.ParseFloat(maxPrice, 64); err == nil {
 			filter.MaxPrice = maxPriceFloat
+			filter.MaxPriceMoney = moneyFromFloat(maxPriceFloat, currency)
 		}
 	}
 
@@ -357,8 +530,12 @@ func (h *Handler) ListProducts(c *gin.Context) {
 	filter.Page = int32(page)
 	filter.PageSize = int32(pageSize)
 
-	response, err := h.grpcClients.ListProducts(c.Request.Context(), &filter)
+	response, err := h.grpcClients.ListProducts(c.Request.Context(), &filter, currency)
 	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			c.JSON(http.StatusBadRequest, gin.H{"error": status.Convert(err).Message()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -369,8 +546,12 @@ func (h *Handler) ListProducts(c *gin.Context) {
 func (h *Handler) GetProduct(c *gin.Context) {
 	id := c.Param("id")
 
-	product, err := h.grpcClients.GetProduct(c.Request.Context(), id)
+	product, err := h.grpcClients.GetProduct(c.Request.Context(), id, c.Query("currency"))
 	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			c.JSON(http.StatusBadRequest, gin.H{"error": status.Convert(err).Message()})
+			return
+		}
 		c.JSON(http.StatusNotFound, gin.H{"error": "Product not found"})
 		return
 	}
@@ -492,17 +673,25 @@ func (h *Handler) CreateOrder(c *gin.Context) {
 		return
 	}
 
+	// Name and bike attributes are taken from the catalogue; a price, if sent,
+	// is only checked against the current catalogue price
 	var req struct {
 		Items []struct {
 			ProductID string  `json:"product_id" binding:"required"`
-			Name      string  `json:"name" binding:"required"`
-			Price     float64 `json:"price" binding:"required,gt=0"`
+			Price     float64 `json:"price" binding:"gte=0"`
 			Quantity  int32   `json:"quantity" binding:"required,gt=0"`
-			FrameSize string  `json:"frame_size"`
-			WheelSize string  `json:"wheel_size"`
-			Color     string  `json:"color"`
-			BikeType  string  `json:"bike_type"`
 		} `json:"items" binding:"required,dive"`
+		CouponCode string `json:"coupon_code"`
+		// Region whose tax rate applies, e.g. "DE" or "US-CA"
+		TaxRegion string `json:"tax_region"`
+		// Currency to pay in, e.g. "EUR"; item prices are in this currency too
+		Currency string `json:"currency"`
+		// Address book entry to ship to, or a one-off shipping address; with neither,
+		// the order ships to the default address
+		AddressID       string         `json:"address_id"`
+		ShippingAddress *addressFields `json:"shipping_address"`
+		// Collect the order in store instead; pickup orders take no address
+		Pickup *pickupRequest `json:"pickup"`
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -513,18 +702,39 @@ func (h *Handler) CreateOrder(c *gin.Context) {
 	var orderItems []*orderpb.OrderItemRequest
 	for _, item := range req.Items {
 		orderItems = append(orderItems, &orderpb.OrderItemRequest{
-			ProductId: item.ProductID,
-			Name:      item.Name,
-			Price:     item.Price,
-			Quantity:  item.Quantity,
+			ProductId:  item.ProductID,
+			Price:      item.Price,
+			PriceMoney: moneyFromFloat(item.Price, req.Currency),
+			Quantity:   item.Quantity,
 		})
 	}
 
-	// Check stock availability
+	order, ok := h.placeOrder(c, &orderpb.CreateOrderRequest{
+		UserId:          userID.(string),
+		Items:           orderItems,
+		CouponCode:      req.CouponCode,
+		TaxRegion:       req.TaxRegion,
+		Currency:        req.Currency,
+		IdempotencyKey:  c.GetHeader(middleware.IdempotencyKeyHeader),
+		AddressId:       req.AddressID,
+		ShippingAddress: req.ShippingAddress.toProto(),
+		Pickup:          req.Pickup.toProto(),
+	})
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusCreated, order)
+}
+
+// placeOrder checks that the items of req are in stock, creates the order and emails the
+// confirmation. It responds to the client itself when it fails, returning false.
+func (h *Handler) placeOrder(c *gin.Context, req *orderpb.CreateOrderRequest) (*orderpb.OrderResponse, bool) {
+	// Check available stock up front; the order itself holds the stock
 	var productQuantities []*inventorypb.ProductQuantity
 	for _, item := range req.Items {
 		productQuantities = append(productQuantities, &inventorypb.ProductQuantity{
-			ProductId: item.ProductID,
+			ProductId: item.ProductId,
 			Quantity:  item.Quantity,
 		})
 	}
@@ -532,7 +742,7 @@ func (h *Handler) CreateOrder(c *gin.Context) {
 	stockCheck, err := h.grpcClients.CheckStock(c.Request.Context(), productQuantities)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check stock: " + err.Error()})
-		return
+		return nil, false
 	}
 
 	if !stockCheck.Available {
@@ -540,40 +750,59 @@ func (h *Handler) CreateOrder(c *gin.Context) {
 			"error":             "Some bicycles are out of stock",
 			"unavailable_items": stockCheck.UnavailableItems,
 		})
-		return
+		return nil, false
 	}
 
-	order, err := h.grpcClients.CreateOrder(c.Request.Context(), &orderpb.CreateOrderRequest{
-		UserId: userID.(string),
-		Items:  orderItems,
-	})
+	order, err := h.grpcClients.CreateOrder(c.Request.Context(), req)
 
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, gin.H{"error": status.Convert(err).Message()})
+		case codes.FailedPrecondition:
+			// Someone else reserved the stock between the check above and the order
+			c.JSON(http.StatusConflict, gin.H{"error": status.Convert(err).Message()})
+		case codes.AlreadyExists:
+			// The idempotency key belongs to a different order, as the middleware answers
+			// when it knows the key
+			c.JSON(http.StatusUnprocessableEntity, gin.H{"error": status.Convert(err).Message()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return nil, false
 	}
 
 	// Get user details for the email
-	userProfile, err := h.grpcClients.GetUserProfile(c.Request.Context(), userID.(string))
+	userProfile, err := h.grpcClients.GetUserProfile(c.Request.Context(), req.UserId)
 	if err != nil {
 		log.Printf("Failed to get user profile for email notification: %v", err)
 	} else {
 		// Prepare order details for email
 		orderDetails := map[string]interface{}{
-			"Items": make([]map[string]interface{}, 0, len(order.Items)),
-			"Total": order.Total,
+			"Items":      make([]map[string]interface{}, 0, len(order.Items)),
+			"Subtotal":   formatPrice(order.SubtotalMoney),
+			"CouponCode": order.CouponCode,
+			"Discount":   formatPrice(order.DiscountMoney),
+			"Tax":        formatPrice(order.TaxMoney),
+			"TaxRegion":  order.TaxRegion,
+			"Shipping":   formatPrice(order.ShippingMoney),
+			"Total":      formatPrice(order.TotalMoney),
+		}
+		// Leave the discount row out of the email when there is none
+		if order.GetDiscountMoney().GetAmount() == 0 {
+			orderDetails["Discount"] = nil
 		}
 
 		for _, item := range order.Items {
 			orderDetails["Items"] = append(orderDetails["Items"].([]map[string]interface{}), map[string]interface{}{
 				"Name":      item.Name,
-				"Price":     item.Price,
+				"Price":     formatPrice(item.PriceMoney),
 				"Quantity":  item.Quantity,
-				"Subtotal":  item.Price * float64(item.Quantity),
-				"FrameSize": req.Items[0].FrameSize,
-				"WheelSize": req.Items[0].WheelSize,
-				"Color":     req.Items[0].Color,
-				"BikeType":  req.Items[0].BikeType,
+				"Subtotal":  formatPrice(multiplyMoney(item.PriceMoney, item.Quantity)),
+				"FrameSize": item.FrameSize,
+				"WheelSize": item.WheelSize,
+				"Color":     item.Color,
+				"BikeType":  item.BikeType,
 			})
 		}
 
@@ -585,7 +814,7 @@ func (h *Handler) CreateOrder(c *gin.Context) {
 		}
 	}
 
-	c.JSON(http.StatusCreated, order)
+	return order, true
 }
 
 func (h *Handler) GetOrder(c *gin.Context) {
@@ -637,6 +866,7 @@ func (h *Handler) UpdateOrderStatus(c *gin.Context) {
 
 	var req struct {
 		Status string `json:"status" binding:"required"`
+		Reason string `json:"reason"`
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -650,6 +880,10 @@ func (h *Handler) UpdateOrderStatus(c *gin.Context) {
 		status = orderpb.OrderStatus_PENDING
 	case "paid":
 		status = orderpb.OrderStatus_PAID
+	case "ready_for_pickup":
+		// Only the store knows when an order is waiting there
+		c.JSON(http.StatusForbidden, gin.H{"error": "Orders are marked ready for pickup by the store"})
+		return
 	case "shipped":
 		status = orderpb.OrderStatus_SHIPPED
 	case "delivered":
@@ -664,93 +898,106 @@ func (h *Handler) UpdateOrderStatus(c *gin.Context) {
 	updatedOrder, err := h.grpcClients.UpdateOrderStatus(c.Request.Context(), &orderpb.UpdateOrderStatusRequest{
 		Id:     id,
 		Status: status,
+		Actor:  actorFromContext(c),
+		Reason: req.Reason,
 	})
 
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondStateError(c, err)
 		return
 	}
 
 	c.JSON(http.StatusOK, updatedOrder)
 }
 
-func (h *Handler) ListUserOrders(c *gin.Context) {
+func (h *Handler) GetOrderHistory(c *gin.Context) {
 	userID, exists := c.Get("user_id")
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
 		return
 	}
 
-	response, err := h.grpcClients.GetUserOrders(c.Request.Context(), userID.(string))
+	id := c.Param("id")
+
+	order, err := h.grpcClients.GetOrder(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.JSON(http.StatusNotFound, gin.H{"error": "Order not found"})
 		return
 	}
 
-	c.JSON(http.StatusOK, response)
-}
-
-// Admin-only handlers
+	// Ensure the order belongs to the authenticated user (unless admin)
+	userRole, _ := c.Get("user_role")
+	if userRole != service.UserRoleAdmin && order.UserId != userID.(string) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Access denied"})
+		return
+	}
 
-// ListAllOrders - Admin only: List
-func (h *Handler) ListAllOrders(c *gin.Context) {
-	// Parse query parameters for filtering
-	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
-	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
-	status := c.Query("status")
-	userID := c.Query("user_id")
+	history, err := h.grpcClients.GetOrderHistory(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
 
-	var filter orderpb.OrderFilter
-	filter.Page = int32(page)
-	filter.PageSize = int32(pageSize)
+	c.JSON(http.StatusOK, history)
+}
 
-	if userID != "" {
-		filter.UserId = userID
+// UpdateOrderItems replaces the items of an order that has not been paid yet. The order is
+// repriced and its stock held again for the new quantities.
+func (h *Handler) UpdateOrderItems(c *gin.Context) {
+	order, ok := h.callerOrder(c)
+	if !ok {
+		return
 	}
 
-	if status != "" {
-		switch status {
-		case "pending":
-			filter.Status = orderpb.OrderStatus_PENDING
-		case "paid":
-			filter.Status = orderpb.OrderStatus_PAID
-		case "shipped":
-			filter.Status = orderpb.OrderStatus_SHIPPED
-		case "delivered":
-			filter.Status = orderpb.OrderStatus_DELIVERED
-		case "cancelled":
-			filter.Status = orderpb.OrderStatus_CANCELLED
-		}
+	// As when ordering, a price, if sent, is only checked against the current catalogue price
+	var req struct {
+		Items []struct {
+			ProductID string  `json:"product_id" binding:"required"`
+			Price     float64 `json:"price" binding:"gte=0"`
+			Quantity  int32   `json:"quantity" binding:"required,gt=0"`
+		} `json:"items" binding:"required,min=1,dive"`
 	}
 
-	response, err := h.grpcClients.ListOrders(c.Request.Context(), &filter)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
 
-	c.JSON(http.StatusOK, response)
-}
-
-// GetAnyOrder - Admin only: Get any order by ID
-func (h *Handler) GetAnyOrder(c *gin.Context) {
-	id := c.Param("id")
+	updateReq := &orderpb.UpdateOrderItemsRequest{
+		Id:    order.Id,
+		Actor: actorFromContext(c),
+	}
+	for _, item := range req.Items {
+		updateReq.Items = append(updateReq.Items, &orderpb.OrderItemRequest{
+			ProductId:  item.ProductID,
+			Price:      item.Price,
+			PriceMoney: moneyFromFloat(item.Price, order.Currency),
+			Quantity:   item.Quantity,
+		})
+	}
 
-	order, err := h.grpcClients.GetOrder(c.Request.Context(), id)
+	updatedOrder, err := h.grpcClients.UpdateOrderItems(c.Request.Context(), updateReq)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Order not found"})
+		respondOrderItemsError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, order)
+	c.JSON(http.StatusOK, updatedOrder)
 }
 
-// AdminUpdateOrderStatus - Admin only: Update any order's status
-func (h *Handler) AdminUpdateOrderStatus(c *gin.Context) {
-	id := c.Param("id")
+// RequestReturn asks to send back some of a delivered order's items
+func (h *Handler) RequestReturn(c *gin.Context) {
+	order, ok := h.callerOrder(c)
+	if !ok {
+		return
+	}
 
 	var req struct {
-		Status string `json:"status" binding:"required"`
+		Reason string `json:"reason" binding:"required"`
+		Items  []struct {
+			OrderItemID string `json:"order_item_id" binding:"required"`
+			Quantity    int32  `json:"quantity" binding:"required,min=1"`
+		} `json:"items" binding:"required,min=1"`
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -758,89 +1005,1318 @@ func (h *Handler) AdminUpdateOrderStatus(c *gin.Context) {
 		return
 	}
 
-	var status orderpb.OrderStatus
-	switch req.Status {
-	case "pending":
-		status = orderpb.OrderStatus_PENDING
-	case "paid":
-		status = orderpb.OrderStatus_PAID
-	case "shipped":
-		status = orderpb.OrderStatus_SHIPPED
-	case "delivered":
-		status = orderpb.OrderStatus_DELIVERED
-	case "cancelled":
-		status = orderpb.OrderStatus_CANCELLED
-	default:
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid status"})
+	returnReq := &orderpb.RequestReturnRequest{
+		OrderId: order.Id,
+		Reason:  req.Reason,
+	}
+	for _, item := range req.Items {
+		returnReq.Items = append(returnReq.Items, &orderpb.ReturnItem{
+			OrderItemId: item.OrderItemID,
+			Quantity:    item.Quantity,
+		})
+	}
+
+	ret, err := h.grpcClients.RequestReturn(c.Request.Context(), returnReq)
+	if err != nil {
+		respondReturnError(c, err)
 		return
 	}
 
-	updatedOrder, err := h.grpcClients.UpdateOrderStatus(c.Request.Context(), &orderpb.UpdateOrderStatusRequest{
-		Id:     id,
-		Status: status,
-	})
+	c.JSON(http.StatusCreated, ret)
+}
+
+// GetOrderReturns lists the returns requested for an order
+func (h *Handler) GetOrderReturns(c *gin.Context) {
+	order, ok := h.callerOrder(c)
+	if !ok {
+		return
+	}
 
+	returns, err := h.grpcClients.GetOrderReturns(c.Request.Context(), order.Id)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	c.JSON(http.StatusOK, updatedOrder)
+	c.JSON(http.StatusOK, returns)
 }
 
-func RegisterRoutes(router *gin.Engine, h *Handler) {
-	// Public routes (no authentication required)
-	auth := router.Group("/api/v1/auth")
-	{
-		auth.POST("/register", h.RegisterUser)
-		auth.POST("/login", h.Login)
+// GetOrderReturn gets one of an order's returns
+func (h *Handler) GetOrderReturn(c *gin.Context) {
+	order, ok := h.callerOrder(c)
+	if !ok {
+		return
 	}
 
-	// Public product and category routes (read-only)
-	publicAPI := router.Group("/api/v1")
-	{
-		// Public product routes
-		publicAPI.GET("/products", h.ListProducts)
-		publicAPI.GET("/products/:id", h.GetProduct)
+	ret, err := h.grpcClients.GetReturn(c.Request.Context(), c.Param("return_id"))
+	if err != nil || ret.OrderId != order.Id {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Return not found"})
+		return
+	}
 
-		// Public category routes
-		publicAPI.GET("/categories", h.ListCategories)
-		publicAPI.GET("/categories/:id", h.GetCategory)
+	c.JSON(http.StatusOK, ret)
+}
+
+func (h *Handler) ListUserOrders(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
 	}
 
-	// Protected routes - require authentication
-	api := router.Group("/api/v1")
-	api.Use(service.AuthMiddleware(h.authService))
-	{
-		// User profile routes
-		api.GET("/users/profile", h.GetUserProfile)
-		api.POST("/users/verify-email", h.VerifyEmailCode)
-		api.POST("/users/resend-verification", h.ResendVerificationCode)
+	filter, ok := orderFilterFromQuery(c)
+	if !ok {
+		return
+	}
 
-		// Protected product routes (admin only)
-		products := api.Group("/products")
-		{
-			products.POST("", middleware.RequireAdmin(), h.CreateProduct)
-			products.PUT("/:id", middleware.RequireAdmin(), h.UpdateProduct)
-			products.DELETE("/:id", middleware.RequireAdmin(), h.DeleteProduct)
+	response, err := h.grpcClients.GetUserOrders(c.Request.Context(), userID.(string), filter)
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			c.JSON(http.StatusBadRequest, gin.H{"error": status.Convert(err).Message()})
+			return
 		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
 
-		// Protected category routes (admin only)
-		categories := api.Group("/categories")
-		{
-			categories.POST("", middleware.RequireAdmin(), h.CreateCategory)
-			categories.PUT("/:id", middleware.RequireAdmin(), h.UpdateCategory)
-			categories.DELETE("/:id", middleware.RequireAdmin(), h.DeleteCategory)
+	c.JSON(http.StatusOK, response)
+}
+
+// Cart handlers
+
+// CartSessionHeader carries the session a guest cart belongs to. The gateway starts a session
+// for guests that do not send one and returns it in the same header.
+const CartSessionHeader = "X-Session-ID"
+
+const maxCartSessionIDLength = 128
+
+// cartOwner is the authenticated user, or else the guest session of the request.
+func cartOwner(c *gin.Context) (*orderpb.CartOwner, bool) {
+	if userID, exists := c.Get("user_id"); exists {
+		return &orderpb.CartOwner{UserId: userID.(string)}, true
+	}
+
+	sessionID := c.GetHeader(CartSessionHeader)
+	if len(sessionID) > maxCartSessionIDLength {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s must be at most %d characters", CartSessionHeader, maxCartSessionIDLength)})
+		return nil, false
+	}
+	if sessionID == "" {
+		data := make([]byte, 16)
+		if _, err := rand.Read(data); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to start a cart session"})
+			return nil, false
 		}
+		sessionID = hex.EncodeToString(data)
+	}
+	c.Header(CartSessionHeader, sessionID)
 
-		// Order routes (user must be authenticated)
-		orders := api.Group("/orders")
+	return &orderpb.CartOwner{SessionId: sessionID}, true
+}
+
+func respondCart(c *gin.Context, cart *orderpb.CartResponse, err error) {
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, gin.H{"error": status.Convert(err).Message()})
+		case codes.NotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": status.Convert(err).Message()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, cart)
+}
+
+func (h *Handler) GetCart(c *gin.Context) {
+	owner, ok := cartOwner(c)
+	if !ok {
+		return
+	}
+
+	cart, err := h.grpcClients.GetCart(c.Request.Context(), owner, c.Query("currency"))
+	respondCart(c, cart, err)
+}
+
+func (h *Handler) AddCartItem(c *gin.Context) {
+	owner, ok := cartOwner(c)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		ProductID string `json:"product_id" binding:"required"`
+		Quantity  int32  `json:"quantity" binding:"required,gt=0"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cart, err := h.grpcClients.AddCartItem(c.Request.Context(), owner, req.ProductID, req.Quantity, c.Query("currency"))
+	respondCart(c, cart, err)
+}
+
+func (h *Handler) UpdateCartItem(c *gin.Context) {
+	owner, ok := cartOwner(c)
+	if !ok {
+		return
+	}
+
+	// A quantity of zero removes the item
+	var req struct {
+		Quantity *int32 `json:"quantity" binding:"required,gte=0"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cart, err := h.grpcClients.UpdateCartItem(c.Request.Context(), owner, c.Param("product_id"), *req.Quantity, c.Query("currency"))
+	respondCart(c, cart, err)
+}
+
+func (h *Handler) RemoveCartItem(c *gin.Context) {
+	owner, ok := cartOwner(c)
+	if !ok {
+		return
+	}
+
+	cart, err := h.grpcClients.RemoveCartItem(c.Request.Context(), owner, c.Param("product_id"), c.Query("currency"))
+	respondCart(c, cart, err)
+}
+
+func (h *Handler) ClearCart(c *gin.Context) {
+	owner, ok := cartOwner(c)
+	if !ok {
+		return
+	}
+
+	cart, err := h.grpcClients.ClearCart(c.Request.Context(), owner, c.Query("currency"))
+	respondCart(c, cart, err)
+}
+
+// CheckoutCart turns the signed-in user's cart into an order, priced from the catalogue
+// as it is now, and empties the cart.
+func (h *Handler) CheckoutCart(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req struct {
+		CouponCode string `json:"coupon_code"`
+		// Region whose tax rate applies, e.g. "DE" or "US-CA"
+		TaxRegion string `json:"tax_region"`
+		// Currency to pay in, e.g. "EUR"
+		Currency string `json:"currency"`
+		// Address book entry to ship to, or a one-off shipping address; with neither,
+		// the order ships to the default address
+		AddressID       string         `json:"address_id"`
+		ShippingAddress *addressFields `json:"shipping_address"`
+		// Collect the order in store instead; pickup orders take no address
+		Pickup *pickupRequest `json:"pickup"`
+	}
+
+	// The body is optional
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	owner := &orderpb.CartOwner{UserId: userID.(string)}
+
+	cart, err := h.grpcClients.GetCart(c.Request.Context(), owner, req.Currency)
+	if err != nil {
+		respondCart(c, nil, err)
+		return
+	}
+
+	if len(cart.Items) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Cart is empty"})
+		return
+	}
+
+	// Items carry no price, so the order service prices them from the catalogue
+	var orderItems []*orderpb.OrderItemRequest
+	for _, item := range cart.Items {
+		orderItems = append(orderItems, &orderpb.OrderItemRequest{
+			ProductId: item.ProductId,
+			Quantity:  item.Quantity,
+		})
+	}
+
+	order, ok := h.placeOrder(c, &orderpb.CreateOrderRequest{
+		UserId:          userID.(string),
+		Items:           orderItems,
+		CouponCode:      req.CouponCode,
+		TaxRegion:       req.TaxRegion,
+		Currency:        req.Currency,
+		IdempotencyKey:  c.GetHeader(middleware.IdempotencyKeyHeader),
+		AddressId:       req.AddressID,
+		ShippingAddress: req.ShippingAddress.toProto(),
+		Pickup:          req.Pickup.toProto(),
+	})
+	if !ok {
+		return
+	}
+
+	if _, err := h.grpcClients.ClearCart(c.Request.Context(), owner, ""); err != nil {
+		log.Printf("Failed to clear cart of user %s after checkout: %v", userID, err)
+	}
+
+	c.JSON(http.StatusCreated, order)
+}
+
+// Pickup handlers
+
+// pickupRequest picks the store and day an order is collected on
+type pickupRequest struct {
+	StoreID string `json:"store_id" binding:"required"`
+	// Day to collect the order, e.g. "2024-05-31"
+	Date string `json:"date" binding:"required"`
+}
+
+func (p *pickupRequest) toProto() *orderpb.Pickup {
+	if p == nil {
+		return nil
+	}
+	return &orderpb.Pickup{
+		StoreId: p.StoreID,
+		Date:    p.Date,
+	}
+}
+
+// ListStores lists the stores orders can be collected from
+func (h *Handler) ListStores(c *gin.Context) {
+	resp, err := h.grpcClients.ListStores(c.Request.Context(), false)
+	if err != nil {
+		respondPickupError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"stores": resp.Stores})
+}
+
+// GetPickupSlots lists the days an order can be collected from a store and the room left on
+// each. The optional from (a date) and days query parameters pick the days to list.
+func (h *Handler) GetPickupSlots(c *gin.Context) {
+	var days int
+	if value := c.Query("days"); value != "" {
+		var err error
+		if days, err = strconv.Atoi(value); err != nil || days <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "days must be a positive number"})
+			return
+		}
+	}
+
+	resp, err := h.grpcClients.GetPickupSlots(c.Request.Context(), c.Param("id"), c.Query("from"), int32(days))
+	if err != nil {
+		respondPickupError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Payment handlers
+
+func (h *Handler) CreatePayment(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	id := c.Param("id")
+
+	order, err := h.grpcClients.GetOrder(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Order not found"})
+		return
+	}
+
+	userRole, _ := c.Get("user_role")
+	if userRole != service.UserRoleAdmin && order.UserId != userID.(string) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Access denied"})
+		return
+	}
+
+	var req struct {
+		Method string `json:"method" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	payment, err := h.grpcClients.CreatePayment(c.Request.Context(), id, req.Method, actorFromContext(c))
+	if err != nil {
+		if status.Code(err) == codes.FailedPrecondition {
+			c.JSON(http.StatusPaymentRequired, gin.H{"error": status.Convert(err).Message()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, payment)
+}
+
+func (h *Handler) GetPayment(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	id := c.Param("id")
+
+	payment, err := h.grpcClients.GetPayment(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Payment not found"})
+		return
+	}
+
+	// Ensure the paid order belongs to the authenticated user (unless admin)
+	userRole, _ := c.Get("user_role")
+	if userRole != service.UserRoleAdmin {
+		order, err := h.grpcClients.GetOrder(c.Request.Context(), payment.OrderId)
+		if err != nil || order.UserId != userID.(string) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Access denied"})
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, payment)
+}
+
+// Admin-only handlers
+
+// ListAllOrders - Admin only: List
+func (h *Handler) ListAllOrders(c *gin.Context) {
+	// Parse query parameters for filtering
+	filter, ok := orderFilterFromQuery(c)
+	if !ok {
+		return
+	}
+	filter.UserId = c.Query("user_id")
+
+	response, err := h.grpcClients.ListOrders(c.Request.Context(), filter)
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			c.JSON(http.StatusBadRequest, gin.H{"error": status.Convert(err).Message()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetAnyOrder - Admin only: Get any order by ID
+func (h *Handler) GetAnyOrder(c *gin.Context) {
+	id := c.Param("id")
+
+	order, err := h.grpcClients.GetOrder(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Order not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, order)
+}
+
+// AdminUpdateOrderStatus - Admin only: Update any order's status
+func (h *Handler) AdminUpdateOrderStatus(c *gin.Context) {
+	id := c.Param("id")
+
+	var req struct {
+		Status string `json:"status" binding:"required"`
+		Reason string `json:"reason"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var status orderpb.OrderStatus
+	switch req.Status {
+	case "pending":
+		status = orderpb.OrderStatus_PENDING
+	case "paid":
+		status = orderpb.OrderStatus_PAID
+	case "ready_for_pickup":
+		status = orderpb.OrderStatus_READY_FOR_PICKUP
+	case "shipped":
+		status = orderpb.OrderStatus_SHIPPED
+	case "delivered":
+		status = orderpb.OrderStatus_DELIVERED
+	case "cancelled":
+		status = orderpb.OrderStatus_CANCELLED
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid status"})
+		return
+	}
+
+	updatedOrder, err := h.grpcClients.UpdateOrderStatus(c.Request.Context(), &orderpb.UpdateOrderStatusRequest{
+		Id:     id,
+		Status: status,
+		Actor:  actorFromContext(c),
+		Reason: req.Reason,
+	})
+
+	if err != nil {
+		respondStateError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, updatedOrder)
+}
+
+// CreateShipment - Admin only: Ship some or all of a paid order's items
+func (h *Handler) CreateShipment(c *gin.Context) {
+	id := c.Param("id")
+
+	var req struct {
+		Carrier        string     `json:"carrier" binding:"required"`
+		TrackingNumber string     `json:"tracking_number" binding:"required"`
+		ShippedAt      *time.Time `json:"shipped_at"`
+		Items          []struct {
+			OrderItemID string `json:"order_item_id" binding:"required"`
+			Quantity    int32  `json:"quantity" binding:"required,min=1"`
+		} `json:"items"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	shipmentReq := &orderpb.CreateShipmentRequest{
+		OrderId:        id,
+		Carrier:        req.Carrier,
+		TrackingNumber: req.TrackingNumber,
+		Actor:          actorFromContext(c),
+	}
+	if req.ShippedAt != nil {
+		shipmentReq.ShippedAt = timestamppb.New(*req.ShippedAt)
+	}
+	for _, item := range req.Items {
+		shipmentReq.Items = append(shipmentReq.Items, &orderpb.ShipmentItem{
+			OrderItemId: item.OrderItemID,
+			Quantity:    item.Quantity,
+		})
+	}
+
+	shipment, err := h.grpcClients.CreateShipment(c.Request.Context(), shipmentReq)
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, gin.H{"error": status.Convert(err).Message()})
+		case codes.FailedPrecondition, codes.AlreadyExists:
+			c.JSON(http.StatusConflict, gin.H{"error": status.Convert(err).Message()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, shipment)
+}
+
+// GetShipments - Admin only: List an order's shipments
+func (h *Handler) GetShipments(c *gin.Context) {
+	id := c.Param("id")
+
+	shipments, err := h.grpcClients.GetShipments(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Order not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, shipments)
+}
+
+// ListReturns - Admin only: List returns, optionally by status
+func (h *Handler) ListReturns(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+
+	returns, err := h.grpcClients.ListReturns(c.Request.Context(), &orderpb.ListReturnsRequest{
+		Status:   c.Query("status"),
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+	})
+	if err != nil {
+		respondReturnError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, returns)
+}
+
+// GetAnyReturn - Admin only: Get any return by ID
+func (h *Handler) GetAnyReturn(c *gin.Context) {
+	ret, err := h.grpcClients.GetReturn(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Return not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, ret)
+}
+
+// ApproveReturn - Admin only: Accept a requested return
+func (h *Handler) ApproveReturn(c *gin.Context) {
+	h.resolveReturn(c, h.grpcClients.ApproveReturn)
+}
+
+// RejectReturn - Admin only: Turn down a requested return, with a note for the customer
+func (h *Handler) RejectReturn(c *gin.Context) {
+	h.resolveReturn(c, h.grpcClients.RejectReturn)
+}
+
+func (h *Handler) resolveReturn(c *gin.Context, resolve func(ctx context.Context, returnID, note string) (*orderpb.ReturnResponse, error)) {
+	// The body is optional
+	var req struct {
+		Note string `json:"note"`
+	}
+
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	ret, err := resolve(c.Request.Context(), c.Param("id"), req.Note)
+	if err != nil {
+		respondReturnError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ret)
+}
+
+// ReceiveReturn - Admin only: Record that a return's items arrived, restocking them if resellable
+func (h *Handler) ReceiveReturn(c *gin.Context) {
+	var req struct {
+		Restock bool `json:"restock"`
+	}
+
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	ret, err := h.grpcClients.ReceiveReturn(c.Request.Context(), c.Param("id"), req.Restock)
+	if err != nil {
+		respondReturnError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ret)
+}
+
+// RefundReturn - Admin only: Refund a received return against the order's payment
+func (h *Handler) RefundReturn(c *gin.Context) {
+	// Without an amount the full value of the returned items is refunded
+	var req struct {
+		Amount float64 `json:"amount" binding:"min=0"`
+	}
+
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	ret, err := h.grpcClients.RefundReturn(c.Request.Context(), c.Param("id"), moneyFromFloat(req.Amount, ""))
+	if err != nil {
+		respondReturnError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ret)
+}
+
+// couponRequest is the body for creating or replacing a coupon. Zero limits mean unlimited.
+type couponRequest struct {
+	Code           string     `json:"code" binding:"required"`
+	Description    string     `json:"description"`
+	DiscountType   string     `json:"discount_type" binding:"required,oneof=percentage fixed"`
+	DiscountValue  float64    `json:"discount_value" binding:"required,gt=0"`
+	CategoryID     string     `json:"category_id"`
+	BikeType       string     `json:"bike_type"`
+	MinOrderValue  float64    `json:"min_order_value" binding:"gte=0"`
+	StartsAt       *time.Time `json:"starts_at"`
+	EndsAt         *time.Time `json:"ends_at"`
+	MaxUses        int32      `json:"max_uses" binding:"gte=0"`
+	MaxUsesPerUser int32      `json:"max_uses_per_user" binding:"gte=0"`
+	// Coupons are active unless switched off
+	Active *bool `json:"active"`
+}
+
+func (r couponRequest) toProto(id string) *orderpb.CouponRequest {
+	coupon := &orderpb.CouponRequest{
+		Id:                 id,
+		Code:               r.Code,
+		Description:        r.Description,
+		DiscountType:       r.DiscountType,
+		DiscountValue:      r.DiscountValue,
+		CategoryId:         r.CategoryID,
+		BikeType:           r.BikeType,
+		MinOrderValue:      r.MinOrderValue,
+		MinOrderValueMoney: moneyFromFloat(r.MinOrderValue, ""),
+		MaxUses:            r.MaxUses,
+		MaxUsesPerUser:     r.MaxUsesPerUser,
+		Active:             r.Active == nil || *r.Active,
+	}
+
+	if r.DiscountType == "fixed" {
+		coupon.DiscountAmount = moneyFromFloat(r.DiscountValue, "")
+	}
+
+	if r.StartsAt != nil {
+		coupon.StartsAt = timestamppb.New(*r.StartsAt)
+	}
+	if r.EndsAt != nil {
+		coupon.EndsAt = timestamppb.New(*r.EndsAt)
+	}
+
+	return coupon
+}
+
+// CreateCoupon - Admin only: Create a promotion code
+func (h *Handler) CreateCoupon(c *gin.Context) {
+	var req couponRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	coupon, err := h.grpcClients.CreateCoupon(c.Request.Context(), req.toProto(""))
+	if err != nil {
+		respondCouponError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, coupon)
+}
+
+// ListCoupons - Admin only: List all promotion codes
+func (h *Handler) ListCoupons(c *gin.Context) {
+	coupons, err := h.grpcClients.ListCoupons(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, coupons)
+}
+
+// GetCoupon - Admin only: Get a promotion code with its usage
+func (h *Handler) GetCoupon(c *gin.Context) {
+	coupon, err := h.grpcClients.GetCoupon(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		respondCouponError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, coupon)
+}
+
+// UpdateCoupon - Admin only: Replace a promotion code's settings
+func (h *Handler) UpdateCoupon(c *gin.Context) {
+	var req couponRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	coupon, err := h.grpcClients.UpdateCoupon(c.Request.Context(), req.toProto(c.Param("id")))
+	if err != nil {
+		respondCouponError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, coupon)
+}
+
+// DeleteCoupon - Admin only: Delete a promotion code
+func (h *Handler) DeleteCoupon(c *gin.Context) {
+	response, err := h.grpcClients.DeleteCoupon(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		respondCouponError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": response.Success,
+		"message": response.Message,
+	})
+}
+
+// storeRequest is the body for creating or replacing a pickup store
+type storeRequest struct {
+	Name    string `json:"name" binding:"required"`
+	Address string `json:"address" binding:"required"`
+	// Orders that can be picked up at the store per day
+	DailyCapacity int32 `json:"daily_capacity" binding:"required,gt=0"`
+	// Stores stop taking pickups once inactive; defaults to true
+	Active *bool `json:"active"`
+}
+
+func (r storeRequest) toProto(id string) *orderpb.StoreRequest {
+	active := true
+	if r.Active != nil {
+		active = *r.Active
+	}
+	return &orderpb.StoreRequest{
+		Id:            id,
+		Name:          r.Name,
+		Address:       r.Address,
+		DailyCapacity: r.DailyCapacity,
+		Active:        active,
+	}
+}
+
+// CreateStore - Admin only: Add a store orders can be collected from
+func (h *Handler) CreateStore(c *gin.Context) {
+	var req storeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	store, err := h.grpcClients.CreateStore(c.Request.Context(), req.toProto(""))
+	if err != nil {
+		respondPickupError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, store)
+}
+
+// ListAllStores - Admin only: List all stores, including those no longer taking pickups
+func (h *Handler) ListAllStores(c *gin.Context) {
+	resp, err := h.grpcClients.ListStores(c.Request.Context(), true)
+	if err != nil {
+		respondPickupError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"stores": resp.Stores})
+}
+
+// UpdateStore - Admin only: Replace a store's settings
+func (h *Handler) UpdateStore(c *gin.Context) {
+	var req storeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	store, err := h.grpcClients.UpdateStore(c.Request.Context(), req.toProto(c.Param("id")))
+	if err != nil {
+		respondPickupError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, store)
+}
+
+// GetDailyPickups - Admin only: The orders to be collected on a day, per store. The date
+// query parameter defaults to today and store_id limits the list to one store.
+func (h *Handler) GetDailyPickups(c *gin.Context) {
+	date := c.DefaultQuery("date", time.Now().Format("2006-01-02"))
+
+	resp, err := h.grpcClients.GetDailyPickups(c.Request.Context(), date, c.Query("store_id"))
+	if err != nil {
+		respondPickupError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// ListExchangeRates - Admin only: List the rates from the base currency to the others
+func (h *Handler) ListExchangeRates(c *gin.Context) {
+	rates, err := h.grpcClients.ListExchangeRates(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, rates)
+}
+
+// SetExchangeRates - Admin only: Replace the exchange-rate table. The body maps currency
+// codes to the units of that currency one unit of the base currency buys, e.g. {"EUR": 0.92};
+// currencies left out can no longer be used.
+func (h *Handler) SetExchangeRates(c *gin.Context) {
+	var req map[string]float64
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	rates := make([]*inventorypb.ExchangeRate, 0, len(req))
+	for currency, rate := range req {
+		rates = append(rates, &inventorypb.ExchangeRate{
+			Currency: currency,
+			Rate:     rate,
+		})
+	}
+
+	response, err := h.grpcClients.SetExchangeRates(c.Request.Context(), rates)
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			c.JSON(http.StatusBadRequest, gin.H{"error": status.Convert(err).Message()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// RefundPayment - Admin only: Refund a completed payment
+func (h *Handler) RefundPayment(c *gin.Context) {
+	id := c.Param("id")
+
+	// The body is optional
+	var req struct {
+		Reason string `json:"reason"`
+	}
+
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	payment, err := h.grpcClients.RefundPayment(c.Request.Context(), id, actorFromContext(c), req.Reason)
+	if err != nil {
+		respondStateError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, payment)
+}
+
+// GetRevenueReport - Admin only: Revenue per day, week or month
+func (h *Handler) GetRevenueReport(c *gin.Context) {
+	reportRange, ok := reportRangeFromQuery(c)
+	if !ok {
+		return
+	}
+
+	report, err := h.grpcClients.GetRevenueReport(c.Request.Context(), reportRange, c.DefaultQuery("period", "day"))
+	if err != nil {
+		respondReportError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// GetOrderStatusReport - Admin only: Order counts by status
+func (h *Handler) GetOrderStatusReport(c *gin.Context) {
+	reportRange, ok := reportRangeFromQuery(c)
+	if !ok {
+		return
+	}
+
+	report, err := h.grpcClients.GetOrderStatusReport(c.Request.Context(), reportRange)
+	if err != nil {
+		respondReportError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// GetTopProductsReport - Admin only: Best selling products by units sold
+func (h *Handler) GetTopProductsReport(c *gin.Context) {
+	reportRange, ok := reportRangeFromQuery(c)
+	if !ok {
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	report, err := h.grpcClients.GetTopProducts(c.Request.Context(), reportRange, int32(limit))
+	if err != nil {
+		respondReportError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// GetSalesByAttributeReport - Admin only: Sales by bike_type, frame_size or color
+func (h *Handler) GetSalesByAttributeReport(c *gin.Context) {
+	reportRange, ok := reportRangeFromQuery(c)
+	if !ok {
+		return
+	}
+
+	report, err := h.grpcClients.GetSalesByAttribute(c.Request.Context(), reportRange, c.Param("attribute"))
+	if err != nil {
+		respondReportError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// orderFilterFromQuery reads the order listing query parameters: status, from/to
+// (as for reports), page, page_size and page_token. Passing the next_page_token of
+// a response as page_token fetches the following page.
+func orderFilterFromQuery(c *gin.Context) (*orderpb.OrderFilter, bool) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+
+	filter := &orderpb.OrderFilter{
+		Page:      int32(page),
+		PageSize:  int32(pageSize),
+		PageToken: c.Query("page_token"),
+	}
+
+	switch c.Query("status") {
+	case "":
+	case "pending":
+		filter.Status = orderpb.OrderStatus_PENDING.Enum()
+	case "paid":
+		filter.Status = orderpb.OrderStatus_PAID.Enum()
+	case "ready_for_pickup":
+		filter.Status = orderpb.OrderStatus_READY_FOR_PICKUP.Enum()
+	case "shipped":
+		filter.Status = orderpb.OrderStatus_SHIPPED.Enum()
+	case "delivered":
+		filter.Status = orderpb.OrderStatus_DELIVERED.Enum()
+	case "cancelled":
+		filter.Status = orderpb.OrderStatus_CANCELLED.Enum()
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid status"})
+		return nil, false
+	}
+
+	dateRange, ok := reportRangeFromQuery(c)
+	if !ok {
+		return nil, false
+	}
+	filter.FromDate = dateRange.From
+	filter.ToDate = dateRange.To
+
+	return filter, true
+}
+
+// reportRangeFromQuery reads the optional from/to query parameters, given as dates
+// (2006-01-02) or RFC 3339 timestamps. A date in to includes that whole day.
+func reportRangeFromQuery(c *gin.Context) (*orderpb.ReportRange, bool) {
+	reportRange := &orderpb.ReportRange{}
+
+	for _, param := range []string{"from", "to"} {
+		value := c.Query(param)
+		if value == "" {
+			continue
+		}
+
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			t, err = time.Parse("2006-01-02", value)
+			if err == nil && param == "to" {
+				t = t.AddDate(0, 0, 1)
+			}
+		}
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("Invalid %s date, use YYYY-MM-DD or RFC 3339", param)})
+			return nil, false
+		}
+
+		if param == "from" {
+			reportRange.From = timestamppb.New(t)
+		} else {
+			reportRange.To = timestamppb.New(t)
+		}
+	}
+
+	return reportRange, true
+}
+
+func respondReportError(c *gin.Context, err error) {
+	if status.Code(err) == codes.InvalidArgument {
+		c.JSON(http.StatusBadRequest, gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+}
+
+func respondOrderItemsError(c *gin.Context, err error) {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		c.JSON(http.StatusBadRequest, gin.H{"error": status.Convert(err).Message()})
+	case codes.FailedPrecondition:
+		c.JSON(http.StatusConflict, gin.H{"error": status.Convert(err).Message()})
+	case codes.NotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Order not found"})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+// respondStateError answers a request the order or payment is in the wrong state for with a
+// conflict.
+func respondStateError(c *gin.Context, err error) {
+	if status.Code(err) == codes.FailedPrecondition {
+		c.JSON(http.StatusConflict, gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+}
+
+func respondReturnError(c *gin.Context, err error) {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		c.JSON(http.StatusBadRequest, gin.H{"error": status.Convert(err).Message()})
+	case codes.FailedPrecondition:
+		c.JSON(http.StatusConflict, gin.H{"error": status.Convert(err).Message()})
+	case codes.NotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": status.Convert(err).Message()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+func respondCouponError(c *gin.Context, err error) {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		c.JSON(http.StatusBadRequest, gin.H{"error": status.Convert(err).Message()})
+	case codes.NotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Coupon not found"})
+	case codes.AlreadyExists:
+		c.JSON(http.StatusConflict, gin.H{"error": status.Convert(err).Message()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+func respondAddressError(c *gin.Context, err error) {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		c.JSON(http.StatusBadRequest, gin.H{"error": status.Convert(err).Message()})
+	case codes.NotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Address not found"})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+func respondPickupError(c *gin.Context, err error) {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		c.JSON(http.StatusBadRequest, gin.H{"error": status.Convert(err).Message()})
+	case codes.NotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Store not found"})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+// callerOrder loads the order in the :id parameter if it belongs to the authenticated user
+// (or the user is an admin), responding with an error otherwise.
+func (h *Handler) callerOrder(c *gin.Context) (*orderpb.OrderResponse, bool) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return nil, false
+	}
+
+	order, err := h.grpcClients.GetOrder(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Order not found"})
+		return nil, false
+	}
+
+	userRole, _ := c.Get("user_role")
+	if userRole != service.UserRoleAdmin && order.UserId != userID.(string) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Access denied"})
+		return nil, false
+	}
+
+	return order, true
+}
+
+// moneyFromFloat turns an amount sent as a JSON number into exact minor units. API clients
+// keep sending decimals; the services only work with exact amounts. Without a currency the
+// amount is in whatever currency it applies to: the base currency for catalogue prices and
+// coupons, the order's for refunds.
+func moneyFromFloat(amount float64, currency string) *moneypb.Money {
+	return &moneypb.Money{
+		Amount:   int64(math.Round(amount * 100)),
+		Currency: strings.ToUpper(strings.TrimSpace(currency)),
+	}
+}
+
+func multiplyMoney(m *moneypb.Money, quantity int32) *moneypb.Money {
+	return &moneypb.Money{
+		Amount:   m.GetAmount() * int64(quantity),
+		Currency: m.GetCurrency(),
+	}
+}
+
+// formatMoney formats an amount as a decimal such as "19.99"
+func formatMoney(m *moneypb.Money) string {
+	amount := m.GetAmount()
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, amount/100, amount%100)
+}
+
+// currencySymbols are shown in front of amounts in customer emails; other currencies are
+// shown by their code after the amount.
+var currencySymbols = map[string]string{
+	"USD": "$",
+	"EUR": "€",
+	"GBP": "£",
+}
+
+// formatPrice formats an amount with its currency, such as "$19.99" or "19.99 CHF"
+func formatPrice(m *moneypb.Money) string {
+	amount := formatMoney(m)
+	if symbol, ok := currencySymbols[m.GetCurrency()]; ok {
+		if strings.HasPrefix(amount, "-") {
+			return "-" + symbol + amount[1:]
+		}
+		return symbol + amount
+	}
+	if m.GetCurrency() == "" {
+		return amount
+	}
+	return amount + " " + m.GetCurrency()
+}
+
+// actorFromContext identifies the authenticated caller for the order's audit trail
+func actorFromContext(c *gin.Context) *orderpb.Actor {
+	actor := &orderpb.Actor{}
+	if userID, ok := c.Get("user_id"); ok {
+		actor.Id, _ = userID.(string)
+	}
+	if userRole, ok := c.Get("user_role"); ok {
+		if role, ok := userRole.(service.UserRole); ok {
+			actor.Role = string(role)
+		}
+	}
+	return actor
+}
+
+func RegisterRoutes(router *gin.Engine, h *Handler) {
+	// Public routes (no authentication required)
+	auth := router.Group("/api/v1/auth")
+	{
+		auth.POST("/register", h.RegisterUser)
+		auth.POST("/login", h.Login)
+	}
+
+	// Public product and category routes (read-only)
+	publicAPI := router.Group("/api/v1")
+	{
+		// Public product routes
+		publicAPI.GET("/products", h.ListProducts)
+		publicAPI.GET("/products/:id", h.GetProduct)
+
+		// Public category routes
+		publicAPI.GET("/categories", h.ListCategories)
+		publicAPI.GET("/categories/:id", h.GetCategory)
+
+		// Public pickup store routes
+		publicAPI.GET("/stores", h.ListStores)
+		publicAPI.GET("/stores/:id/pickup-slots", h.GetPickupSlots)
+	}
+
+	// Cart routes, for guests and signed-in users alike
+	cart := router.Group("/api/v1/cart")
+	cart.Use(service.OptionalAuthMiddleware(h.authService))
+	{
+		cart.GET("", h.GetCart)
+		cart.DELETE("", h.ClearCart)
+		cart.POST("/items", h.AddCartItem)
+		cart.PUT("/items/:product_id", h.UpdateCartItem)
+		cart.DELETE("/items/:product_id", h.RemoveCartItem)
+		cart.POST("/checkout", middleware.RequireAuthenticated(), middleware.Idempotent(h.idempotencyStore), h.CheckoutCart)
+	}
+
+	// Protected routes - require authentication
+	api := router.Group("/api/v1")
+	api.Use(service.AuthMiddleware(h.authService))
+	{
+		// User profile routes
+		api.GET("/users/profile", h.GetUserProfile)
+		api.POST("/users/verify-email", h.VerifyEmailCode)
+		api.POST("/users/resend-verification", h.ResendVerificationCode)
+
+		// Address book routes
+		api.GET("/users/addresses", h.ListAddresses)
+		api.POST("/users/addresses", h.AddAddress)
+		api.PUT("/users/addresses/:id", h.UpdateAddress)
+		api.DELETE("/users/addresses/:id", h.DeleteAddress)
+		api.POST("/users/addresses/:id/default", h.SetDefaultAddress)
+
+		// Protected product routes (admin only)
+		products := api.Group("/products")
+		{
+			products.POST("", middleware.RequireAdmin(), h.CreateProduct)
+			products.PUT("/:id", middleware.RequireAdmin(), h.UpdateProduct)
+			products.DELETE("/:id", middleware.RequireAdmin(), h.DeleteProduct)
+		}
+
+		// Protected category routes (admin only)
+		categories := api.Group("/categories")
+		{
+			categories.POST("", middleware.RequireAdmin(), h.CreateCategory)
+			categories.PUT("/:id", middleware.RequireAdmin(), h.UpdateCategory)
+			categories.DELETE("/:id", middleware.RequireAdmin(), h.DeleteCategory)
+		}
+
+		// Order routes (user must be authenticated)
+		orders := api.Group("/orders")
 		{
-			orders.POST("", h.CreateOrder)
+			orders.POST("", middleware.Idempotent(h.idempotencyStore), h.CreateOrder)
 			orders.GET("", h.ListUserOrders)
 			orders.GET("/:id", h.GetOrder)
+			orders.GET("/:id/history", h.GetOrderHistory)
 			orders.PATCH("/:id/status", h.UpdateOrderStatus)
+			orders.PUT("/:id/items", h.UpdateOrderItems)
+			orders.POST("/:id/payments", h.CreatePayment)
+			orders.POST("/:id/returns", h.RequestReturn)
+			orders.GET("/:id/returns", h.GetOrderReturns)
+			orders.GET("/:id/returns/:return_id", h.GetOrderReturn)
 		}
+
+		// Payment routes (user must be authenticated)
+		api.GET("/payments/:id", h.GetPayment)
 	}
 
 	// Admin routes
@@ -851,5 +2327,34 @@ func RegisterRoutes(router *gin.Engine, h *Handler) {
 		admin.GET("/orders", h.ListAllOrders)
 		admin.GET("/orders/:id", h.GetAnyOrder)
 		admin.PATCH("/orders/:id/status", h.AdminUpdateOrderStatus)
+		admin.POST("/orders/:id/shipments", h.CreateShipment)
+		admin.GET("/orders/:id/shipments", h.GetShipments)
+		admin.POST("/payments/:id/refund", h.RefundPayment)
+
+		admin.POST("/coupons", h.CreateCoupon)
+		admin.GET("/coupons", h.ListCoupons)
+		admin.GET("/coupons/:id", h.GetCoupon)
+		admin.PUT("/coupons/:id", h.UpdateCoupon)
+		admin.DELETE("/coupons/:id", h.DeleteCoupon)
+
+		admin.POST("/stores", h.CreateStore)
+		admin.GET("/stores", h.ListAllStores)
+		admin.PUT("/stores/:id", h.UpdateStore)
+		admin.GET("/pickups", h.GetDailyPickups)
+
+		admin.GET("/exchange-rates", h.ListExchangeRates)
+		admin.PUT("/exchange-rates", h.SetExchangeRates)
+
+		admin.GET("/returns", h.ListReturns)
+		admin.GET("/returns/:id", h.GetAnyReturn)
+		admin.POST("/returns/:id/approve", h.ApproveReturn)
+		admin.POST("/returns/:id/reject", h.RejectReturn)
+		admin.POST("/returns/:id/receive", h.ReceiveReturn)
+		admin.POST("/returns/:id/refund", h.RefundReturn)
+
+		admin.GET("/reports/revenue", h.GetRevenueReport)
+		admin.GET("/reports/orders-by-status", h.GetOrderStatusReport)
+		admin.GET("/reports/top-products", h.GetTopProductsReport)
+		admin.GET("/reports/sales/:attribute", h.GetSalesByAttributeReport)
 	}
 }
@@ -35,3 +35,16 @@ func AuthMiddleware(authService AuthService) gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// OptionalAuthMiddleware authenticates requests that carry an Authorization header and lets
+// anonymous requests through without a user.
+func OptionalAuthMiddleware(authService AuthService) gin.HandlerFunc {
+	authenticate := AuthMiddleware(authService)
+	return func(c *gin.Context) {
+		if c.GetHeader("Authorization") == "" {
+			c.Next()
+			return
+		}
+		authenticate(c)
+	}
+}
@@ -82,13 +82,31 @@ func (s *emailService) SendOrderConfirmation(to string, orderID string, orderDet
             <tr>
                 <td>{{.Name}}</td>
                 <td>{{.Quantity}}</td>
-                <td>${{.Price}}</td>
-                <td>${{.Subtotal}}</td>
+                <td>{{.Price}}</td>
+                <td>{{.Subtotal}}</td>
             </tr>
             {{end}}
+            <tr>
+                <td colspan="3">Subtotal</td>
+                <td>{{.Subtotal}}</td>
+            </tr>
+            {{if .Discount}}
+            <tr>
+                <td colspan="3">Discount{{if .CouponCode}} ({{.CouponCode}}){{end}}</td>
+                <td>-{{.Discount}}</td>
+            </tr>
+            {{end}}
+            <tr>
+                <td colspan="3">Tax{{if .TaxRegion}} ({{.TaxRegion}}){{end}}</td>
+                <td>{{.Tax}}</td>
+            </tr>
+            <tr>
+                <td colspan="3">Shipping</td>
+                <td>{{.Shipping}}</td>
+            </tr>
             <tr class="total">
                 <td colspan="3">Total</td>
-                <td>${{.Total}}</td>
+                <td>{{.Total}}</td>
             </tr>
         </table>
         
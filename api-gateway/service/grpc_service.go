@@ -6,6 +6,7 @@ import (
 	"time"
 
 	inventorypb "proto/inventory"
+	moneypb "proto/money"
 	orderpb "proto/order"
 	userpb "proto/user"
 
@@ -15,12 +16,19 @@ import (
 
 type GrpcClients struct {
 	userClient      userpb.UserServiceClient
+	addressClient   userpb.AddressServiceClient
 	inventoryClient struct {
 		product  inventorypb.ProductServiceClient
 		category inventorypb.CategoryServiceClient
+		currency inventorypb.CurrencyServiceClient
 	}
 	orderClient struct {
-		order orderpb.OrderServiceClient
+		order     orderpb.OrderServiceClient
+		analytics orderpb.AnalyticsServiceClient
+		returns   orderpb.ReturnServiceClient
+		coupons   orderpb.CouponServiceClient
+		carts     orderpb.CartServiceClient
+		pickups   orderpb.PickupServiceClient
 	}
 }
 
@@ -33,6 +41,7 @@ func NewGrpcClients(userServiceURL, inventoryServiceURL, orderServiceURL string)
 		return nil, err
 	}
 	clients.userClient = userpb.NewUserServiceClient(userConn)
+	clients.addressClient = userpb.NewAddressServiceClient(userConn)
 
 	// Set up connection to Inventory Service
 	inventoryConn, err := grpc.Dial(inventoryServiceURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
@@ -41,6 +50,7 @@ func NewGrpcClients(userServiceURL, inventoryServiceURL, orderServiceURL string)
 	}
 	clients.inventoryClient.product = inventorypb.NewProductServiceClient(inventoryConn)
 	clients.inventoryClient.category = inventorypb.NewCategoryServiceClient(inventoryConn)
+	clients.inventoryClient.currency = inventorypb.NewCurrencyServiceClient(inventoryConn)
 
 	// Set up connection to Order Service
 	orderConn, err := grpc.Dial(orderServiceURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
@@ -48,6 +58,11 @@ func NewGrpcClients(userServiceURL, inventoryServiceURL, orderServiceURL string)
 		return nil, err
 	}
 	clients.orderClient.order = orderpb.NewOrderServiceClient(orderConn)
+	clients.orderClient.analytics = orderpb.NewAnalyticsServiceClient(orderConn)
+	clients.orderClient.returns = orderpb.NewReturnServiceClient(orderConn)
+	clients.orderClient.coupons = orderpb.NewCouponServiceClient(orderConn)
+	clients.orderClient.carts = orderpb.NewCartServiceClient(orderConn)
+	clients.orderClient.pickups = orderpb.NewPickupServiceClient(orderConn)
 
 	return clients, nil
 }
@@ -94,6 +109,50 @@ func (c *GrpcClients) GetUserProfile(ctx context.Context, userID string) (*userp
 	})
 }
 
+// User Service - Address methods
+func (c *GrpcClients) AddAddress(ctx context.Context, req *userpb.AddressRequest) (*userpb.Address, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.addressClient.AddAddress(ctx, req)
+}
+
+func (c *GrpcClients) ListAddresses(ctx context.Context, userID string) (*userpb.ListAddressesResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.addressClient.ListAddresses(ctx, &userpb.ListAddressesRequest{
+		UserId: userID,
+	})
+}
+
+func (c *GrpcClients) UpdateAddress(ctx context.Context, req *userpb.AddressRequest) (*userpb.Address, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.addressClient.UpdateAddress(ctx, req)
+}
+
+func (c *GrpcClients) DeleteAddress(ctx context.Context, userID, addressID string) (*userpb.DeleteAddressResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.addressClient.DeleteAddress(ctx, &userpb.AddressIDRequest{
+		UserId: userID,
+		Id:     addressID,
+	})
+}
+
+func (c *GrpcClients) SetDefaultAddress(ctx context.Context, userID, addressID string) (*userpb.Address, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.addressClient.SetDefault(ctx, &userpb.AddressIDRequest{
+		UserId: userID,
+		Id:     addressID,
+	})
+}
+
 // Inventory Service - Product methods
 
 func (c *GrpcClients) CreateProduct(ctx context.Context, req *inventorypb.CreateProductRequest) (*inventorypb.ProductResponse, error) {
@@ -110,21 +169,25 @@ func (c *GrpcClients) UpdateProduct(ctx context.Context, req *inventorypb.Update
 	return c.inventoryClient.product.UpdateProduct(ctx, req)
 }
 
-func (c *GrpcClients) ListProducts(ctx context.Context, filter *inventorypb.ProductFilter) (*inventorypb.ListProductsResponse, error) {
+// ListProducts lists products with prices in currency, or in the base currency if it is empty.
+func (c *GrpcClients) ListProducts(ctx context.Context, filter *inventorypb.ProductFilter, currency string) (*inventorypb.ListProductsResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
 	return c.inventoryClient.product.ListProducts(ctx, &inventorypb.ListProductsRequest{
-		Filter: filter,
+		Filter:   filter,
+		Currency: currency,
 	})
 }
 
-func (c *GrpcClients) GetProduct(ctx context.Context, productID string) (*inventorypb.ProductResponse, error) {
+// GetProduct gets a product with its price in currency, or in the base currency if it is empty.
+func (c *GrpcClients) GetProduct(ctx context.Context, productID, currency string) (*inventorypb.ProductResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
 	return c.inventoryClient.product.GetProduct(ctx, &inventorypb.ProductIDRequest{
-		Id: productID,
+		Id:       productID,
+		Currency: currency,
 	})
 }
 
@@ -146,6 +209,24 @@ func (c *GrpcClients) CheckStock(ctx context.Context, items []*inventorypb.Produ
 	})
 }
 
+// Inventory Service - Currency methods
+
+func (c *GrpcClients) ListExchangeRates(ctx context.Context) (*inventorypb.ExchangeRatesResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.inventoryClient.currency.ListExchangeRates(ctx, &inventorypb.ListExchangeRatesRequest{})
+}
+
+func (c *GrpcClients) SetExchangeRates(ctx context.Context, rates []*inventorypb.ExchangeRate) (*inventorypb.ExchangeRatesResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.inventoryClient.currency.SetExchangeRates(ctx, &inventorypb.SetExchangeRatesRequest{
+		Rates: rates,
+	})
+}
+
 // Inventory Service - Category methods
 func (c *GrpcClients) CreateCategory(ctx context.Context, req *inventorypb.CreateCategoryRequest) (*inventorypb.CategoryResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
@@ -210,12 +291,115 @@ func (c *GrpcClients) UpdateOrderStatus(ctx context.Context, req *orderpb.Update
 	return c.orderClient.order.UpdateOrderStatus(ctx, req)
 }
 
-func (c *GrpcClients) GetUserOrders(ctx context.Context, userID string) (*orderpb.ListOrdersResponse, error) {
+func (c *GrpcClients) UpdateOrderItems(ctx context.Context, req *orderpb.UpdateOrderItemsRequest) (*orderpb.OrderResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.orderClient.order.UpdateOrderItems(ctx, req)
+}
+
+func (c *GrpcClients) GetOrderHistory(ctx context.Context, orderID string) (*orderpb.OrderHistoryResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	return c.orderClient.order.GetUserOrders(ctx, &orderpb.UserIDRequest{
+	return c.orderClient.order.GetOrderHistory(ctx, &orderpb.OrderIDRequest{
+		Id: orderID,
+	})
+}
+
+func (c *GrpcClients) CreateShipment(ctx context.Context, req *orderpb.CreateShipmentRequest) (*orderpb.ShipmentResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.orderClient.order.CreateShipment(ctx, req)
+}
+
+func (c *GrpcClients) GetShipments(ctx context.Context, orderID string) (*orderpb.ShipmentsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.orderClient.order.GetShipments(ctx, &orderpb.OrderIDRequest{
+		Id: orderID,
+	})
+}
+
+func (c *GrpcClients) GetUserOrders(ctx context.Context, userID string, filter *orderpb.OrderFilter) (*orderpb.ListOrdersResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.orderClient.order.GetUserOrders(ctx, &orderpb.UserOrdersRequest{
 		UserId: userID,
+		Filter: filter,
+	})
+}
+
+// Order Service - Payment methods
+func (c *GrpcClients) CreatePayment(ctx context.Context, orderID, method string, actor *orderpb.Actor) (*orderpb.PaymentResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.orderClient.order.CreatePayment(ctx, &orderpb.CreatePaymentRequest{
+		OrderId: orderID,
+		Method:  method,
+		Actor:   actor,
+	})
+}
+
+func (c *GrpcClients) GetPayment(ctx context.Context, paymentID string) (*orderpb.PaymentResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.orderClient.order.GetPayment(ctx, &orderpb.PaymentIDRequest{
+		Id: paymentID,
+	})
+}
+
+func (c *GrpcClients) RefundPayment(ctx context.Context, paymentID string, actor *orderpb.Actor, reason string) (*orderpb.PaymentResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.orderClient.order.RefundPayment(ctx, &orderpb.RefundPaymentRequest{
+		Id:     paymentID,
+		Actor:  actor,
+		Reason: reason,
+	})
+}
+
+// Order Service - Analytics methods
+func (c *GrpcClients) GetRevenueReport(ctx context.Context, reportRange *orderpb.ReportRange, period string) (*orderpb.RevenueReportResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.orderClient.analytics.GetRevenueReport(ctx, &orderpb.RevenueReportRequest{
+		Range:  reportRange,
+		Period: period,
+	})
+}
+
+func (c *GrpcClients) GetOrderStatusReport(ctx context.Context, reportRange *orderpb.ReportRange) (*orderpb.OrderStatusReportResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.orderClient.analytics.GetOrderStatusReport(ctx, reportRange)
+}
+
+func (c *GrpcClients) GetTopProducts(ctx context.Context, reportRange *orderpb.ReportRange, limit int32) (*orderpb.TopProductsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.orderClient.analytics.GetTopProducts(ctx, &orderpb.TopProductsRequest{
+		Range: reportRange,
+		Limit: limit,
+	})
+}
+
+func (c *GrpcClients) GetSalesByAttribute(ctx context.Context, reportRange *orderpb.ReportRange, attribute string) (*orderpb.SalesByAttributeResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.orderClient.analytics.GetSalesByAttribute(ctx, &orderpb.SalesByAttributeRequest{
+		Range:     reportRange,
+		Attribute: attribute,
 	})
 }
 
@@ -251,3 +435,229 @@ func (c *GrpcClients) VerifyEmailCode(ctx context.Context, userID, code string)
 
 	return response.Success, nil
 }
+
+// Order Service - Return methods
+func (c *GrpcClients) RequestReturn(ctx context.Context, req *orderpb.RequestReturnRequest) (*orderpb.ReturnResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.orderClient.returns.RequestReturn(ctx, req)
+}
+
+func (c *GrpcClients) GetReturn(ctx context.Context, returnID string) (*orderpb.ReturnResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.orderClient.returns.GetReturn(ctx, &orderpb.ReturnIDRequest{
+		Id: returnID,
+	})
+}
+
+func (c *GrpcClients) GetOrderReturns(ctx context.Context, orderID string) (*orderpb.ReturnsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.orderClient.returns.GetOrderReturns(ctx, &orderpb.OrderReturnsRequest{
+		OrderId: orderID,
+	})
+}
+
+func (c *GrpcClients) ListReturns(ctx context.Context, req *orderpb.ListReturnsRequest) (*orderpb.ReturnsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.orderClient.returns.ListReturns(ctx, req)
+}
+
+func (c *GrpcClients) ApproveReturn(ctx context.Context, returnID, note string) (*orderpb.ReturnResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.orderClient.returns.ApproveReturn(ctx, &orderpb.ResolveReturnRequest{
+		Id:   returnID,
+		Note: note,
+	})
+}
+
+func (c *GrpcClients) RejectReturn(ctx context.Context, returnID, note string) (*orderpb.ReturnResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.orderClient.returns.RejectReturn(ctx, &orderpb.ResolveReturnRequest{
+		Id:   returnID,
+		Note: note,
+	})
+}
+
+func (c *GrpcClients) ReceiveReturn(ctx context.Context, returnID string, restock bool) (*orderpb.ReturnResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.orderClient.returns.ReceiveReturn(ctx, &orderpb.ReceiveReturnRequest{
+		Id:      returnID,
+		Restock: restock,
+	})
+}
+
+func (c *GrpcClients) RefundReturn(ctx context.Context, returnID string, amount *moneypb.Money) (*orderpb.ReturnResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return c.orderClient.returns.RefundReturn(ctx, &orderpb.RefundReturnRequest{
+		Id:          returnID,
+		Amount:      float64(amount.GetAmount()) / 100,
+		AmountMoney: amount,
+	})
+}
+
+// Order Service - Coupon methods
+func (c *GrpcClients) CreateCoupon(ctx context.Context, req *orderpb.CouponRequest) (*orderpb.CouponResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.orderClient.coupons.CreateCoupon(ctx, req)
+}
+
+func (c *GrpcClients) GetCoupon(ctx context.Context, couponID string) (*orderpb.CouponResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.orderClient.coupons.GetCoupon(ctx, &orderpb.CouponIDRequest{
+		Id: couponID,
+	})
+}
+
+func (c *GrpcClients) UpdateCoupon(ctx context.Context, req *orderpb.CouponRequest) (*orderpb.CouponResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.orderClient.coupons.UpdateCoupon(ctx, req)
+}
+
+func (c *GrpcClients) DeleteCoupon(ctx context.Context, couponID string) (*orderpb.DeleteCouponResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.orderClient.coupons.DeleteCoupon(ctx, &orderpb.CouponIDRequest{
+		Id: couponID,
+	})
+}
+
+func (c *GrpcClients) ListCoupons(ctx context.Context) (*orderpb.ListCouponsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.orderClient.coupons.ListCoupons(ctx, &orderpb.ListCouponsRequest{})
+}
+
+// Order Service - Cart methods
+
+func (c *GrpcClients) GetCart(ctx context.Context, owner *orderpb.CartOwner, currency string) (*orderpb.CartResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.orderClient.carts.GetCart(ctx, &orderpb.CartRequest{
+		Owner:    owner,
+		Currency: currency,
+	})
+}
+
+func (c *GrpcClients) AddCartItem(ctx context.Context, owner *orderpb.CartOwner, productID string, quantity int32, currency string) (*orderpb.CartResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.orderClient.carts.AddCartItem(ctx, &orderpb.CartItemRequest{
+		Owner:     owner,
+		Currency:  currency,
+		ProductId: productID,
+		Quantity:  quantity,
+	})
+}
+
+func (c *GrpcClients) UpdateCartItem(ctx context.Context, owner *orderpb.CartOwner, productID string, quantity int32, currency string) (*orderpb.CartResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.orderClient.carts.UpdateCartItem(ctx, &orderpb.CartItemRequest{
+		Owner:     owner,
+		Currency:  currency,
+		ProductId: productID,
+		Quantity:  quantity,
+	})
+}
+
+func (c *GrpcClients) RemoveCartItem(ctx context.Context, owner *orderpb.CartOwner, productID, currency string) (*orderpb.CartResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.orderClient.carts.RemoveCartItem(ctx, &orderpb.CartItemRequest{
+		Owner:     owner,
+		Currency:  currency,
+		ProductId: productID,
+	})
+}
+
+func (c *GrpcClients) ClearCart(ctx context.Context, owner *orderpb.CartOwner, currency string) (*orderpb.CartResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.orderClient.carts.ClearCart(ctx, &orderpb.CartRequest{
+		Owner:    owner,
+		Currency: currency,
+	})
+}
+
+func (c *GrpcClients) MergeCarts(ctx context.Context, sessionID, userID string) (*orderpb.CartResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.orderClient.carts.MergeCarts(ctx, &orderpb.MergeCartsRequest{
+		SessionId: sessionID,
+		UserId:    userID,
+	})
+}
+
+// Order Service - Pickup methods
+func (c *GrpcClients) CreateStore(ctx context.Context, req *orderpb.StoreRequest) (*orderpb.StoreResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.orderClient.pickups.CreateStore(ctx, req)
+}
+
+func (c *GrpcClients) UpdateStore(ctx context.Context, req *orderpb.StoreRequest) (*orderpb.StoreResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.orderClient.pickups.UpdateStore(ctx, req)
+}
+
+func (c *GrpcClients) ListStores(ctx context.Context, includeInactive bool) (*orderpb.ListStoresResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.orderClient.pickups.ListStores(ctx, &orderpb.ListStoresRequest{
+		IncludeInactive: includeInactive,
+	})
+}
+
+func (c *GrpcClients) GetPickupSlots(ctx context.Context, storeID, from string, days int32) (*orderpb.PickupSlotsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.orderClient.pickups.GetPickupSlots(ctx, &orderpb.PickupSlotsRequest{
+		StoreId: storeID,
+		From:    from,
+		Days:    days,
+	})
+}
+
+func (c *GrpcClients) GetDailyPickups(ctx context.Context, date, storeID string) (*orderpb.DailyPickupsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.orderClient.pickups.GetDailyPickups(ctx, &orderpb.DailyPickupsRequest{
+		Date:    date,
+		StoreId: storeID,
+	})
+}
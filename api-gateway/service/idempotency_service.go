@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+var (
+	// ErrIdempotencyKeyReused is returned when a key comes back with a different request
+	ErrIdempotencyKeyReused = errors.New("idempotency key was already used for a different request")
+	// ErrIdempotencyKeyInProgress is returned while the first request with a key is still running
+	ErrIdempotencyKeyInProgress = errors.New("a request with this idempotency key is still in progress")
+)
+
+// IdempotentResponse is the response stored for a request, replayed to its retries.
+type IdempotentResponse struct {
+	StatusCode  int    `json:"status_code"`
+	ContentType string `json:"content_type"`
+	Body        []byte `json:"body"`
+}
+
+// IdempotencyStore remembers the requests made with each idempotency key for a window.
+type IdempotencyStore interface {
+	// Begin claims key for the request with the given fingerprint. It returns the stored
+	// response if the same request already completed, ErrIdempotencyKeyInProgress if it is
+	// still running and ErrIdempotencyKeyReused if the key belongs to a different request.
+	Begin(ctx context.Context, key, fingerprint string) (*IdempotentResponse, error)
+	// Complete stores the response for the claimed key
+	Complete(ctx context.Context, key, fingerprint string, response IdempotentResponse) error
+	// Release gives up the claim, so that a retry runs the request again
+	Release(ctx context.Context, key string) error
+}
+
+// idempotencyRecord is what is stored under a key; Response is nil while the request runs.
+type idempotencyRecord struct {
+	Fingerprint string              `json:"fingerprint"`
+	Response    *IdempotentResponse `json:"response,omitempty"`
+}
+
+// idempotencyClaimTTL bounds how long a request that never completes blocks its key.
+const idempotencyClaimTTL = time.Minute
+
+type redisIdempotencyStore struct {
+	client *redis.Client
+	ttl    time.Duration
+}
+
+func NewIdempotencyStore(client *redis.Client, ttl time.Duration) IdempotencyStore {
+	return &redisIdempotencyStore{
+		client: client,
+		ttl:    ttl,
+	}
+}
+
+func (s *redisIdempotencyStore) Begin(ctx context.Context, key, fingerprint string) (*IdempotentResponse, error) {
+	claim, err := json.Marshal(idempotencyRecord{Fingerprint: fingerprint})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode idempotency record: %v", err)
+	}
+
+	claimed, err := s.client.SetNX(ctx, s.redisKey(key), claim, idempotencyClaimTTL).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim idempotency key: %v", err)
+	}
+	if claimed {
+		return nil, nil
+	}
+
+	data, err := s.client.Get(ctx, s.redisKey(key)).Bytes()
+	if err == redis.Nil {
+		// The previous claim expired in between; try again
+		return s.Begin(ctx, key, fingerprint)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get idempotency key: %v", err)
+	}
+
+	var record idempotencyRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return nil, fmt.Errorf("failed to decode idempotency record: %v", err)
+	}
+
+	if record.Fingerprint != fingerprint {
+		return nil, ErrIdempotencyKeyReused
+	}
+	if record.Response == nil {
+		return nil, ErrIdempotencyKeyInProgress
+	}
+	return record.Response, nil
+}
+
+func (s *redisIdempotencyStore) Complete(ctx context.Context, key, fingerprint string, response IdempotentResponse) error {
+	data, err := json.Marshal(idempotencyRecord{Fingerprint: fingerprint, Response: &response})
+	if err != nil {
+		return fmt.Errorf("failed to encode idempotency record: %v", err)
+	}
+
+	if err := s.client.Set(ctx, s.redisKey(key), data, s.ttl).Err(); err != nil {
+		return fmt.Errorf("failed to store idempotent response: %v", err)
+	}
+	return nil
+}
+
+func (s *redisIdempotencyStore) Release(ctx context.Context, key string) error {
+	if err := s.client.Del(ctx, s.redisKey(key)).Err(); err != nil {
+		return fmt.Errorf("failed to release idempotency key: %v", err)
+	}
+	return nil
+}
+
+func (s *redisIdempotencyStore) redisKey(key string) string {
+	return "idempotency:" + key
+}
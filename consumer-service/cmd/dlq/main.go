@@ -0,0 +1,158 @@
+// Command dlq lists, inspects, replays and discards order events the consumer gave up on.
+//
+// Usage:
+//
+//	dlq list [-limit N]
+//	dlq inspect <sequence>
+//	dlq replay <sequence>|all
+//	dlq discard <sequence>
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"consumer-service/config"
+	"consumer-service/internal/service"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	cfg := config.LoadConfig()
+
+	deadLetterService, err := service.NewDeadLetterService(cfg.NATS.URL, cfg.NATS.DeadLetterStream)
+	if err != nil {
+		log.Fatalf("Failed to initialize dead-letter service: %v", err)
+	}
+	defer deadLetterService.Close()
+
+	command, args := os.Args[1], os.Args[2:]
+	switch command {
+	case "list":
+		err = list(deadLetterService, args)
+	case "inspect":
+		err = inspect(deadLetterService, args)
+	case "replay":
+		err = replay(deadLetterService, args)
+	case "discard":
+		err = discard(deadLetterService, args)
+	default:
+		usage()
+	}
+
+	if err != nil {
+		deadLetterService.Close()
+		log.Fatalf("%s failed: %v", command, err)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: dlq list [-limit N] | inspect <sequence> | replay <sequence>|all | discard <sequence>")
+	os.Exit(2)
+}
+
+func list(deadLetterService service.DeadLetterService, args []string) error {
+	flags := flag.NewFlagSet("list", flag.ExitOnError)
+	limit := flags.Int("limit", 50, "maximum number of dead letters to show")
+	_ = flags.Parse(args)
+
+	deadLetters, err := deadLetterService.List(*limit)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "SEQUENCE\tSUBJECT\tATTEMPTS\tFAILED AT\tERROR")
+	for _, deadLetter := range deadLetters {
+		fmt.Fprintf(w, "%d\t%s\t%d\t%s\t%s\n",
+			deadLetter.Sequence,
+			deadLetter.Subject,
+			deadLetter.Attempts,
+			deadLetter.FailedAt.Format(time.RFC3339),
+			deadLetter.Error,
+		)
+	}
+	return w.Flush()
+}
+
+func inspect(deadLetterService service.DeadLetterService, args []string) error {
+	sequence, err := parseSequence(args)
+	if err != nil {
+		return err
+	}
+
+	deadLetter, err := deadLetterService.Get(sequence)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Sequence:     %d\n", deadLetter.Sequence)
+	fmt.Printf("Subject:      %s\n", deadLetter.Subject)
+	fmt.Printf("Attempts:     %d\n", deadLetter.Attempts)
+	fmt.Printf("Published at: %s\n", deadLetter.PublishedAt.Format(time.RFC3339))
+	fmt.Printf("Failed at:    %s\n", deadLetter.FailedAt.Format(time.RFC3339))
+	fmt.Printf("Error:        %s\n", deadLetter.Error)
+	fmt.Printf("Payload:\n%s\n", deadLetter.Payload)
+	return nil
+}
+
+func replay(deadLetterService service.DeadLetterService, args []string) error {
+	if len(args) == 1 && args[0] == "all" {
+		deadLetters, err := deadLetterService.List(0)
+		if err != nil {
+			return err
+		}
+
+		for _, deadLetter := range deadLetters {
+			if err := deadLetterService.Replay(deadLetter.Sequence); err != nil {
+				return err
+			}
+		}
+		fmt.Printf("Replayed %d dead letters\n", len(deadLetters))
+		return nil
+	}
+
+	sequence, err := parseSequence(args)
+	if err != nil {
+		return err
+	}
+
+	if err := deadLetterService.Replay(sequence); err != nil {
+		return err
+	}
+	fmt.Printf("Replayed dead letter %d\n", sequence)
+	return nil
+}
+
+func discard(deadLetterService service.DeadLetterService, args []string) error {
+	sequence, err := parseSequence(args)
+	if err != nil {
+		return err
+	}
+
+	if err := deadLetterService.Discard(sequence); err != nil {
+		return err
+	}
+	fmt.Printf("Discarded dead letter %d\n", sequence)
+	return nil
+}
+
+func parseSequence(args []string) (uint64, error) {
+	if len(args) != 1 {
+		return 0, fmt.Errorf("expected exactly one sequence number")
+	}
+
+	sequence, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid sequence number %q", args[0])
+	}
+	return sequence, nil
+}
@@ -8,6 +8,7 @@ import (
 	"syscall"
 
 	"consumer-service/config"
+	"consumer-service/internal/dedupe"
 	"consumer-service/internal/handler"
 	"consumer-service/internal/service"
 )
@@ -22,11 +23,39 @@ func main() {
 	}
 	defer inventoryService.Close()
 
+	// Initialize store of processed events so redeliveries don't move stock twice
+	dedupeStore, err := dedupe.NewRedisStore(cfg.Redis.Addr, cfg.Redis.Password, cfg.Redis.DB)
+	if err != nil {
+		log.Fatalf("Failed to connect to Redis: %v", err)
+	}
+	defer dedupeStore.Close()
+
+	// Initialize publisher used to report orders whose stock could not be reserved
+	eventPublisher, err := service.NewEventPublisher(cfg.NATS.URL)
+	if err != nil {
+		log.Fatalf("Failed to initialize event publisher: %v", err)
+	}
+	defer eventPublisher.Close()
+
 	// Initialize order handler - cast inventoryService to the interface expected by handler
-	orderHandler := handler.NewOrderHandler(inventoryService)
+	orderHandler := handler.NewOrderHandler(inventoryService, eventPublisher, dedupeStore)
+
+	// Initialize dead-letter queue for events that keep failing
+	deadLetterService, err := service.NewDeadLetterService(cfg.NATS.URL, cfg.NATS.DeadLetterStream)
+	if err != nil {
+		log.Fatalf("Failed to initialize dead-letter service: %v", err)
+	}
+	defer deadLetterService.Close()
 
 	// Initialize NATS service - cast orderHandler to the interface expected by nats service
-	natsService, err := service.NewNatsService(cfg.NATS.URL, orderHandler)
+	natsService, err := service.NewNatsService(cfg.NATS.URL, service.ConsumerConfig{
+		Stream:      cfg.NATS.Stream,
+		Durable:     cfg.NATS.Durable,
+		MaxDeliver:  cfg.NATS.MaxDeliver,
+		AckWait:     cfg.NATS.AckWait,
+		NakDelay:    cfg.NATS.NakDelay,
+		MaxNakDelay: cfg.NATS.MaxNakDelay,
+	}, orderHandler, deadLetterService)
 	if err != nil {
 		log.Fatalf("Failed to initialize NATS service: %v", err)
 	}
@@ -3,6 +3,8 @@ package config
 import (
 	"log"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -14,7 +16,19 @@ type Config struct {
 		}
 	}
 	NATS struct {
-		URL string
+		URL              string
+		Stream           string
+		DeadLetterStream string
+		Durable          string
+		MaxDeliver       int
+		AckWait          time.Duration
+		NakDelay         time.Duration
+		MaxNakDelay      time.Duration
+	}
+	Redis struct {
+		Addr     string
+		Password string
+		DB       int
 	}
 }
 
@@ -28,6 +42,31 @@ func LoadConfig() *Config {
 	config.Services.Inventory.GrpcURL = getEnv("INVENTORY_GRPC_URL", "localhost:50051")
 	config.NATS.URL = getEnv("NATS_URL", "nats://localhost:4222")
 
+	// JetStream consumer configuration
+	config.NATS.Stream = getEnv("NATS_STREAM", "BICYCLE_ORDERS")
+	config.NATS.Durable = getEnv("NATS_DURABLE", "consumer-service")
+	config.NATS.DeadLetterStream = getEnv("NATS_DEAD_LETTER_STREAM", "BICYCLE_ORDERS_DLQ")
+
+	maxDeliver, err := strconv.Atoi(getEnv("NATS_MAX_DELIVER", "5"))
+	if err != nil || maxDeliver <= 0 {
+		maxDeliver = 5
+	}
+	config.NATS.MaxDeliver = maxDeliver
+
+	config.NATS.AckWait = getDuration("NATS_ACK_WAIT", 30*time.Second)
+	config.NATS.NakDelay = getDuration("NATS_NAK_DELAY", 2*time.Second)
+	config.NATS.MaxNakDelay = getDuration("NATS_MAX_NAK_DELAY", time.Minute)
+
+	// Redis configuration for processed event tracking
+	config.Redis.Addr = getEnv("REDIS_ADDR", "localhost:6379")
+	config.Redis.Password = getEnv("REDIS_PASSWORD", "")
+
+	redisDB, err := strconv.Atoi(getEnv("REDIS_DB", "4"))
+	if err != nil {
+		redisDB = 4
+	}
+	config.Redis.DB = redisDB
+
 	return config
 }
 
@@ -38,3 +77,11 @@ func getEnv(key, defaultValue string) string {
 	}
 	return value
 }
+
+func getDuration(key string, defaultValue time.Duration) time.Duration {
+	value, err := time.ParseDuration(getEnv(key, defaultValue.String()))
+	if err != nil || value <= 0 {
+		return defaultValue
+	}
+	return value
+}
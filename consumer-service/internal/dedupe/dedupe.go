@@ -0,0 +1,41 @@
+package dedupe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrAlreadyProcessed = errors.New("event already processed")
+	ErrInProgress       = errors.New("event is being processed")
+)
+
+// Store remembers which events have been handled so redelivered or duplicated events are skipped.
+type Store interface {
+	// Claim reserves key for processing. It returns ErrAlreadyProcessed once the key has been
+	// completed and ErrInProgress while another worker holds an unexpired claim.
+	Claim(ctx context.Context, key string) error
+	// Complete records key as processed.
+	Complete(ctx context.Context, key string) error
+	// Release drops a claim after a failure so the event can be retried.
+	Release(ctx context.Context, key string) error
+	Close() error
+}
+
+const (
+	// ClaimTTL bounds how long a crashed worker can block retries of an event
+	ClaimTTL = 2 * time.Minute
+	// ProcessedTTL is how long processed events are remembered; it must outlast any redelivery
+	ProcessedTTL = 7 * 24 * time.Hour
+)
+
+// Key identifies an event of the given type. Events published before event IDs existed
+// fall back to the order ID, which is unique per order for each event type.
+func Key(eventType, eventID, orderID string) string {
+	if eventID == "" {
+		return fmt.Sprintf("processed:%s:order:%s", eventType, orderID)
+	}
+	return fmt.Sprintf("processed:%s:%s", eventType, eventID)
+}
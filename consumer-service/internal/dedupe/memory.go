@@ -0,0 +1,59 @@
+package dedupe
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type memoryEntry struct {
+	done      bool
+	expiresAt time.Time
+}
+
+type memoryStore struct {
+	mu      sync.Mutex
+	entries map[string]memoryEntry
+}
+
+// NewMemoryStore returns a Store kept in process memory, for tests and single-instance setups.
+func NewMemoryStore() Store {
+	return &memoryStore{
+		entries: make(map[string]memoryEntry),
+	}
+}
+
+func (m *memoryStore) Claim(ctx context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if entry, ok := m.entries[key]; ok && time.Now().Before(entry.expiresAt) {
+		if entry.done {
+			return ErrAlreadyProcessed
+		}
+		return ErrInProgress
+	}
+
+	m.entries[key] = memoryEntry{expiresAt: time.Now().Add(ClaimTTL)}
+	return nil
+}
+
+func (m *memoryStore) Complete(ctx context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.entries[key] = memoryEntry{done: true, expiresAt: time.Now().Add(ProcessedTTL)}
+	return nil
+}
+
+func (m *memoryStore) Release(ctx context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.entries, key)
+	return nil
+}
+
+func (m *memoryStore) Close() error {
+	return nil
+}
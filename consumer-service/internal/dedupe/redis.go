@@ -0,0 +1,80 @@
+package dedupe
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	stateProcessing = "processing"
+	stateDone       = "done"
+)
+
+type redisStore struct {
+	client *redis.Client
+}
+
+func NewRedisStore(addr, password string, db int) (Store, error) {
+	client := redis.NewClient(&redis.Options{
+		Addr:     addr,
+		Password: password,
+		DB:       db,
+	})
+
+	// Test connection
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := client.Ping(ctx).Err(); err != nil {
+		return nil, fmt.Errorf("failed to connect to Redis: %v", err)
+	}
+
+	return &redisStore{
+		client: client,
+	}, nil
+}
+
+func (r *redisStore) Claim(ctx context.Context, key string) error {
+	claimed, err := r.client.SetNX(ctx, key, stateProcessing, ClaimTTL).Result()
+	if err != nil {
+		return fmt.Errorf("failed to claim key %s: %v", key, err)
+	}
+	if claimed {
+		return nil
+	}
+
+	state, err := r.client.Get(ctx, key).Result()
+	if err == redis.Nil {
+		// The previous claim expired in between; try again
+		return r.Claim(ctx, key)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get key %s: %v", key, err)
+	}
+
+	if state == stateDone {
+		return ErrAlreadyProcessed
+	}
+	return ErrInProgress
+}
+
+func (r *redisStore) Complete(ctx context.Context, key string) error {
+	if err := r.client.Set(ctx, key, stateDone, ProcessedTTL).Err(); err != nil {
+		return fmt.Errorf("failed to complete key %s: %v", key, err)
+	}
+	return nil
+}
+
+func (r *redisStore) Release(ctx context.Context, key string) error {
+	if err := r.client.Del(ctx, key).Err(); err != nil {
+		return fmt.Errorf("failed to release key %s: %v", key, err)
+	}
+	return nil
+}
+
+func (r *redisStore) Close() error {
+	return r.client.Close()
+}
@@ -3,6 +3,7 @@ package events
 import "time"
 
 type OrderCreatedEvent struct {
+	EventID    string           `json:"event_id"`
 	OrderID    string           `json:"order_id"`
 	UserID     string           `json:"user_id"`
 	Total      float64          `json:"total"`
@@ -12,6 +13,54 @@ type OrderCreatedEvent struct {
 	CreatedAt  time.Time        `json:"created_at"`
 }
 
+type OrderPaidEvent struct {
+	EventID string           `json:"event_id"`
+	OrderID string           `json:"order_id"`
+	UserID  string           `json:"user_id"`
+	Items   []OrderItemEvent `json:"items"`
+	PaidAt  time.Time        `json:"paid_at"`
+}
+
+// OrderUpdatedEvent carries the whole new list of items of a pending order that was edited.
+type OrderUpdatedEvent struct {
+	EventID   string           `json:"event_id"`
+	OrderID   string           `json:"order_id"`
+	UserID    string           `json:"user_id"`
+	Total     float64          `json:"total"`
+	Items     []OrderItemEvent `json:"items"`
+	UpdatedAt time.Time        `json:"updated_at"`
+}
+
+type OrderCancelledEvent struct {
+	EventID        string           `json:"event_id"`
+	OrderID        string           `json:"order_id"`
+	UserID         string           `json:"user_id"`
+	PreviousStatus string           `json:"previous_status"`
+	Items          []OrderItemEvent `json:"items"`
+	CancelledAt    time.Time        `json:"cancelled_at"`
+}
+
+// OrderStockFailedEvent tells order-service that an order's stock could not be reserved,
+// or that its reservation expired and the stock was sold before the order was paid.
+type OrderStockFailedEvent struct {
+	EventID          string           `json:"event_id"`
+	OrderID          string           `json:"order_id"`
+	Reason           string           `json:"reason"`
+	UnavailableItems []OrderItemEvent `json:"unavailable_items"`
+	FailedAt         time.Time        `json:"failed_at"`
+}
+
+// OrderReturnedEvent asks for the items of a return received back in the warehouse to be
+// put back on hand.
+type OrderReturnedEvent struct {
+	EventID    string           `json:"event_id"`
+	ReturnID   string           `json:"return_id"`
+	OrderID    string           `json:"order_id"`
+	UserID     string           `json:"user_id"`
+	Items      []OrderItemEvent `json:"items"`
+	ReceivedAt time.Time        `json:"received_at"`
+}
+
 type OrderItemEvent struct {
 	ProductID string `json:"product_id"`
 	Quantity  int    `json:"quantity"`
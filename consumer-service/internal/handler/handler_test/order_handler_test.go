@@ -0,0 +1,528 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"consumer-service/internal/dedupe"
+	"consumer-service/internal/events"
+	"consumer-service/internal/handler"
+	"consumer-service/internal/service"
+)
+
+type reservation struct {
+	items     []events.OrderItemEvent
+	committed bool
+	expired   bool
+}
+
+// fakeInventory tracks available stock per product and reservations per order.
+type fakeInventory struct {
+	stock        map[string]int
+	reservations map[string]*reservation
+	failFor      string
+	holds        int
+	adjustments  int
+}
+
+func newFakeInventory(stock map[string]int) *fakeInventory {
+	return &fakeInventory{
+		stock:        stock,
+		reservations: make(map[string]*reservation),
+	}
+}
+
+func (f *fakeInventory) HoldStock(ctx context.Context, orderID string, items []events.OrderItemEvent) ([]events.OrderItemEvent, error) {
+	for _, item := range items {
+		if item.ProductID == f.failFor {
+			return nil, errors.New("inventory unavailable")
+		}
+	}
+	if _, ok := f.reservations[orderID]; ok {
+		return nil, nil
+	}
+
+	if unavailable := f.shortItems(items); len(unavailable) > 0 {
+		return unavailable, nil
+	}
+
+	f.holds++
+	f.take(items)
+	f.reservations[orderID] = &reservation{items: items}
+	return nil, nil
+}
+
+func (f *fakeInventory) AdjustReservation(ctx context.Context, orderID string, items []events.OrderItemEvent) ([]events.OrderItemEvent, error) {
+	r, ok := f.reservations[orderID]
+	if !ok {
+		return nil, service.ErrReservationNotFound
+	}
+	if r.committed {
+		return nil, service.ErrReservationReleased
+	}
+
+	// Hand the current hold back, then take the new items if they are all there
+	if !r.expired {
+		for _, item := range r.items {
+			f.stock[item.ProductID] += item.Quantity
+		}
+	}
+	if unavailable := f.shortItems(items); len(unavailable) > 0 {
+		if !r.expired {
+			f.take(r.items)
+		}
+		return unavailable, nil
+	}
+
+	f.adjustments++
+	f.take(items)
+	r.items = items
+	r.expired = false
+	return nil, nil
+}
+
+func (f *fakeInventory) CommitReservation(ctx context.Context, orderID string) ([]events.OrderItemEvent, error) {
+	r, ok := f.reservations[orderID]
+	if !ok {
+		return nil, service.ErrReservationNotFound
+	}
+	if r.committed {
+		return nil, nil
+	}
+
+	if r.expired {
+		if unavailable := f.shortItems(r.items); len(unavailable) > 0 {
+			return unavailable, nil
+		}
+		f.take(r.items)
+	}
+	r.committed = true
+	return nil, nil
+}
+
+func (f *fakeInventory) ReleaseReservation(ctx context.Context, orderID string) error {
+	r, ok := f.reservations[orderID]
+	if !ok {
+		return service.ErrReservationNotFound
+	}
+
+	if !r.expired {
+		for _, item := range r.items {
+			f.stock[item.ProductID] += item.Quantity
+		}
+	}
+	r.expired = true
+	r.committed = false
+	return nil
+}
+
+func (f *fakeInventory) RestockItems(ctx context.Context, items []events.OrderItemEvent) error {
+	for _, item := range items {
+		if item.ProductID == f.failFor {
+			return errors.New("inventory unavailable")
+		}
+	}
+
+	for _, item := range items {
+		f.stock[item.ProductID] += item.Quantity
+	}
+	return nil
+}
+
+// expire lets a held reservation run out, handing its stock back.
+func (f *fakeInventory) expire(orderID string) {
+	r := f.reservations[orderID]
+	for _, item := range r.items {
+		f.stock[item.ProductID] += item.Quantity
+	}
+	r.expired = true
+}
+
+func (f *fakeInventory) shortItems(items []events.OrderItemEvent) []events.OrderItemEvent {
+	var unavailable []events.OrderItemEvent
+	for _, item := range items {
+		if f.stock[item.ProductID] < item.Quantity {
+			unavailable = append(unavailable, item)
+		}
+	}
+	return unavailable
+}
+
+func (f *fakeInventory) take(items []events.OrderItemEvent) {
+	for _, item := range items {
+		f.stock[item.ProductID] -= item.Quantity
+	}
+}
+
+type fakePublisher struct {
+	stockFailed []events.OrderStockFailedEvent
+}
+
+func (f *fakePublisher) PublishOrderStockFailed(event events.OrderStockFailedEvent) error {
+	f.stockFailed = append(f.stockFailed, event)
+	return nil
+}
+
+func newOrderCreatedEvent(eventID string) events.OrderCreatedEvent {
+	return events.OrderCreatedEvent{
+		EventID: eventID,
+		OrderID: "order123",
+		Items: []events.OrderItemEvent{
+			{ProductID: "bike-1", Quantity: 2},
+		},
+	}
+}
+
+func TestHandleOrderCreated_DuplicateEventIsNoop(t *testing.T) {
+	inventory := newFakeInventory(map[string]int{"bike-1": 10})
+	orderHandler := handler.NewOrderHandler(inventory, &fakePublisher{}, dedupe.NewMemoryStore())
+	event := newOrderCreatedEvent("event-1")
+
+	for i := 0; i < 3; i++ {
+		if err := orderHandler.HandleOrderCreated(context.Background(), event); err != nil {
+			t.Fatalf("Expected delivery %d to succeed, got error: %v", i+1, err)
+		}
+	}
+
+	if inventory.stock["bike-1"] != 8 {
+		t.Errorf("Expected stock to be held once leaving 8, got %d", inventory.stock["bike-1"])
+	}
+}
+
+func TestHandleOrderCreated_StockAlreadyHeldIsNoop(t *testing.T) {
+	inventory := newFakeInventory(map[string]int{"bike-1": 10})
+	event := newOrderCreatedEvent("event-1")
+
+	// order-service holds the stock when the order is placed
+	if _, err := inventory.HoldStock(context.Background(), event.OrderID, event.Items); err != nil {
+		t.Fatalf("Failed to hold stock: %v", err)
+	}
+
+	orderHandler := handler.NewOrderHandler(inventory, &fakePublisher{}, dedupe.NewMemoryStore())
+	if err := orderHandler.HandleOrderCreated(context.Background(), event); err != nil {
+		t.Fatalf("Expected event to succeed, got error: %v", err)
+	}
+
+	if inventory.holds != 1 || inventory.stock["bike-1"] != 8 {
+		t.Errorf("Expected the existing hold to be kept, got %d holds and stock %d", inventory.holds, inventory.stock["bike-1"])
+	}
+}
+
+func TestHandleOrderCreated_FailedEventCanBeRetried(t *testing.T) {
+	inventory := newFakeInventory(map[string]int{"bike-1": 10})
+	inventory.failFor = "bike-1"
+	orderHandler := handler.NewOrderHandler(inventory, &fakePublisher{}, dedupe.NewMemoryStore())
+	event := newOrderCreatedEvent("event-1")
+
+	if err := orderHandler.HandleOrderCreated(context.Background(), event); err == nil {
+		t.Fatalf("Expected error while inventory is unavailable, got none")
+	}
+
+	inventory.failFor = ""
+	if err := orderHandler.HandleOrderCreated(context.Background(), event); err != nil {
+		t.Fatalf("Expected retry to succeed, got error: %v", err)
+	}
+
+	if inventory.stock["bike-1"] != 8 {
+		t.Errorf("Expected stock 8 after retry, got %d", inventory.stock["bike-1"])
+	}
+}
+
+func TestHandleOrderCreated_EventsWithoutIDDedupeByOrder(t *testing.T) {
+	inventory := newFakeInventory(map[string]int{"bike-1": 10})
+	orderHandler := handler.NewOrderHandler(inventory, &fakePublisher{}, dedupe.NewMemoryStore())
+	event := newOrderCreatedEvent("")
+
+	_ = orderHandler.HandleOrderCreated(context.Background(), event)
+	_ = orderHandler.HandleOrderCreated(context.Background(), event)
+
+	if inventory.stock["bike-1"] != 8 {
+		t.Errorf("Expected stock to be held once leaving 8, got %d", inventory.stock["bike-1"])
+	}
+}
+
+func TestHandleOrderCreated_InsufficientStockReportsFailure(t *testing.T) {
+	inventory := newFakeInventory(map[string]int{"bike-1": 10, "helmet-1": 5, "bike-2": 1})
+	publisher := &fakePublisher{}
+	orderHandler := handler.NewOrderHandler(inventory, publisher, dedupe.NewMemoryStore())
+	event := events.OrderCreatedEvent{
+		EventID: "event-3",
+		OrderID: "order456",
+		Items: []events.OrderItemEvent{
+			{ProductID: "bike-1", Quantity: 2},
+			{ProductID: "helmet-1", Quantity: 1},
+			{ProductID: "bike-2", Quantity: 3},
+		},
+	}
+
+	if err := orderHandler.HandleOrderCreated(context.Background(), event); err != nil {
+		t.Fatalf("Expected stock failure to be handled, got error: %v", err)
+	}
+
+	if inventory.stock["bike-1"] != 10 || inventory.stock["helmet-1"] != 5 || inventory.stock["bike-2"] != 1 {
+		t.Errorf("Expected nothing to be held, got %v", inventory.stock)
+	}
+
+	if len(publisher.stockFailed) != 1 {
+		t.Fatalf("Expected one stock failed event, got %d", len(publisher.stockFailed))
+	}
+	stockFailed := publisher.stockFailed[0]
+	if stockFailed.OrderID != "order456" {
+		t.Errorf("Expected order ID order456, got %s", stockFailed.OrderID)
+	}
+	if stockFailed.EventID != "event-3:stock_failed" {
+		t.Errorf("Expected event ID event-3:stock_failed, got %s", stockFailed.EventID)
+	}
+	if len(stockFailed.UnavailableItems) != 1 || stockFailed.UnavailableItems[0].ProductID != "bike-2" {
+		t.Errorf("Expected bike-2 to be reported unavailable, got %v", stockFailed.UnavailableItems)
+	}
+}
+
+func TestHandleOrderUpdated_AdjustsReservationOnce(t *testing.T) {
+	inventory := newFakeInventory(map[string]int{"bike-1": 10, "helmet-1": 5})
+	orderHandler := handler.NewOrderHandler(inventory, &fakePublisher{}, dedupe.NewMemoryStore())
+
+	if err := orderHandler.HandleOrderCreated(context.Background(), newOrderCreatedEvent("event-1")); err != nil {
+		t.Fatalf("Failed to handle order created: %v", err)
+	}
+
+	event := events.OrderUpdatedEvent{
+		EventID: "event-8",
+		OrderID: "order123",
+		Items: []events.OrderItemEvent{
+			{ProductID: "bike-1", Quantity: 1},
+			{ProductID: "helmet-1", Quantity: 2},
+		},
+	}
+	for i := 0; i < 2; i++ {
+		if err := orderHandler.HandleOrderUpdated(context.Background(), event); err != nil {
+			t.Fatalf("Expected delivery %d to succeed, got error: %v", i+1, err)
+		}
+	}
+
+	if inventory.adjustments != 1 {
+		t.Errorf("Expected the reservation to be adjusted once, got %d", inventory.adjustments)
+	}
+	if inventory.stock["bike-1"] != 9 || inventory.stock["helmet-1"] != 3 {
+		t.Errorf("Expected stock of 9 bikes and 3 helmets, got %v", inventory.stock)
+	}
+}
+
+func TestHandleOrderUpdated_InsufficientStockReportsFailure(t *testing.T) {
+	inventory := newFakeInventory(map[string]int{"bike-1": 3})
+	publisher := &fakePublisher{}
+	orderHandler := handler.NewOrderHandler(inventory, publisher, dedupe.NewMemoryStore())
+
+	if err := orderHandler.HandleOrderCreated(context.Background(), newOrderCreatedEvent("event-1")); err != nil {
+		t.Fatalf("Failed to handle order created: %v", err)
+	}
+
+	event := events.OrderUpdatedEvent{
+		EventID: "event-9",
+		OrderID: "order123",
+		Items:   []events.OrderItemEvent{{ProductID: "bike-1", Quantity: 4}},
+	}
+	if err := orderHandler.HandleOrderUpdated(context.Background(), event); err != nil {
+		t.Fatalf("Expected stock failure to be handled, got error: %v", err)
+	}
+
+	if inventory.stock["bike-1"] != 1 {
+		t.Errorf("Expected the original hold to be kept leaving 1, got %d", inventory.stock["bike-1"])
+	}
+	if len(publisher.stockFailed) != 1 || publisher.stockFailed[0].EventID != "event-9:stock_failed" {
+		t.Errorf("Expected one stock failed event for event-9, got %v", publisher.stockFailed)
+	}
+}
+
+func TestHandleOrderUpdated_SettledReservationIsNoop(t *testing.T) {
+	inventory := newFakeInventory(map[string]int{"bike-1": 10})
+	publisher := &fakePublisher{}
+	orderHandler := handler.NewOrderHandler(inventory, publisher, dedupe.NewMemoryStore())
+
+	if err := orderHandler.HandleOrderCreated(context.Background(), newOrderCreatedEvent("event-1")); err != nil {
+		t.Fatalf("Failed to handle order created: %v", err)
+	}
+	if err := orderHandler.HandleOrderPaid(context.Background(), events.OrderPaidEvent{EventID: "event-5", OrderID: "order123"}); err != nil {
+		t.Fatalf("Failed to handle order paid: %v", err)
+	}
+
+	event := events.OrderUpdatedEvent{
+		EventID: "event-10",
+		OrderID: "order123",
+		Items:   []events.OrderItemEvent{{ProductID: "bike-1", Quantity: 5}},
+	}
+	if err := orderHandler.HandleOrderUpdated(context.Background(), event); err != nil {
+		t.Fatalf("Expected event to succeed, got error: %v", err)
+	}
+
+	if inventory.stock["bike-1"] != 8 || len(publisher.stockFailed) != 0 {
+		t.Errorf("Expected nothing to change, got stock %d and %d failures", inventory.stock["bike-1"], len(publisher.stockFailed))
+	}
+}
+
+func TestHandleOrderPaid_CommitsReservationOnce(t *testing.T) {
+	inventory := newFakeInventory(map[string]int{"bike-1": 10})
+	publisher := &fakePublisher{}
+	orderHandler := handler.NewOrderHandler(inventory, publisher, dedupe.NewMemoryStore())
+
+	if err := orderHandler.HandleOrderCreated(context.Background(), newOrderCreatedEvent("event-1")); err != nil {
+		t.Fatalf("Failed to handle order created: %v", err)
+	}
+
+	paid := events.OrderPaidEvent{EventID: "event-5", OrderID: "order123"}
+	for i := 0; i < 2; i++ {
+		if err := orderHandler.HandleOrderPaid(context.Background(), paid); err != nil {
+			t.Fatalf("Expected delivery %d to succeed, got error: %v", i+1, err)
+		}
+	}
+
+	if !inventory.reservations["order123"].committed {
+		t.Errorf("Expected reservation to be committed")
+	}
+	if inventory.stock["bike-1"] != 8 {
+		t.Errorf("Expected stock to stay at 8, got %d", inventory.stock["bike-1"])
+	}
+	if len(publisher.stockFailed) != 0 {
+		t.Errorf("Expected no stock failed event, got %d", len(publisher.stockFailed))
+	}
+}
+
+func TestHandleOrderPaid_ExpiredAndSoldReportsFailure(t *testing.T) {
+	inventory := newFakeInventory(map[string]int{"bike-1": 2})
+	publisher := &fakePublisher{}
+	orderHandler := handler.NewOrderHandler(inventory, publisher, dedupe.NewMemoryStore())
+
+	if err := orderHandler.HandleOrderCreated(context.Background(), newOrderCreatedEvent("event-1")); err != nil {
+		t.Fatalf("Failed to handle order created: %v", err)
+	}
+
+	// The hold runs out and another customer buys the bikes
+	inventory.expire("order123")
+	inventory.stock["bike-1"] = 0
+
+	if err := orderHandler.HandleOrderPaid(context.Background(), events.OrderPaidEvent{EventID: "event-6", OrderID: "order123"}); err != nil {
+		t.Fatalf("Expected stock failure to be handled, got error: %v", err)
+	}
+
+	if len(publisher.stockFailed) != 1 {
+		t.Fatalf("Expected one stock failed event, got %d", len(publisher.stockFailed))
+	}
+	if publisher.stockFailed[0].EventID != "event-6:stock_failed" {
+		t.Errorf("Expected event ID event-6:stock_failed, got %s", publisher.stockFailed[0].EventID)
+	}
+}
+
+func TestHandleOrderPaid_OrderWithoutReservationIsNoop(t *testing.T) {
+	inventory := newFakeInventory(map[string]int{"bike-1": 8})
+	publisher := &fakePublisher{}
+	orderHandler := handler.NewOrderHandler(inventory, publisher, dedupe.NewMemoryStore())
+
+	if err := orderHandler.HandleOrderPaid(context.Background(), events.OrderPaidEvent{EventID: "event-7", OrderID: "legacy-order"}); err != nil {
+		t.Fatalf("Expected event to succeed, got error: %v", err)
+	}
+
+	if inventory.stock["bike-1"] != 8 || len(publisher.stockFailed) != 0 {
+		t.Errorf("Expected nothing to change, got stock %d and %d failures", inventory.stock["bike-1"], len(publisher.stockFailed))
+	}
+}
+
+func TestHandleOrderCancelled_DuplicateEventIsNoop(t *testing.T) {
+	inventory := newFakeInventory(map[string]int{"bike-1": 10})
+	orderHandler := handler.NewOrderHandler(inventory, &fakePublisher{}, dedupe.NewMemoryStore())
+
+	if err := orderHandler.HandleOrderCreated(context.Background(), newOrderCreatedEvent("event-1")); err != nil {
+		t.Fatalf("Failed to handle order created: %v", err)
+	}
+
+	event := events.OrderCancelledEvent{
+		EventID: "event-2",
+		OrderID: "order123",
+		Items:   []events.OrderItemEvent{{ProductID: "bike-1", Quantity: 2}},
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := orderHandler.HandleOrderCancelled(context.Background(), event); err != nil {
+			t.Fatalf("Expected delivery %d to succeed, got error: %v", i+1, err)
+		}
+	}
+
+	if inventory.stock["bike-1"] != 10 {
+		t.Errorf("Expected stock to be restored once to 10, got %d", inventory.stock["bike-1"])
+	}
+}
+
+func TestHandleOrderCancelled_OrderWithoutReservationRestocksItems(t *testing.T) {
+	inventory := newFakeInventory(map[string]int{"bike-1": 8})
+	orderHandler := handler.NewOrderHandler(inventory, &fakePublisher{}, dedupe.NewMemoryStore())
+	event := events.OrderCancelledEvent{
+		EventID: "event-8",
+		OrderID: "legacy-order",
+		Items:   []events.OrderItemEvent{{ProductID: "bike-1", Quantity: 2}},
+	}
+
+	if err := orderHandler.HandleOrderCancelled(context.Background(), event); err != nil {
+		t.Fatalf("Expected event to succeed, got error: %v", err)
+	}
+
+	if inventory.stock["bike-1"] != 10 {
+		t.Errorf("Expected stock to be restored to 10, got %d", inventory.stock["bike-1"])
+	}
+}
+
+func TestHandleOrderReturned_RestocksItemsOnce(t *testing.T) {
+	inventory := newFakeInventory(map[string]int{"bike-1": 8, "bike-2": 3})
+	orderHandler := handler.NewOrderHandler(inventory, &fakePublisher{}, dedupe.NewMemoryStore())
+	event := events.OrderReturnedEvent{
+		EventID:  "event-9",
+		ReturnID: "return-1",
+		OrderID:  "order123",
+		Items: []events.OrderItemEvent{
+			{ProductID: "bike-1", Quantity: 1},
+			{ProductID: "bike-2", Quantity: 2},
+		},
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := orderHandler.HandleOrderReturned(context.Background(), event); err != nil {
+			t.Fatalf("Expected delivery %d to succeed, got error: %v", i+1, err)
+		}
+	}
+
+	if inventory.stock["bike-1"] != 9 || inventory.stock["bike-2"] != 5 {
+		t.Errorf("Expected returned items to be restocked once, got bike-1=%d bike-2=%d",
+			inventory.stock["bike-1"], inventory.stock["bike-2"])
+	}
+}
+
+func TestHandleOrderReturned_FailedRestockIsRetriedWhole(t *testing.T) {
+	inventory := newFakeInventory(map[string]int{"bike-1": 8, "bike-2": 3})
+	inventory.failFor = "bike-2"
+	orderHandler := handler.NewOrderHandler(inventory, &fakePublisher{}, dedupe.NewMemoryStore())
+	event := events.OrderReturnedEvent{
+		EventID:  "event-11",
+		ReturnID: "return-2",
+		OrderID:  "order123",
+		Items: []events.OrderItemEvent{
+			{ProductID: "bike-1", Quantity: 1},
+			{ProductID: "bike-2", Quantity: 2},
+		},
+	}
+
+	if err := orderHandler.HandleOrderReturned(context.Background(), event); err == nil {
+		t.Fatalf("Expected error while inventory is unavailable, got none")
+	}
+	if inventory.stock["bike-1"] != 8 {
+		t.Errorf("Expected nothing to be restocked, got bike-1=%d", inventory.stock["bike-1"])
+	}
+
+	inventory.failFor = ""
+	if err := orderHandler.HandleOrderReturned(context.Background(), event); err != nil {
+		t.Fatalf("Expected retry to succeed, got error: %v", err)
+	}
+
+	if inventory.stock["bike-1"] != 9 || inventory.stock["bike-2"] != 5 {
+		t.Errorf("Expected returned items to be restocked once, got bike-1=%d bike-2=%d",
+			inventory.stock["bike-1"], inventory.stock["bike-2"])
+	}
+}
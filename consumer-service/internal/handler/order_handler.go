@@ -2,46 +2,238 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"log"
 	"time"
 
+	"consumer-service/internal/dedupe"
 	"consumer-service/internal/events"
+	"consumer-service/internal/service"
 )
 
 type OrderHandler interface {
 	HandleOrderCreated(ctx context.Context, event events.OrderCreatedEvent) error
+	HandleOrderUpdated(ctx context.Context, event events.OrderUpdatedEvent) error
+	HandleOrderPaid(ctx context.Context, event events.OrderPaidEvent) error
+	HandleOrderCancelled(ctx context.Context, event events.OrderCancelledEvent) error
+	HandleOrderReturned(ctx context.Context, event events.OrderReturnedEvent) error
 }
 
 type InventoryServiceHandler interface {
-	DecreaseStock(ctx context.Context, productID string, quantity int) error
+	HoldStock(ctx context.Context, orderID string, items []events.OrderItemEvent) ([]events.OrderItemEvent, error)
+	AdjustReservation(ctx context.Context, orderID string, items []events.OrderItemEvent) ([]events.OrderItemEvent, error)
+	CommitReservation(ctx context.Context, orderID string) ([]events.OrderItemEvent, error)
+	ReleaseReservation(ctx context.Context, orderID string) error
+	RestockItems(ctx context.Context, items []events.OrderItemEvent) error
+}
+
+type OrderEventPublisher interface {
+	PublishOrderStockFailed(event events.OrderStockFailedEvent) error
 }
 
 type orderHandler struct {
 	inventoryService InventoryServiceHandler
+	publisher        OrderEventPublisher
+	dedupeStore      dedupe.Store
 }
 
-func NewOrderHandler(inventoryService InventoryServiceHandler) OrderHandler {
+func NewOrderHandler(inventoryService InventoryServiceHandler, publisher OrderEventPublisher, dedupeStore dedupe.Store) OrderHandler {
 	return &orderHandler{
 		inventoryService: inventoryService,
+		publisher:        publisher,
+		dedupeStore:      dedupeStore,
 	}
 }
 
 func (h *orderHandler) HandleOrderCreated(ctx context.Context, event events.OrderCreatedEvent) error {
-	log.Printf("[ORDER-HANDLER] Processing order %s", event.OrderID)
+	return h.once(ctx, dedupe.Key("order.created", event.EventID, event.OrderID), func() error {
+		return h.holdStock(ctx, event)
+	})
+}
+
+func (h *orderHandler) HandleOrderUpdated(ctx context.Context, event events.OrderUpdatedEvent) error {
+	return h.once(ctx, dedupe.Key("order.updated", event.EventID, event.OrderID), func() error {
+		return h.adjustStock(ctx, event)
+	})
+}
+
+func (h *orderHandler) HandleOrderPaid(ctx context.Context, event events.OrderPaidEvent) error {
+	return h.once(ctx, dedupe.Key("order.paid", event.EventID, event.OrderID), func() error {
+		return h.commitStock(ctx, event)
+	})
+}
 
-	// Update inventory for each item in the order
-	for _, item := range event.Items {
-		log.Printf("[ORDER-HANDLER] Updating stock for product %s, reducing by %d", item.ProductID, item.Quantity)
+func (h *orderHandler) HandleOrderCancelled(ctx context.Context, event events.OrderCancelledEvent) error {
+	return h.once(ctx, dedupe.Key("order.cancelled", event.EventID, event.OrderID), func() error {
+		return h.restock(ctx, event)
+	})
+}
+
+func (h *orderHandler) HandleOrderReturned(ctx context.Context, event events.OrderReturnedEvent) error {
+	return h.once(ctx, dedupe.Key("order.returned", event.EventID, event.OrderID), func() error {
+		return h.restockReturn(ctx, event)
+	})
+}
+
+// once runs process unless the event identified by key has already been handled.
+func (h *orderHandler) once(ctx context.Context, key string, process func() error) error {
+	if err := h.dedupeStore.Claim(ctx, key); err != nil {
+		if errors.Is(err, dedupe.ErrAlreadyProcessed) {
+			log.Printf("[ORDER-HANDLER] Skipping duplicate event %s", key)
+			return nil
+		}
+		return err
+	}
 
-		// Call inventory service to decrease stock
-		if err := h.inventoryService.DecreaseStock(ctx, item.ProductID, item.Quantity); err != nil {
-			log.Printf("[ORDER-HANDLER] Failed to decrease stock for product %s: %v", item.ProductID, err)
-			return err
+	if err := process(); err != nil {
+		if releaseErr := h.dedupeStore.Release(ctx, key); releaseErr != nil {
+			log.Printf("[ORDER-HANDLER] Failed to release event %s: %v", key, releaseErr)
 		}
+		return err
+	}
+
+	// The stock has moved, so a failure here must not make the event look unprocessed
+	if err := h.dedupeStore.Complete(ctx, key); err != nil {
+		log.Printf("[ORDER-HANDLER] Failed to mark event %s as processed: %v", key, err)
+	}
+	return nil
+}
+
+// holdStock makes sure the order's stock is reserved. order-service normally holds it when
+// the order is placed, in which case this is a no-op; when the stock simply isn't there,
+// order-service is told to cancel the order.
+func (h *orderHandler) holdStock(ctx context.Context, event events.OrderCreatedEvent) error {
+	log.Printf("[ORDER-HANDLER] Processing order %s", event.OrderID)
+
+	unavailable, err := h.inventoryService.HoldStock(ctx, event.OrderID, event.Items)
+	if err != nil {
+		// Transient failure, the whole order is retried later
+		log.Printf("[ORDER-HANDLER] Failed to hold stock for order %s: %v", event.OrderID, err)
+		return err
+	}
 
-		log.Printf("[ORDER-HANDLER] Successfully decreased stock for product %s", item.ProductID)
+	if len(unavailable) > 0 {
+		return h.publishStockFailed(event.EventID, event.OrderID, "insufficient stock", unavailable)
 	}
 
 	log.Printf("[ORDER-HANDLER] Successfully processed order %s at %s", event.OrderID, time.Now().Format(time.RFC3339))
 	return nil
 }
+
+// adjustStock makes sure the stock held for an edited order matches its new items.
+// order-service normally adjusts the hold before storing the edit, in which case this only
+// extends it; when the stock for the new items isn't there, order-service is told to cancel
+// the order.
+func (h *orderHandler) adjustStock(ctx context.Context, event events.OrderUpdatedEvent) error {
+	log.Printf("[ORDER-HANDLER] Adjusting stock for updated order %s", event.OrderID)
+
+	unavailable, err := h.inventoryService.AdjustReservation(ctx, event.OrderID, event.Items)
+	switch {
+	case errors.Is(err, service.ErrReservationNotFound):
+		// Orders placed before reservations had their stock taken when they were created
+		log.Printf("[ORDER-HANDLER] Order %s has no reservation, nothing to adjust", event.OrderID)
+		return nil
+	case errors.Is(err, service.ErrReservationReleased):
+		// The order was paid or cancelled since, which settled its stock
+		log.Printf("[ORDER-HANDLER] Order %s was updated after its stock was settled", event.OrderID)
+		return nil
+	case err != nil:
+		log.Printf("[ORDER-HANDLER] Failed to adjust stock for order %s: %v", event.OrderID, err)
+		return err
+	}
+
+	if len(unavailable) > 0 {
+		return h.publishStockFailed(event.EventID, event.OrderID, "insufficient stock", unavailable)
+	}
+
+	log.Printf("[ORDER-HANDLER] Successfully adjusted stock for order %s", event.OrderID)
+	return nil
+}
+
+// commitStock turns the stock held for a paid order into a sale.
+func (h *orderHandler) commitStock(ctx context.Context, event events.OrderPaidEvent) error {
+	log.Printf("[ORDER-HANDLER] Committing stock for paid order %s", event.OrderID)
+
+	unavailable, err := h.inventoryService.CommitReservation(ctx, event.OrderID)
+	switch {
+	case errors.Is(err, service.ErrReservationNotFound):
+		// Orders placed before reservations had their stock taken when they were created
+		log.Printf("[ORDER-HANDLER] Order %s has no reservation, nothing to commit", event.OrderID)
+		return nil
+	case errors.Is(err, service.ErrReservationReleased):
+		log.Printf("[ORDER-HANDLER] Order %s was paid after its stock was handed back", event.OrderID)
+		return nil
+	case err != nil:
+		log.Printf("[ORDER-HANDLER] Failed to commit stock for order %s: %v", event.OrderID, err)
+		return err
+	}
+
+	if len(unavailable) > 0 {
+		return h.publishStockFailed(event.EventID, event.OrderID, "reservation expired and the stock has been sold", unavailable)
+	}
+
+	log.Printf("[ORDER-HANDLER] Successfully committed stock for order %s", event.OrderID)
+	return nil
+}
+
+func (h *orderHandler) publishStockFailed(eventID, orderID, reason string, unavailable []events.OrderItemEvent) error {
+	if eventID == "" {
+		eventID = "order:" + orderID
+	}
+
+	stockFailed := events.OrderStockFailedEvent{
+		EventID:          eventID + ":stock_failed",
+		OrderID:          orderID,
+		Reason:           reason,
+		UnavailableItems: unavailable,
+		FailedAt:         time.Now(),
+	}
+
+	if err := h.publisher.PublishOrderStockFailed(stockFailed); err != nil {
+		log.Printf("[ORDER-HANDLER] Failed to publish stock failure for order %s: %v", orderID, err)
+		return err
+	}
+
+	log.Printf("[ORDER-HANDLER] Order %s cannot be fulfilled: %s", orderID, reason)
+	return nil
+}
+
+func (h *orderHandler) restock(ctx context.Context, event events.OrderCancelledEvent) error {
+	log.Printf("[ORDER-HANDLER] Restocking cancelled order %s (was %s)", event.OrderID, event.PreviousStatus)
+
+	// Held stock goes back to available, stock already sold goes back on hand
+	err := h.inventoryService.ReleaseReservation(ctx, event.OrderID)
+	if err == nil {
+		log.Printf("[ORDER-HANDLER] Successfully restocked order %s at %s", event.OrderID, time.Now().Format(time.RFC3339))
+		return nil
+	}
+	if !errors.Is(err, service.ErrReservationNotFound) {
+		log.Printf("[ORDER-HANDLER] Failed to release reservation for order %s: %v", event.OrderID, err)
+		return err
+	}
+
+	// Orders placed before reservations had their stock taken directly, so return the items,
+	// all at once so a retried event never restocks some of them twice
+	if err := h.inventoryService.RestockItems(ctx, event.Items); err != nil {
+		log.Printf("[ORDER-HANDLER] Failed to restock items of order %s: %v", event.OrderID, err)
+		return err
+	}
+
+	log.Printf("[ORDER-HANDLER] Successfully restocked order %s at %s", event.OrderID, time.Now().Format(time.RFC3339))
+	return nil
+}
+
+// restockReturn puts the items of a received return back on hand. The order's reservation
+// was committed long ago, so the items are added back to stock directly, all at once so a
+// retried event never restocks some of them twice.
+func (h *orderHandler) restockReturn(ctx context.Context, event events.OrderReturnedEvent) error {
+	log.Printf("[ORDER-HANDLER] Restocking return %s of order %s", event.ReturnID, event.OrderID)
+
+	if err := h.inventoryService.RestockItems(ctx, event.Items); err != nil {
+		log.Printf("[ORDER-HANDLER] Failed to restock return %s: %v", event.ReturnID, err)
+		return err
+	}
+
+	log.Printf("[ORDER-HANDLER] Successfully restocked return %s at %s", event.ReturnID, time.Now().Format(time.RFC3339))
+	return nil
+}
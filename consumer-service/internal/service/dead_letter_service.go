@@ -0,0 +1,207 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nats-io/nats.go"
+)
+
+const (
+	deadLetterPrefix   = "dlq."
+	deadLetterSubjects = deadLetterPrefix + orderSubjects
+
+	headerOriginalSubject = "Dlq-Original-Subject"
+	headerError           = "Dlq-Error"
+	headerAttempts        = "Dlq-Attempts"
+	headerPublishedAt     = "Dlq-Published-At"
+	headerFailedAt        = "Dlq-Failed-At"
+)
+
+var ErrDeadLetterNotFound = errors.New("dead letter not found")
+
+// DeadLetter is an order event the consumer gave up on, kept so it can be inspected and replayed.
+type DeadLetter struct {
+	Sequence    uint64    `json:"sequence"`
+	Subject     string    `json:"subject"`
+	Payload     []byte    `json:"payload"`
+	Error       string    `json:"error"`
+	Attempts    int       `json:"attempts"`
+	PublishedAt time.Time `json:"published_at"`
+	FailedAt    time.Time `json:"failed_at"`
+}
+
+type DeadLetterService interface {
+	Add(subject string, payload []byte, handleErr error, attempts int, publishedAt time.Time) error
+	List(limit int) ([]DeadLetter, error)
+	Get(sequence uint64) (DeadLetter, error)
+	// Replay publishes the event to its original subject again and removes it from the queue
+	Replay(sequence uint64) error
+	Discard(sequence uint64) error
+	Close()
+}
+
+type deadLetterService struct {
+	conn   *nats.Conn
+	js     nats.JetStreamContext
+	stream string
+}
+
+func NewDeadLetterService(natsURL, stream string) (DeadLetterService, error) {
+	conn, err := nats.Connect(natsURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS: %v", err)
+	}
+
+	js, err := conn.JetStream()
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to create JetStream context: %v", err)
+	}
+
+	s := &deadLetterService{
+		conn:   conn,
+		js:     js,
+		stream: stream,
+	}
+
+	if err := s.ensureStream(); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *deadLetterService) Close() {
+	if s.conn != nil {
+		s.conn.Close()
+	}
+}
+
+func (s *deadLetterService) ensureStream() error {
+	_, err := s.js.StreamInfo(s.stream)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, nats.ErrStreamNotFound) {
+		return fmt.Errorf("failed to look up stream %s: %v", s.stream, err)
+	}
+
+	_, err = s.js.AddStream(&nats.StreamConfig{
+		Name:     s.stream,
+		Subjects: []string{deadLetterSubjects},
+		Storage:  nats.FileStorage,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create stream %s: %v", s.stream, err)
+	}
+
+	log.Printf("[DEAD-LETTER] Created stream %s for %s", s.stream, deadLetterSubjects)
+	return nil
+}
+
+func (s *deadLetterService) Add(subject string, payload []byte, handleErr error, attempts int, publishedAt time.Time) error {
+	msg := nats.NewMsg(deadLetterPrefix + subject)
+	msg.Data = payload
+	msg.Header.Set(headerOriginalSubject, subject)
+	msg.Header.Set(headerError, handleErr.Error())
+	msg.Header.Set(headerAttempts, strconv.Itoa(attempts))
+	msg.Header.Set(headerPublishedAt, publishedAt.Format(time.RFC3339Nano))
+	msg.Header.Set(headerFailedAt, time.Now().Format(time.RFC3339Nano))
+
+	if _, err := s.js.PublishMsg(msg); err != nil {
+		return fmt.Errorf("failed to dead-letter %s event: %v", subject, err)
+	}
+
+	log.Printf("[DEAD-LETTER] Stored failed %s event after %d attempts: %v", subject, attempts, handleErr)
+	return nil
+}
+
+func (s *deadLetterService) List(limit int) ([]DeadLetter, error) {
+	info, err := s.js.StreamInfo(s.stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up stream %s: %v", s.stream, err)
+	}
+
+	var deadLetters []DeadLetter
+	if info.State.Msgs == 0 {
+		return deadLetters, nil
+	}
+
+	for seq := info.State.FirstSeq; seq <= info.State.LastSeq; seq++ {
+		if limit > 0 && len(deadLetters) >= limit {
+			break
+		}
+
+		deadLetter, err := s.Get(seq)
+		if errors.Is(err, ErrDeadLetterNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		deadLetters = append(deadLetters, deadLetter)
+	}
+
+	return deadLetters, nil
+}
+
+func (s *deadLetterService) Get(sequence uint64) (DeadLetter, error) {
+	raw, err := s.js.GetMsg(s.stream, sequence)
+	if err != nil {
+		if errors.Is(err, nats.ErrMsgNotFound) {
+			return DeadLetter{}, ErrDeadLetterNotFound
+		}
+		return DeadLetter{}, fmt.Errorf("failed to get dead letter %d: %v", sequence, err)
+	}
+
+	deadLetter := DeadLetter{
+		Sequence: raw.Sequence,
+		Subject:  raw.Header.Get(headerOriginalSubject),
+		Payload:  raw.Data,
+		Error:    raw.Header.Get(headerError),
+		FailedAt: raw.Time,
+	}
+	if deadLetter.Subject == "" {
+		deadLetter.Subject = strings.TrimPrefix(raw.Subject, deadLetterPrefix)
+	}
+	deadLetter.Attempts, _ = strconv.Atoi(raw.Header.Get(headerAttempts))
+	if publishedAt, err := time.Parse(time.RFC3339Nano, raw.Header.Get(headerPublishedAt)); err == nil {
+		deadLetter.PublishedAt = publishedAt
+	}
+	if failedAt, err := time.Parse(time.RFC3339Nano, raw.Header.Get(headerFailedAt)); err == nil {
+		deadLetter.FailedAt = failedAt
+	}
+
+	return deadLetter, nil
+}
+
+func (s *deadLetterService) Replay(sequence uint64) error {
+	deadLetter, err := s.Get(sequence)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.js.Publish(deadLetter.Subject, deadLetter.Payload); err != nil {
+		return fmt.Errorf("failed to replay dead letter %d: %v", sequence, err)
+	}
+
+	log.Printf("[DEAD-LETTER] Replayed dead letter %d to %s", sequence, deadLetter.Subject)
+	return s.Discard(sequence)
+}
+
+func (s *deadLetterService) Discard(sequence uint64) error {
+	if err := s.js.DeleteMsg(s.stream, sequence); err != nil {
+		if errors.Is(err, nats.ErrMsgNotFound) {
+			return ErrDeadLetterNotFound
+		}
+		return fmt.Errorf("failed to discard dead letter %d: %v", sequence, err)
+	}
+
+	return nil
+}
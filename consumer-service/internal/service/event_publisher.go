@@ -0,0 +1,60 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"consumer-service/internal/events"
+
+	"github.com/nats-io/nats.go"
+)
+
+type EventPublisher interface {
+	PublishOrderStockFailed(event events.OrderStockFailedEvent) error
+	Close()
+}
+
+type eventPublisher struct {
+	conn *nats.Conn
+	js   nats.JetStreamContext
+}
+
+func NewEventPublisher(natsURL string) (EventPublisher, error) {
+	conn, err := nats.Connect(natsURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS: %v", err)
+	}
+
+	js, err := conn.JetStream()
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to create JetStream context: %v", err)
+	}
+
+	return &eventPublisher{
+		conn: conn,
+		js:   js,
+	}, nil
+}
+
+func (p *eventPublisher) Close() {
+	if p.conn != nil {
+		p.conn.Close()
+	}
+}
+
+func (p *eventPublisher) PublishOrderStockFailed(event events.OrderStockFailedEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal stock failed event: %v", err)
+	}
+
+	// The event ID doubles as the message ID so a retried publish is stored once
+	if _, err := p.js.Publish(SubjectOrderStockFailed, data, nats.MsgId(event.EventID)); err != nil {
+		return fmt.Errorf("failed to publish stock failed event: %v", err)
+	}
+
+	log.Printf("[NATS-PRODUCER] Published stock failure for order %s", event.OrderID)
+	return nil
+}
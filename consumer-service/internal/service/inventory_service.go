@@ -2,18 +2,42 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
+	"consumer-service/internal/events"
 	inventorypb "proto/inventory"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// ErrReservationNotFound means no stock was ever held for the order, e.g. it was placed
+	// before reservations existed and its stock was taken directly.
+	ErrReservationNotFound = errors.New("reservation not found")
+	// ErrReservationReleased means the order's stock was already handed back.
+	ErrReservationReleased = errors.New("reservation has been released")
 )
 
 type InventoryService interface {
-	DecreaseStock(ctx context.Context, productID string, quantity int) error
+	// HoldStock reserves the items for the order and returns the items that are not available.
+	// Holding again for the same order is a no-op.
+	HoldStock(ctx context.Context, orderID string, items []events.OrderItemEvent) ([]events.OrderItemEvent, error)
+	// CommitReservation turns the order's reservation into a sale and returns the items that
+	// could not be taken because the reservation expired and the stock has been sold since.
+	CommitReservation(ctx context.Context, orderID string) ([]events.OrderItemEvent, error)
+	// AdjustReservation changes what is held for the order to items and returns the items that
+	// are not available. Nothing changes unless all of them are.
+	AdjustReservation(ctx context.Context, orderID string, items []events.OrderItemEvent) ([]events.OrderItemEvent, error)
+	ReleaseReservation(ctx context.Context, orderID string) error
+	// RestockItems puts all of the items back on hand in one transaction, or none of them.
+	RestockItems(ctx context.Context, items []events.OrderItemEvent) error
 	Close()
 }
 
@@ -36,44 +60,147 @@ func NewInventoryService(inventoryURL string) (InventoryService, error) {
 	}, nil
 }
 
-func (s *inventoryService) DecreaseStock(ctx context.Context, productID string, quantity int) error {
-	log.Printf("[INVENTORY-SERVICE] Decreasing stock for product %s by %d", productID, quantity)
+func (s *inventoryService) HoldStock(ctx context.Context, orderID string, items []events.OrderItemEvent) ([]events.OrderItemEvent, error) {
+	log.Printf("[INVENTORY-SERVICE] Holding stock for order %s", orderID)
 
 	// Set timeout for the gRPC call
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	// Get current product info
-	product, err := s.productClient.GetProduct(ctx, &inventorypb.ProductIDRequest{
-		Id: productID,
+	// Holding is all-or-nothing, so a failure never leaves part of the order reserved
+	resp, err := s.productClient.HoldStock(ctx, &inventorypb.HoldStockRequest{
+		OrderId: orderID,
+		Items:   mapProductQuantities(items),
 	})
 	if err != nil {
-		return fmt.Errorf("failed to get product: %v", err)
+		return nil, fmt.Errorf("failed to hold stock: %v", err)
 	}
 
-	// Calculate new stock
-	newStock := product.Stock - int32(quantity)
-	if newStock < 0 {
-		return fmt.Errorf("insufficient stock for product %s", productID)
+	if !resp.Success {
+		return mapUnavailableItems(resp.UnavailableItems), nil
 	}
 
-	// Update product with new stock
-	_, err = s.productClient.UpdateProduct(ctx, &inventorypb.UpdateProductRequest{
-		Id:          productID,
-		Name:        product.Name,
-		Description: product.Description,
-		Price:       product.Price,
-		Stock:       newStock,
-		CategoryId:  product.CategoryId,
+	log.Printf("[INVENTORY-SERVICE] Successfully held stock for order %s", orderID)
+	return nil, nil
+}
+
+func (s *inventoryService) AdjustReservation(ctx context.Context, orderID string, items []events.OrderItemEvent) ([]events.OrderItemEvent, error) {
+	log.Printf("[INVENTORY-SERVICE] Adjusting reservation for order %s", orderID)
+
+	// Set timeout for the gRPC call
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := s.productClient.AdjustReservation(ctx, &inventorypb.HoldStockRequest{
+		OrderId: orderID,
+		Items:   mapProductQuantities(items),
 	})
 	if err != nil {
-		return fmt.Errorf("failed to update product stock: %v", err)
+		return nil, reservationError(orderID, "adjust", err)
+	}
+
+	if !resp.Success {
+		return mapUnavailableItems(resp.UnavailableItems), nil
+	}
+
+	log.Printf("[INVENTORY-SERVICE] Successfully adjusted reservation for order %s", orderID)
+	return nil, nil
+}
+
+func (s *inventoryService) CommitReservation(ctx context.Context, orderID string) ([]events.OrderItemEvent, error) {
+	log.Printf("[INVENTORY-SERVICE] Committing reservation for order %s", orderID)
+
+	// Set timeout for the gRPC call
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := s.productClient.CommitReservation(ctx, &inventorypb.ReservationRequest{OrderId: orderID})
+	if err != nil {
+		return nil, reservationError(orderID, "commit", err)
 	}
 
-	log.Printf("[INVENTORY-SERVICE] Successfully decreased stock for product %s to %d", productID, newStock)
+	if !resp.Success {
+		return mapUnavailableItems(resp.UnavailableItems), nil
+	}
+
+	log.Printf("[INVENTORY-SERVICE] Successfully committed reservation for order %s", orderID)
+	return nil, nil
+}
+
+func (s *inventoryService) ReleaseReservation(ctx context.Context, orderID string) error {
+	log.Printf("[INVENTORY-SERVICE] Releasing reservation for order %s", orderID)
+
+	// Set timeout for the gRPC call
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if _, err := s.productClient.ReleaseReservation(ctx, &inventorypb.ReservationRequest{OrderId: orderID}); err != nil {
+		return reservationError(orderID, "release", err)
+	}
+
+	log.Printf("[INVENTORY-SERVICE] Successfully released reservation for order %s", orderID)
 	return nil
 }
 
+func (s *inventoryService) RestockItems(ctx context.Context, items []events.OrderItemEvent) error {
+	log.Printf("[INVENTORY-SERVICE] Restocking %d items", len(items))
+
+	// Set timeout for the gRPC call
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := s.productClient.ReleaseStock(ctx, &inventorypb.StockAdjustmentRequest{
+		Items: mapProductQuantities(items),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to release stock: %v", err)
+	}
+
+	if !resp.Success {
+		missing := make([]string, 0, len(resp.UnavailableItems))
+		for _, item := range resp.UnavailableItems {
+			missing = append(missing, item.ProductId)
+		}
+		return fmt.Errorf("products not found: %s", strings.Join(missing, ", "))
+	}
+
+	log.Printf("[INVENTORY-SERVICE] Successfully restocked %d items", len(items))
+	return nil
+}
+
+func reservationError(orderID, action string, err error) error {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return fmt.Errorf("%w for order %s", ErrReservationNotFound, orderID)
+	case codes.FailedPrecondition:
+		return fmt.Errorf("%w for order %s", ErrReservationReleased, orderID)
+	default:
+		return fmt.Errorf("failed to %s reservation: %v", action, err)
+	}
+}
+
+func mapProductQuantities(items []events.OrderItemEvent) []*inventorypb.ProductQuantity {
+	quantities := make([]*inventorypb.ProductQuantity, 0, len(items))
+	for _, item := range items {
+		quantities = append(quantities, &inventorypb.ProductQuantity{
+			ProductId: item.ProductID,
+			Quantity:  int32(item.Quantity),
+		})
+	}
+	return quantities
+}
+
+func mapUnavailableItems(items []*inventorypb.ProductQuantity) []events.OrderItemEvent {
+	unavailable := make([]events.OrderItemEvent, 0, len(items))
+	for _, item := range items {
+		unavailable = append(unavailable, events.OrderItemEvent{
+			ProductID: item.ProductId,
+			Quantity:  int(item.Quantity),
+		})
+	}
+	return unavailable
+}
+
 func (s *inventoryService) Close() {
 	if s.conn != nil {
 		_ = s.conn.Close()
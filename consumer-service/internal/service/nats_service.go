@@ -3,8 +3,10 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"sync"
 	"time"
 
 	"consumer-service/internal/events"
@@ -12,8 +14,25 @@ import (
 	"github.com/nats-io/nats.go"
 )
 
+const (
+	SubjectOrderCreated     = "bicycle.order.created"
+	SubjectOrderPaid        = "bicycle.order.paid"
+	SubjectOrderCancelled   = "bicycle.order.cancelled"
+	SubjectOrderStockFailed = "bicycle.order.stock_failed"
+	SubjectOrderReturned    = "bicycle.order.returned"
+	SubjectOrderUpdated     = "bicycle.order.updated"
+
+	orderSubjects = "bicycle.order.*"
+	fetchBatch    = 10
+	fetchWait     = 5 * time.Second
+)
+
 type OrderEventHandler interface {
 	HandleOrderCreated(ctx context.Context, event events.OrderCreatedEvent) error
+	HandleOrderUpdated(ctx context.Context, event events.OrderUpdatedEvent) error
+	HandleOrderPaid(ctx context.Context, event events.OrderPaidEvent) error
+	HandleOrderCancelled(ctx context.Context, event events.OrderCancelledEvent) error
+	HandleOrderReturned(ctx context.Context, event events.OrderReturnedEvent) error
 }
 
 type NatsService interface {
@@ -21,57 +40,271 @@ type NatsService interface {
 	Close()
 }
 
+// ConsumerConfig describes the JetStream stream and durable consumer order events are read from.
+// MaxDeliver is the number of deliveries after which an event is dead-lettered; JetStream itself
+// redelivers without limit, so an event the dead-letter queue could not take is never dropped.
+type ConsumerConfig struct {
+	Stream      string
+	Durable     string
+	MaxDeliver  int
+	AckWait     time.Duration
+	NakDelay    time.Duration
+	MaxNakDelay time.Duration
+}
+
 type natsService struct {
-	conn         *nats.Conn
-	subscription *nats.Subscription
-	handler      OrderEventHandler
+	conn          *nats.Conn
+	js            nats.JetStreamContext
+	cfg           ConsumerConfig
+	subscriptions []*nats.Subscription
+	handler       OrderEventHandler
+	deadLetters   DeadLetterService
+	wg            sync.WaitGroup
 }
 
-func NewNatsService(natsURL string, orderHandler OrderEventHandler) (NatsService, error) {
+func NewNatsService(natsURL string, cfg ConsumerConfig, orderHandler OrderEventHandler, deadLetters DeadLetterService) (NatsService, error) {
 	conn, err := nats.Connect(natsURL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to NATS: %v", err)
 	}
 
+	js, err := conn.JetStream()
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to create JetStream context: %v", err)
+	}
+
 	return &natsService{
-		conn:    conn,
-		handler: orderHandler,
+		conn:        conn,
+		js:          js,
+		cfg:         cfg,
+		handler:     orderHandler,
+		deadLetters: deadLetters,
 	}, nil
 }
 
 func (s *natsService) StartConsuming(ctx context.Context) error {
-	sub, err := s.conn.Subscribe("bicycle.order.created", func(msg *nats.Msg) {
-		log.Printf("[NATS-CONSUMER] Received bicycle order from subject %s at %s", msg.Subject, time.Now().Format(time.RFC3339))
+	if err := s.ensureStream(); err != nil {
+		return err
+	}
+
+	if err := s.ensureConsumer(); err != nil {
+		return err
+	}
+
+	// Bind to the consumer created above so closing the subscription never deletes it
+	sub, err := s.js.PullSubscribe(orderSubjects, s.cfg.Durable, nats.Bind(s.cfg.Stream, s.cfg.Durable))
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to %s: %v", orderSubjects, err)
+	}
+	s.subscriptions = append(s.subscriptions, sub)
+
+	s.wg.Add(1)
+	go s.consume(ctx, sub)
+
+	log.Printf("[NATS-CONSUMER] Consuming %s from stream %s as %s", orderSubjects, s.cfg.Stream, s.cfg.Durable)
+	return nil
+}
+
+func (s *natsService) Close() {
+	for _, sub := range s.subscriptions {
+		_ = sub.Unsubscribe()
+	}
+	s.wg.Wait()
+	if s.conn != nil {
+		s.conn.Close()
+	}
+}
+
+func (s *natsService) ensureStream() error {
+	_, err := s.js.StreamInfo(s.cfg.Stream)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, nats.ErrStreamNotFound) {
+		return fmt.Errorf("failed to look up stream %s: %v", s.cfg.Stream, err)
+	}
+
+	_, err = s.js.AddStream(&nats.StreamConfig{
+		Name:     s.cfg.Stream,
+		Subjects: []string{orderSubjects},
+		Storage:  nats.FileStorage,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create stream %s: %v", s.cfg.Stream, err)
+	}
+
+	log.Printf("[NATS-CONSUMER] Created stream %s for %s", s.cfg.Stream, orderSubjects)
+	return nil
+}
+
+func (s *natsService) ensureConsumer() error {
+	consumerCfg := &nats.ConsumerConfig{
+		Durable:       s.cfg.Durable,
+		FilterSubject: orderSubjects,
+		DeliverPolicy: nats.DeliverAllPolicy,
+		AckPolicy:     nats.AckExplicitPolicy,
+		AckWait:       s.cfg.AckWait,
+		MaxDeliver:    -1,
+	}
+
+	_, err := s.js.ConsumerInfo(s.cfg.Stream, s.cfg.Durable)
+	switch {
+	case err == nil:
+		_, err = s.js.UpdateConsumer(s.cfg.Stream, consumerCfg)
+	case errors.Is(err, nats.ErrConsumerNotFound):
+		_, err = s.js.AddConsumer(s.cfg.Stream, consumerCfg)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to set up consumer %s: %v", s.cfg.Durable, err)
+	}
+
+	return nil
+}
+
+func (s *natsService) consume(ctx context.Context, sub *nats.Subscription) {
+	defer s.wg.Done()
 
+	for ctx.Err() == nil && sub.IsValid() {
+		msgs, err := sub.Fetch(fetchBatch, nats.MaxWait(fetchWait))
+		if err != nil {
+			if errors.Is(err, nats.ErrTimeout) || errors.Is(err, context.DeadlineExceeded) {
+				continue
+			}
+			if errors.Is(err, nats.ErrBadSubscription) || errors.Is(err, nats.ErrConnectionClosed) {
+				return
+			}
+			log.Printf("[NATS-CONSUMER] Failed to fetch order events: %v", err)
+			time.Sleep(time.Second)
+			continue
+		}
+
+		for _, msg := range msgs {
+			s.handleMessage(ctx, msg)
+		}
+	}
+}
+
+// handleMessage acks a processed event, NAKs a failed one with backoff and
+// dead-letters one that can never be processed. An event the dead-letter queue
+// cannot take is NAKed like any other failure and dead-lettered on a later delivery.
+func (s *natsService) handleMessage(ctx context.Context, msg *nats.Msg) {
+	delivered := uint64(1)
+	publishedAt := time.Now()
+	if meta, err := msg.Metadata(); err == nil {
+		delivered = meta.NumDelivered
+		publishedAt = meta.Timestamp
+	}
+
+	log.Printf("[NATS-CONSUMER] Received bicycle order event from subject %s (delivery %d) at %s",
+		msg.Subject, delivered, time.Now().Format(time.RFC3339))
+
+	err := s.dispatch(ctx, msg)
+	if err == nil {
+		if ackErr := msg.Ack(); ackErr != nil {
+			log.Printf("[NATS-CONSUMER] Failed to ack %s event: %v", msg.Subject, ackErr)
+		}
+		return
+	}
+
+	var decodeErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	malformed := errors.As(err, &decodeErr) || errors.As(err, &typeErr)
+	exhausted := s.cfg.MaxDeliver > 0 && delivered >= uint64(s.cfg.MaxDeliver)
+
+	if malformed || exhausted {
+		log.Printf("[NATS-CONSUMER] Giving up on %s event after %d deliveries: %v", msg.Subject, delivered, err)
+
+		dlqErr := s.deadLetters.Add(msg.Subject, msg.Data, err, int(delivered), publishedAt)
+		if dlqErr == nil {
+			_ = msg.Term()
+			return
+		}
+		log.Printf("[NATS-CONSUMER] %v", dlqErr)
+	}
+
+	delay := s.nakDelay(delivered)
+	log.Printf("[NATS-CONSUMER] Failed to handle %s event, retrying in %s: %v", msg.Subject, delay, err)
+	if nakErr := msg.NakWithDelay(delay); nakErr != nil {
+		log.Printf("[NATS-CONSUMER] Failed to nak %s event: %v", msg.Subject, nakErr)
+	}
+}
+
+func (s *natsService) dispatch(ctx context.Context, msg *nats.Msg) error {
+	switch msg.Subject {
+	case SubjectOrderCreated:
 		var orderEvent events.OrderCreatedEvent
 		if err := json.Unmarshal(msg.Data, &orderEvent); err != nil {
-			log.Printf("[NATS-CONSUMER] Failed to unmarshal bicycle order event: %v", err)
-			return
+			return err
 		}
 
 		log.Printf("[NATS-CONSUMER] Processing bicycle order %s created at %s",
 			orderEvent.OrderID, orderEvent.CreatedAt.Format(time.RFC3339))
 
 		if err := s.handler.HandleOrderCreated(ctx, orderEvent); err != nil {
-			log.Printf("[NATS-CONSUMER] Failed to handle bicycle order created event: %v", err)
-			return
+			return err
 		}
 
 		log.Printf("[NATS-CONSUMER] Successfully processed bicycle order %s", orderEvent.OrderID)
-	})
-	if err != nil {
-		return fmt.Errorf("failed to subscribe to bicycle.order.created: %v", err)
+	case SubjectOrderUpdated:
+		var updatedEvent events.OrderUpdatedEvent
+		if err := json.Unmarshal(msg.Data, &updatedEvent); err != nil {
+			return err
+		}
+
+		if err := s.handler.HandleOrderUpdated(ctx, updatedEvent); err != nil {
+			return err
+		}
+
+		log.Printf("[NATS-CONSUMER] Successfully adjusted stock for updated bicycle order %s", updatedEvent.OrderID)
+	case SubjectOrderPaid:
+		var paidEvent events.OrderPaidEvent
+		if err := json.Unmarshal(msg.Data, &paidEvent); err != nil {
+			return err
+		}
+
+		if err := s.handler.HandleOrderPaid(ctx, paidEvent); err != nil {
+			return err
+		}
+
+		log.Printf("[NATS-CONSUMER] Successfully committed stock for paid bicycle order %s", paidEvent.OrderID)
+	case SubjectOrderCancelled:
+		var cancelledEvent events.OrderCancelledEvent
+		if err := json.Unmarshal(msg.Data, &cancelledEvent); err != nil {
+			return err
+		}
+
+		if err := s.handler.HandleOrderCancelled(ctx, cancelledEvent); err != nil {
+			return err
+		}
+
+		log.Printf("[NATS-CONSUMER] Successfully restocked cancelled bicycle order %s", cancelledEvent.OrderID)
+	case SubjectOrderReturned:
+		var returnedEvent events.OrderReturnedEvent
+		if err := json.Unmarshal(msg.Data, &returnedEvent); err != nil {
+			return err
+		}
+
+		if err := s.handler.HandleOrderReturned(ctx, returnedEvent); err != nil {
+			return err
+		}
+
+		log.Printf("[NATS-CONSUMER] Successfully restocked return %s of bicycle order %s", returnedEvent.ReturnID, returnedEvent.OrderID)
+	default:
+		// Other order events are meant for other services
 	}
 
-	s.subscription = sub
 	return nil
 }
 
-func (s *natsService) Close() {
-	if s.subscription != nil {
-		_ = s.subscription.Unsubscribe()
+// nakDelay doubles the redelivery delay for every failed delivery, capped at MaxNakDelay.
+func (s *natsService) nakDelay(delivered uint64) time.Duration {
+	delay := s.cfg.NakDelay
+	for i := uint64(1); i < delivered && delay < s.cfg.MaxNakDelay; i++ {
+		delay *= 2
 	}
-	if s.conn != nil {
-		s.conn.Close()
+	if delay > s.cfg.MaxNakDelay {
+		delay = s.cfg.MaxNakDelay
 	}
+	return delay
 }
@@ -0,0 +1,340 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os/exec"
+	"sync"
+	"testing"
+	"time"
+
+	"consumer-service/internal/events"
+	"consumer-service/internal/service"
+
+	"github.com/nats-io/nats.go"
+)
+
+type recordingHandler struct {
+	mu       sync.Mutex
+	failures map[string]int
+	calls    map[string]int
+	handled  map[string]bool
+}
+
+func newRecordingHandler() *recordingHandler {
+	return &recordingHandler{
+		failures: make(map[string]int),
+		calls:    make(map[string]int),
+		handled:  make(map[string]bool),
+	}
+}
+
+func (h *recordingHandler) HandleOrderCreated(ctx context.Context, event events.OrderCreatedEvent) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.calls[event.OrderID]++
+	if h.failures[event.OrderID] > 0 {
+		h.failures[event.OrderID]--
+		return errors.New("inventory unavailable")
+	}
+	h.handled[event.OrderID] = true
+	return nil
+}
+
+func (h *recordingHandler) HandleOrderUpdated(ctx context.Context, event events.OrderUpdatedEvent) error {
+	return nil
+}
+
+func (h *recordingHandler) HandleOrderPaid(ctx context.Context, event events.OrderPaidEvent) error {
+	return nil
+}
+
+func (h *recordingHandler) HandleOrderCancelled(ctx context.Context, event events.OrderCancelledEvent) error {
+	return nil
+}
+
+func (h *recordingHandler) HandleOrderReturned(ctx context.Context, event events.OrderReturnedEvent) error {
+	return nil
+}
+
+func (h *recordingHandler) callCount(orderID string) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.calls[orderID]
+}
+
+func (h *recordingHandler) wasHandled(orderID string) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.handled[orderID]
+}
+
+type natsServer struct {
+	t        *testing.T
+	url      string
+	port     int
+	storeDir string
+	cmd      *exec.Cmd
+}
+
+func startNatsServer(t *testing.T) *natsServer {
+	if _, err := exec.LookPath("nats-server"); err != nil {
+		t.Skip("nats-server binary not found in PATH")
+	}
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to find free port: %v", err)
+	}
+	port := lis.Addr().(*net.TCPAddr).Port
+	lis.Close()
+
+	s := &natsServer{
+		t:        t,
+		url:      fmt.Sprintf("nats://127.0.0.1:%d", port),
+		port:     port,
+		storeDir: t.TempDir(),
+	}
+	s.start()
+	t.Cleanup(s.stop)
+	return s
+}
+
+func (s *natsServer) start() {
+	s.cmd = exec.Command("nats-server", "-js", "-a", "127.0.0.1", "-p", fmt.Sprint(s.port), "-sd", s.storeDir)
+	if err := s.cmd.Start(); err != nil {
+		s.t.Fatalf("Failed to start nats-server: %v", err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if conn, err := nats.Connect(s.url); err == nil {
+			conn.Close()
+			return
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	s.t.Fatalf("nats-server did not start on %s", s.url)
+}
+
+func (s *natsServer) stop() {
+	if s.cmd != nil && s.cmd.Process != nil {
+		_ = s.cmd.Process.Kill()
+		_ = s.cmd.Wait()
+		s.cmd = nil
+	}
+}
+
+func testConsumerConfig() service.ConsumerConfig {
+	return service.ConsumerConfig{
+		Stream:      "BICYCLE_ORDERS",
+		Durable:     "consumer-service-test",
+		MaxDeliver:  5,
+		AckWait:     5 * time.Second,
+		NakDelay:    100 * time.Millisecond,
+		MaxNakDelay: 500 * time.Millisecond,
+	}
+}
+
+func startDeadLetters(t *testing.T, url string) service.DeadLetterService {
+	deadLetterService, err := service.NewDeadLetterService(url, "BICYCLE_ORDERS_DLQ")
+	if err != nil {
+		t.Fatalf("Failed to create dead-letter service: %v", err)
+	}
+	t.Cleanup(deadLetterService.Close)
+	return deadLetterService
+}
+
+// flakyDeadLetters fails the first failures writes to the dead-letter queue
+type flakyDeadLetters struct {
+	service.DeadLetterService
+	mu       sync.Mutex
+	failures int
+	attempts int
+}
+
+func (d *flakyDeadLetters) Add(subject string, payload []byte, handleErr error, attempts int, publishedAt time.Time) error {
+	d.mu.Lock()
+	d.attempts++
+	fail := d.attempts <= d.failures
+	d.mu.Unlock()
+
+	if fail {
+		return errors.New("dead-letter stream unavailable")
+	}
+	return d.DeadLetterService.Add(subject, payload, handleErr, attempts, publishedAt)
+}
+
+func (d *flakyDeadLetters) attemptCount() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.attempts
+}
+
+func startConsumer(t *testing.T, url string, handler service.OrderEventHandler) service.NatsService {
+	return startConsumerWithDeadLetters(t, url, handler, startDeadLetters(t, url))
+}
+
+func startConsumerWithDeadLetters(t *testing.T, url string, handler service.OrderEventHandler, deadLetters service.DeadLetterService) service.NatsService {
+	natsService, err := service.NewNatsService(url, testConsumerConfig(), handler, deadLetters)
+	if err != nil {
+		t.Fatalf("Failed to create NATS service: %v", err)
+	}
+
+	if err := natsService.StartConsuming(context.Background()); err != nil {
+		t.Fatalf("Failed to start consuming: %v", err)
+	}
+	return natsService
+}
+
+func publishOrderCreated(t *testing.T, url, orderID string) {
+	conn, err := nats.Connect(url)
+	if err != nil {
+		t.Fatalf("Failed to connect to NATS: %v", err)
+	}
+	defer conn.Close()
+
+	js, err := conn.JetStream()
+	if err != nil {
+		t.Fatalf("Failed to create JetStream context: %v", err)
+	}
+
+	data, _ := json.Marshal(events.OrderCreatedEvent{
+		OrderID:   orderID,
+		UserID:    "user123",
+		Status:    "pending",
+		Items:     []events.OrderItemEvent{{ProductID: "bike-1", Quantity: 1}},
+		CreatedAt: time.Now(),
+	})
+	if _, err := js.Publish(service.SubjectOrderCreated, data); err != nil {
+		t.Fatalf("Failed to publish order event: %v", err)
+	}
+}
+
+func waitFor(t *testing.T, description string, condition func() bool) {
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		if condition() {
+			return
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	t.Fatalf("Timed out waiting for %s", description)
+}
+
+func TestNatsService_RedeliversFailedEvents(t *testing.T) {
+	server := startNatsServer(t)
+	handler := newRecordingHandler()
+	handler.failures["order-retry"] = 2
+
+	natsService := startConsumer(t, server.url, handler)
+	defer natsService.Close()
+
+	publishOrderCreated(t, server.url, "order-retry")
+
+	waitFor(t, "order-retry to be handled", func() bool { return handler.wasHandled("order-retry") })
+	if calls := handler.callCount("order-retry"); calls != 3 {
+		t.Errorf("Expected 3 deliveries, got %d", calls)
+	}
+}
+
+func TestNatsService_DeadLettersAfterMaxDeliver(t *testing.T) {
+	server := startNatsServer(t)
+	handler := newRecordingHandler()
+	handler.failures["order-stuck"] = 5
+
+	natsService := startConsumer(t, server.url, handler)
+	defer natsService.Close()
+
+	publishOrderCreated(t, server.url, "order-stuck")
+
+	deadLetterService := startDeadLetters(t, server.url)
+	var deadLetters []service.DeadLetter
+	waitFor(t, "order-stuck to be dead-lettered", func() bool {
+		deadLetters, _ = deadLetterService.List(0)
+		return len(deadLetters) == 1
+	})
+
+	if calls := handler.callCount("order-stuck"); calls != 5 {
+		t.Errorf("Expected delivery to stop after 5 attempts, got %d", calls)
+	}
+	deadLetter := deadLetters[0]
+	if deadLetter.Subject != service.SubjectOrderCreated {
+		t.Errorf("Expected subject %s, got %s", service.SubjectOrderCreated, deadLetter.Subject)
+	}
+	if deadLetter.Attempts != 5 {
+		t.Errorf("Expected 5 attempts, got %d", deadLetter.Attempts)
+	}
+	if deadLetter.Error != "inventory unavailable" {
+		t.Errorf("Expected handler error to be recorded, got %q", deadLetter.Error)
+	}
+
+	// Once the underlying problem is fixed a replay goes through the normal consumer
+	if err := deadLetterService.Replay(deadLetter.Sequence); err != nil {
+		t.Fatalf("Failed to replay dead letter: %v", err)
+	}
+	waitFor(t, "order-stuck to be handled after replay", func() bool { return handler.wasHandled("order-stuck") })
+
+	if remaining, _ := deadLetterService.List(0); len(remaining) != 0 {
+		t.Errorf("Expected replayed dead letter to be removed, got %d", len(remaining))
+	}
+}
+
+func TestNatsService_RedeliversWhenDeadLetteringFails(t *testing.T) {
+	server := startNatsServer(t)
+	handler := newRecordingHandler()
+	handler.failures["order-dlq-down"] = 7
+
+	deadLetters := &flakyDeadLetters{DeadLetterService: startDeadLetters(t, server.url), failures: 2}
+	natsService := startConsumerWithDeadLetters(t, server.url, handler, deadLetters)
+	defer natsService.Close()
+
+	publishOrderCreated(t, server.url, "order-dlq-down")
+
+	deadLetterService := startDeadLetters(t, server.url)
+	var stored []service.DeadLetter
+	waitFor(t, "order-dlq-down to be dead-lettered", func() bool {
+		stored, _ = deadLetterService.List(0)
+		return len(stored) == 1
+	})
+
+	// Deliveries 5 and 6 could not be dead-lettered and were NAKed, the 7th was stored
+	if calls := handler.callCount("order-dlq-down"); calls != 7 {
+		t.Errorf("Expected delivery to stop after 7 attempts, got %d", calls)
+	}
+	if attempts := deadLetters.attemptCount(); attempts != 3 {
+		t.Errorf("Expected 3 dead-letter attempts, got %d", attempts)
+	}
+	if stored[0].Attempts != 7 {
+		t.Errorf("Expected 7 attempts to be recorded, got %d", stored[0].Attempts)
+	}
+}
+
+func TestNatsService_RestartDoesNotLoseOrders(t *testing.T) {
+	server := startNatsServer(t)
+	handler := newRecordingHandler()
+
+	natsService := startConsumer(t, server.url, handler)
+	publishOrderCreated(t, server.url, "order-before")
+	waitFor(t, "order-before to be handled", func() bool { return handler.wasHandled("order-before") })
+	natsService.Close()
+
+	// Orders placed while the consumer is down wait in the stream
+	publishOrderCreated(t, server.url, "order-while-down")
+
+	// The stream and consumer survive a broker restart too
+	server.stop()
+	server.start()
+
+	natsService = startConsumer(t, server.url, handler)
+	defer natsService.Close()
+
+	waitFor(t, "order-while-down to be handled", func() bool { return handler.wasHandled("order-while-down") })
+	if calls := handler.callCount("order-before"); calls != 1 {
+		t.Errorf("Expected acked order to be delivered once, got %d", calls)
+	}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
@@ -52,10 +53,31 @@ func main() {
 	// Initialize repositories
 	productRepo := repository.NewPostgresProductRepository(db)
 	categoryRepo := repository.NewPostgresCategoryRepository(db)
+	reservationRepo := repository.NewPostgresReservationRepository(db)
+	exchangeRateRepo := repository.NewPostgresExchangeRateRepository(db)
 
 	// Initialize services with cache
 	productService := service.NewProductService(productRepo, redisCache)
 	categoryService := service.NewCategoryService(categoryRepo)
+	reservationService := service.NewReservationService(reservationRepo, redisCache, cfg.Reservations.TTL)
+	currencyService := service.NewCurrencyService(exchangeRateRepo, cfg.Currency.Base)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Load exchange rates from file; otherwise they are managed through SetExchangeRates
+	if cfg.Currency.RatesFile != "" {
+		rates, err := currencyService.LoadRates(ctx, cfg.Currency.RatesFile)
+		if err != nil {
+			log.Fatalf("Failed to load exchange rates: %v", err)
+		}
+		log.Printf("Loaded %d exchange rates from %s", len(rates), cfg.Currency.RatesFile)
+	}
+
+	// Hand back stock held for orders that were never paid
+
+	sweeper := service.NewReservationSweeper(reservationService, cfg.Reservations.SweepInterval, cfg.Reservations.SweepBatchSize)
+	go sweeper.Run(ctx)
 
 	// Initialize gRPC server
 	lis, err := net.Listen("tcp", ":"+cfg.Server.GrpcPort)
@@ -66,13 +88,17 @@ func main() {
 	grpcServer := grpc.NewServer()
 
 	// Register product service handler
-	productHandler := handler.NewProductGrpcHandler(productService)
+	productHandler := handler.NewProductGrpcHandler(productService, reservationService, currencyService)
 	inventory.RegisterProductServiceServer(grpcServer, productHandler)
 
 	// Register category service handler
 	categoryHandler := handler.NewCategoryGrpcHandler(categoryService)
 	inventory.RegisterCategoryServiceServer(grpcServer, categoryHandler)
 
+	// Register currency service handler
+	currencyHandler := handler.NewCurrencyGrpcHandler(currencyService)
+	inventory.RegisterCurrencyServiceServer(grpcServer, currencyHandler)
+
 	// Enable reflection for tools like grpcurl
 	reflection.Register(grpcServer)
 
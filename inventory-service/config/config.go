@@ -4,6 +4,7 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -25,6 +26,15 @@ type Config struct {
 		Password string
 		DB       int
 	}
+	Reservations struct {
+		TTL            time.Duration
+		SweepInterval  time.Duration
+		SweepBatchSize int
+	}
+	Currency struct {
+		Base      string
+		RatesFile string
+	}
 }
 
 func LoadConfig() *Config {
@@ -53,6 +63,20 @@ func LoadConfig() *Config {
 	}
 	config.Redis.DB = redisDB
 
+	// Stock reservation configuration
+	config.Reservations.TTL = getDuration("RESERVATION_TTL", 30*time.Minute)
+	config.Reservations.SweepInterval = getDuration("RESERVATION_SWEEP_INTERVAL", time.Minute)
+
+	sweepBatchSize, err := strconv.Atoi(getEnv("RESERVATION_SWEEP_BATCH_SIZE", "100"))
+	if err != nil || sweepBatchSize <= 0 {
+		sweepBatchSize = 100
+	}
+	config.Reservations.SweepBatchSize = sweepBatchSize
+
+	// Currency configuration; the rates file is a JSON object such as {"EUR": 0.92}
+	config.Currency.Base = getEnv("BASE_CURRENCY", "USD")
+	config.Currency.RatesFile = getEnv("EXCHANGE_RATES_FILE", "")
+
 	return config
 }
 
@@ -63,3 +87,11 @@ func getEnv(key, defaultValue string) string {
 	}
 	return value
 }
+
+func getDuration(key string, defaultValue time.Duration) time.Duration {
+	value, err := time.ParseDuration(getEnv(key, defaultValue.String()))
+	if err != nil || value <= 0 {
+		return defaultValue
+	}
+	return value
+}
@@ -0,0 +1,12 @@
+package domain
+
+import (
+	"time"
+)
+
+// ExchangeRate is the number of units of Currency that one unit of the base currency buys.
+type ExchangeRate struct {
+	Currency  string    `json:"currency"`
+	Rate      float64   `json:"rate"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
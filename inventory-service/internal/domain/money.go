@@ -0,0 +1,130 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// DefaultCurrency is the currency of amounts stored without one.
+const DefaultCurrency = "USD"
+
+// Money is an exact amount in the minor unit of Currency, e.g. 1999 for 19.99 USD. All
+// currencies are assumed to have two decimal places.
+type Money struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// MoneyFromFloat converts a decimal amount, rounding to the nearest minor unit. It is only
+// meant for amounts coming from clients that still send floats.
+func MoneyFromFloat(amount float64, currency string) Money {
+	return Money{Amount: int64(math.Round(amount * 100)), Currency: currency}
+}
+
+// ParseMoney parses a decimal amount such as "19.99" exactly.
+func ParseMoney(amount, currency string) (Money, error) {
+	amount = strings.TrimSpace(amount)
+
+	negative := strings.HasPrefix(amount, "-")
+	whole, fraction, _ := strings.Cut(strings.TrimPrefix(amount, "-"), ".")
+
+	// Digits past the cents are fine as long as they are zeros, e.g. "19.9900"
+	if len(fraction) > 2 {
+		if strings.Trim(fraction[2:], "0") != "" {
+			return Money{}, fmt.Errorf("amount %q has more than two decimal places", amount)
+		}
+		fraction = fraction[:2]
+	}
+	fraction += strings.Repeat("0", 2-len(fraction))
+
+	if whole == "" {
+		whole = "0"
+	}
+
+	units, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || units < 0 {
+		return Money{}, fmt.Errorf("invalid amount %q", amount)
+	}
+	cents, err := strconv.ParseInt(fraction, 10, 64)
+	if err != nil || cents < 0 {
+		return Money{}, fmt.Errorf("invalid amount %q", amount)
+	}
+
+	minor := units*100 + cents
+	if negative {
+		minor = -minor
+	}
+	return Money{Amount: minor, Currency: currency}, nil
+}
+
+// Float64 returns the amount as a decimal, for clients that still expect floats.
+func (m Money) Float64() float64 {
+	return float64(m.Amount) / 100
+}
+
+// String formats the amount as a decimal such as "19.99", without the currency.
+func (m Money) String() string {
+	sign := ""
+	amount := m.Amount
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, amount/100, amount%100)
+}
+
+func (m Money) IsNegative() bool {
+	return m.Amount < 0
+}
+
+// Convert converts the amount into currency at rate, the units of currency that one unit of
+// the amount's currency buys, rounding half away from zero to the minor unit.
+func (m Money) Convert(rate float64, currency string) Money {
+	return Money{Amount: int64(math.Round(float64(m.Amount) * rate)), Currency: currency}
+}
+
+// Scan reads a DECIMAL column exactly. Columns carry no currency, so amounts read from them
+// are in DefaultCurrency.
+func (m *Money) Scan(src interface{}) error {
+	var err error
+	switch v := src.(type) {
+	case []byte:
+		*m, err = ParseMoney(string(v), DefaultCurrency)
+	case string:
+		*m, err = ParseMoney(v, DefaultCurrency)
+	case int64:
+		*m = Money{Amount: v * 100, Currency: DefaultCurrency}
+	case float64:
+		*m = MoneyFromFloat(v, DefaultCurrency)
+	case nil:
+		*m = Money{Currency: DefaultCurrency}
+	default:
+		return fmt.Errorf("cannot scan %T into money", src)
+	}
+	return err
+}
+
+// Value writes the amount to a DECIMAL column.
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
+
+// UnmarshalJSON also accepts a plain decimal number, which is how amounts were encoded
+// before they became exact.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] != '{' && string(data) != "null" {
+		var amount float64
+		if err := json.Unmarshal(data, &amount); err != nil {
+			return err
+		}
+		*m = MoneyFromFloat(amount, DefaultCurrency)
+		return nil
+	}
+
+	type money Money
+	return json.Unmarshal(data, (*money)(m))
+}
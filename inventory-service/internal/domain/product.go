@@ -8,8 +8,9 @@ type Product struct {
 	ID          string    `json:"id"`
 	Name        string    `json:"name"`
 	Description string    `json:"description"`
-	Price       float64   `json:"price"`
+	Price       Money     `json:"price"`
 	Stock       int       `json:"stock"`
+	Reserved    int       `json:"reserved"`
 	CategoryID  string    `json:"category_id"`
 	FrameSize   string    `json:"frame_size"`
 	WheelSize   string    `json:"wheel_size"`
@@ -20,10 +21,15 @@ type Product struct {
 	UpdatedAt   time.Time `json:"updated_at"`
 }
 
+// Available is the stock that is not held for unpaid orders and can still be ordered.
+func (p Product) Available() int {
+	return p.Stock - p.Reserved
+}
+
 type ProductFilter struct {
 	CategoryID string
-	MinPrice   *float64
-	MaxPrice   *float64
+	MinPrice   *Money
+	MaxPrice   *Money
 	InStock    *bool
 	BikeType   string
 	FrameSize  string
@@ -33,3 +39,8 @@ type ProductFilter struct {
 	Page       int
 	PageSize   int
 }
+
+type StockItem struct {
+	ProductID string `json:"product_id"`
+	Quantity  int    `json:"quantity"`
+}
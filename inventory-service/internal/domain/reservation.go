@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"time"
+)
+
+type ReservationStatus string
+
+const (
+	ReservationStatusHeld      ReservationStatus = "held"
+	ReservationStatusCommitted ReservationStatus = "committed"
+	ReservationStatusReleased  ReservationStatus = "released"
+	ReservationStatusExpired   ReservationStatus = "expired"
+)
+
+// Reservation holds a quantity of one product for an order until the order is paid,
+// cancelled or the reservation expires.
+type Reservation struct {
+	ID        string            `json:"id"`
+	OrderID   string            `json:"order_id"`
+	ProductID string            `json:"product_id"`
+	Quantity  int               `json:"quantity"`
+	Status    ReservationStatus `json:"status"`
+	ExpiresAt time.Time         `json:"expires_at"`
+	CreatedAt time.Time         `json:"created_at"`
+	UpdatedAt time.Time         `json:"updated_at"`
+}
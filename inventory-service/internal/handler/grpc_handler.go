@@ -2,12 +2,16 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"log"
+	"strings"
 
 	"inventory-service/internal/domain"
+	"inventory-service/internal/repository"
 	"inventory-service/internal/service"
 
 	pb "proto/inventory"
+	moneypb "proto/money"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -16,22 +20,31 @@ import (
 
 type ProductGrpcHandler struct {
 	pb.UnimplementedProductServiceServer
-	productService service.ProductService
+	productService     service.ProductService
+	reservationService service.ReservationService
+	currencyService    service.CurrencyService
 }
 
-func NewProductGrpcHandler(productService service.ProductService) *ProductGrpcHandler {
+func NewProductGrpcHandler(productService service.ProductService, reservationService service.ReservationService, currencyService service.CurrencyService) *ProductGrpcHandler {
 	return &ProductGrpcHandler{
-		productService: productService,
+		productService:     productService,
+		reservationService: reservationService,
+		currencyService:    currencyService,
 	}
 }
 
 func (h *ProductGrpcHandler) CreateProduct(ctx context.Context, req *pb.CreateProductRequest) (*pb.ProductResponse, error) {
 	log.Printf("Received CreateProduct request for %s", req.Name)
 
+	price, err := h.basePrice(req.PriceMoney, req.Price)
+	if err != nil {
+		return nil, err
+	}
+
 	product := domain.Product{
 		Name:        req.Name,
 		Description: req.Description,
-		Price:       req.Price,
+		Price:       price,
 		Stock:       int(req.Stock),
 		CategoryID:  req.CategoryId,
 		FrameSize:   req.FrameSize,
@@ -47,31 +60,22 @@ func (h *ProductGrpcHandler) CreateProduct(ctx context.Context, req *pb.CreatePr
 		return nil, status.Errorf(codes.Internal, "failed to create product: %v", err)
 	}
 
-	return &pb.ProductResponse{
-		Id:          createdProduct.ID,
-		Name:        createdProduct.Name,
-		Description: createdProduct.Description,
-		Price:       createdProduct.Price,
-		Stock:       int32(createdProduct.Stock),
-		CategoryId:  createdProduct.CategoryID,
-		FrameSize:   createdProduct.FrameSize,
-		WheelSize:   createdProduct.WheelSize,
-		Color:       createdProduct.Color,
-		Weight:      createdProduct.Weight,
-		BikeType:    createdProduct.BikeType,
-		CreatedAt:   timestamppb.New(createdProduct.CreatedAt),
-		UpdatedAt:   timestamppb.New(createdProduct.UpdatedAt),
-	}, nil
+	return h.mapProductToProto(createdProduct, 1, h.currencyService.BaseCurrency()), nil
 }
 
 func (h *ProductGrpcHandler) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest) (*pb.ProductResponse, error) {
 	log.Printf("Received UpdateProduct request for ID: %s", req.Id)
 
+	price, err := h.basePrice(req.PriceMoney, req.Price)
+	if err != nil {
+		return nil, err
+	}
+
 	product := domain.Product{
 		ID:          req.Id,
 		Name:        req.Name,
 		Description: req.Description,
-		Price:       req.Price,
+		Price:       price,
 		Stock:       int(req.Stock),
 		CategoryID:  req.CategoryId,
 		FrameSize:   req.FrameSize,
@@ -93,39 +97,31 @@ func (h *ProductGrpcHandler) UpdateProduct(ctx context.Context, req *pb.UpdatePr
 		return nil, status.Errorf(codes.NotFound, "failed to get updated product: %v", err)
 	}
 
-	return &pb.ProductResponse{
-		Id:          updatedProduct.ID,
-		Name:        updatedProduct.Name,
-		Description: updatedProduct.Description,
-		Price:       updatedProduct.Price,
-		Stock:       int32(updatedProduct.Stock),
-		CategoryId:  updatedProduct.CategoryID,
-		FrameSize:   updatedProduct.FrameSize,
-		WheelSize:   updatedProduct.WheelSize,
-		Color:       updatedProduct.Color,
-		Weight:      updatedProduct.Weight,
-		BikeType:    updatedProduct.BikeType,
-		CreatedAt:   timestamppb.New(updatedProduct.CreatedAt),
-		UpdatedAt:   timestamppb.New(updatedProduct.UpdatedAt),
-	}, nil
+	return h.mapProductToProto(updatedProduct, 1, h.currencyService.BaseCurrency()), nil
 }
 
 func (h *ProductGrpcHandler) ListProducts(ctx context.Context, req *pb.ListProductsRequest) (*pb.ListProductsResponse, error) {
 	log.Printf("Received ListProducts request")
 
+	rate, currency, err := h.displayRate(ctx, req.Currency)
+	if err != nil {
+		return nil, err
+	}
+
 	filter := domain.ProductFilter{
 		CategoryID: req.Filter.CategoryId,
 		Page:       int(req.Filter.Page),
 		PageSize:   int(req.Filter.PageSize),
 	}
 
-	if req.Filter.MinPrice > 0 {
-		minPrice := req.Filter.MinPrice
+	// Price filters are given in the display currency but prices are stored in the base one
+	if req.Filter.MinPriceMoney != nil || req.Filter.MinPrice > 0 {
+		minPrice := mapMoneyFromProto(req.Filter.MinPriceMoney, req.Filter.MinPrice).Convert(1/rate, h.currencyService.BaseCurrency())
 		filter.MinPrice = &minPrice
 	}
 
-	if req.Filter.MaxPrice > 0 {
-		maxPrice := req.Filter.MaxPrice
+	if req.Filter.MaxPriceMoney != nil || req.Filter.MaxPrice > 0 {
+		maxPrice := mapMoneyFromProto(req.Filter.MaxPriceMoney, req.Filter.MaxPrice).Convert(1/rate, h.currencyService.BaseCurrency())
 		filter.MaxPrice = &maxPrice
 	}
 
@@ -153,21 +149,7 @@ func (h *ProductGrpcHandler) ListProducts(ctx context.Context, req *pb.ListProdu
 
 	var protoProducts []*pb.ProductResponse
 	for _, product := range products {
-		protoProducts = append(protoProducts, &pb.ProductResponse{
-			Id:          product.ID,
-			Name:        product.Name,
-			Description: product.Description,
-			Price:       product.Price,
-			Stock:       int32(product.Stock),
-			CategoryId:  product.CategoryID,
-			FrameSize:   product.FrameSize,
-			WheelSize:   product.WheelSize,
-			Color:       product.Color,
-			Weight:      product.Weight,
-			BikeType:    product.BikeType,
-			CreatedAt:   timestamppb.New(product.CreatedAt),
-			UpdatedAt:   timestamppb.New(product.UpdatedAt),
-		})
+		protoProducts = append(protoProducts, h.mapProductToProto(product, rate, currency))
 	}
 
 	return &pb.ListProductsResponse{
@@ -181,27 +163,18 @@ func (h *ProductGrpcHandler) ListProducts(ctx context.Context, req *pb.ListProdu
 func (h *ProductGrpcHandler) GetProduct(ctx context.Context, req *pb.ProductIDRequest) (*pb.ProductResponse, error) {
 	log.Printf("Received GetProduct request for ID: %s", req.Id)
 
+	rate, currency, err := h.displayRate(ctx, req.Currency)
+	if err != nil {
+		return nil, err
+	}
+
 	product, err := h.productService.GetProductByID(ctx, req.Id)
 	if err != nil {
 		log.Printf("Failed to get product: %v", err)
 		return nil, status.Errorf(codes.NotFound, "product not found: %v", err)
 	}
 
-	return &pb.ProductResponse{
-		Id:          product.ID,
-		Name:        product.Name,
-		Description: product.Description,
-		Price:       product.Price,
-		Stock:       int32(product.Stock),
-		CategoryId:  product.CategoryID,
-		FrameSize:   product.FrameSize,
-		WheelSize:   product.WheelSize,
-		Color:       product.Color,
-		Weight:      product.Weight,
-		BikeType:    product.BikeType,
-		CreatedAt:   timestamppb.New(product.CreatedAt),
-		UpdatedAt:   timestamppb.New(product.UpdatedAt),
-	}, nil
+	return h.mapProductToProto(product, rate, currency), nil
 }
 
 func (h *ProductGrpcHandler) DeleteProduct(ctx context.Context, req *pb.ProductIDRequest) (*pb.DeleteResponse, error) {
@@ -234,9 +207,9 @@ func (h *ProductGrpcHandler) CheckStock(ctx context.Context, req *pb.CheckStockR
 			continue
 		}
 
-		if product.Stock < int(item.Quantity) {
+		if product.Available() < int(item.Quantity) {
 			log.Printf("Insufficient stock for product %s: requested %d, available %d",
-				item.ProductId, item.Quantity, product.Stock)
+				item.ProductId, item.Quantity, product.Available())
 			unavailableItems = append(unavailableItems, &pb.ProductQuantity{
 				ProductId: item.ProductId,
 				Quantity:  item.Quantity,
@@ -251,6 +224,203 @@ func (h *ProductGrpcHandler) CheckStock(ctx context.Context, req *pb.CheckStockR
 	}, nil
 }
 
+func (h *ProductGrpcHandler) ReserveStock(ctx context.Context, req *pb.StockAdjustmentRequest) (*pb.StockAdjustmentResponse, error) {
+	log.Printf("Received ReserveStock request for %d items (ref: %s)", len(req.Items), req.Reference)
+
+	unavailable, err := h.productService.ReserveStock(ctx, mapStockItemsFromProto(req.Items))
+	if err != nil {
+		log.Printf("Failed to reserve stock: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to reserve stock: %v", err)
+	}
+
+	return &pb.StockAdjustmentResponse{
+		Success:          len(unavailable) == 0,
+		UnavailableItems: mapStockItemsToProto(unavailable),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) ReleaseStock(ctx context.Context, req *pb.StockAdjustmentRequest) (*pb.StockAdjustmentResponse, error) {
+	log.Printf("Received ReleaseStock request for %d items (ref: %s)", len(req.Items), req.Reference)
+
+	unavailable, err := h.productService.ReleaseStock(ctx, mapStockItemsFromProto(req.Items))
+	if err != nil {
+		log.Printf("Failed to release stock: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to release stock: %v", err)
+	}
+
+	return &pb.StockAdjustmentResponse{
+		Success:          len(unavailable) == 0,
+		UnavailableItems: mapStockItemsToProto(unavailable),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) HoldStock(ctx context.Context, req *pb.HoldStockRequest) (*pb.ReservationResponse, error) {
+	log.Printf("Received HoldStock request for %d items (order: %s)", len(req.Items), req.OrderId)
+
+	reservations, unavailable, err := h.reservationService.HoldStock(ctx, req.OrderId, mapStockItemsFromProto(req.Items))
+	if err != nil {
+		log.Printf("Failed to hold stock: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to hold stock: %v", err)
+	}
+
+	return &pb.ReservationResponse{
+		Success:          len(unavailable) == 0,
+		UnavailableItems: mapStockItemsToProto(unavailable),
+		Reservations:     mapReservationsToProto(reservations),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) AdjustReservation(ctx context.Context, req *pb.HoldStockRequest) (*pb.ReservationResponse, error) {
+	log.Printf("Received AdjustReservation request for %d items (order: %s)", len(req.Items), req.OrderId)
+
+	reservations, unavailable, err := h.reservationService.AdjustReservation(ctx, req.OrderId, mapStockItemsFromProto(req.Items))
+	if err != nil {
+		log.Printf("Failed to adjust reservation: %v", err)
+		return nil, reservationError("adjust", err)
+	}
+
+	return &pb.ReservationResponse{
+		Success:          len(unavailable) == 0,
+		UnavailableItems: mapStockItemsToProto(unavailable),
+		Reservations:     mapReservationsToProto(reservations),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) CommitReservation(ctx context.Context, req *pb.ReservationRequest) (*pb.ReservationResponse, error) {
+	log.Printf("Received CommitReservation request for order: %s", req.OrderId)
+
+	reservations, unavailable, err := h.reservationService.CommitReservation(ctx, req.OrderId)
+	if err != nil {
+		log.Printf("Failed to commit reservation: %v", err)
+		return nil, reservationError("commit", err)
+	}
+
+	return &pb.ReservationResponse{
+		Success:          len(unavailable) == 0,
+		UnavailableItems: mapStockItemsToProto(unavailable),
+		Reservations:     mapReservationsToProto(reservations),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) ReleaseReservation(ctx context.Context, req *pb.ReservationRequest) (*pb.ReservationResponse, error) {
+	log.Printf("Received ReleaseReservation request for order: %s", req.OrderId)
+
+	reservations, err := h.reservationService.ReleaseReservation(ctx, req.OrderId)
+	if err != nil {
+		log.Printf("Failed to release reservation: %v", err)
+		return nil, reservationError("release", err)
+	}
+
+	return &pb.ReservationResponse{
+		Success:      true,
+		Reservations: mapReservationsToProto(reservations),
+	}, nil
+}
+
+// displayRate looks up the rate for showing prices in currency, which defaults to the base
+// currency.
+func (h *ProductGrpcHandler) displayRate(ctx context.Context, currency string) (float64, string, error) {
+	if currency == "" {
+		return 1, h.currencyService.BaseCurrency(), nil
+	}
+
+	rate, err := h.currencyService.Rate(ctx, currency)
+	if err != nil {
+		log.Printf("Failed to get exchange rate for %s: %v", currency, err)
+		if errors.Is(err, service.ErrUnsupportedCurrency) {
+			return 0, "", status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		return 0, "", status.Errorf(codes.Internal, "failed to get exchange rate: %v", err)
+	}
+
+	return rate, strings.ToUpper(strings.TrimSpace(currency)), nil
+}
+
+// basePrice reads a product price, which must be in the base currency.
+func (h *ProductGrpcHandler) basePrice(m *moneypb.Money, fallback float64) (domain.Money, error) {
+	base := h.currencyService.BaseCurrency()
+	if m != nil && m.Currency != "" && !strings.EqualFold(m.Currency, base) {
+		return domain.Money{}, status.Errorf(codes.InvalidArgument, "product prices must be in the base currency %s", base)
+	}
+
+	price := mapMoneyFromProto(m, fallback)
+	price.Currency = base
+	return price, nil
+}
+
+// mapProductToProto shows the product with its price converted into currency at rate.
+func (h *ProductGrpcHandler) mapProductToProto(product domain.Product, rate float64, currency string) *pb.ProductResponse {
+	price := product.Price.Convert(rate, currency)
+
+	return &pb.ProductResponse{
+		Id:           product.ID,
+		Name:         product.Name,
+		Description:  product.Description,
+		Price:        price.Float64(),
+		PriceMoney:   mapMoneyToProto(price),
+		Stock:        int32(product.Stock),
+		CategoryId:   product.CategoryID,
+		FrameSize:    product.FrameSize,
+		WheelSize:    product.WheelSize,
+		Color:        product.Color,
+		Weight:       product.Weight,
+		BikeType:     product.BikeType,
+		CreatedAt:    timestamppb.New(product.CreatedAt),
+		UpdatedAt:    timestamppb.New(product.UpdatedAt),
+		Reserved:     int32(product.Reserved),
+		ExchangeRate: rate,
+		Available:    int32(product.Available()),
+	}
+}
+
+func reservationError(action string, err error) error {
+	switch {
+	case errors.Is(err, repository.ErrReservationNotFound):
+		return status.Errorf(codes.NotFound, "failed to %s reservation: %v", action, err)
+	case errors.Is(err, repository.ErrReservationReleased):
+		return status.Errorf(codes.FailedPrecondition, "failed to %s reservation: %v", action, err)
+	default:
+		return status.Errorf(codes.Internal, "failed to %s reservation: %v", action, err)
+	}
+}
+
+func mapReservationsToProto(reservations []domain.Reservation) []*pb.Reservation {
+	var protoReservations []*pb.Reservation
+	for _, reservation := range reservations {
+		protoReservations = append(protoReservations, &pb.Reservation{
+			Id:        reservation.ID,
+			OrderId:   reservation.OrderID,
+			ProductId: reservation.ProductID,
+			Quantity:  int32(reservation.Quantity),
+			Status:    string(reservation.Status),
+			ExpiresAt: timestamppb.New(reservation.ExpiresAt),
+		})
+	}
+	return protoReservations
+}
+
+func mapStockItemsFromProto(items []*pb.ProductQuantity) []domain.StockItem {
+	stockItems := make([]domain.StockItem, 0, len(items))
+	for _, item := range items {
+		stockItems = append(stockItems, domain.StockItem{
+			ProductID: item.ProductId,
+			Quantity:  int(item.Quantity),
+		})
+	}
+	return stockItems
+}
+
+func mapStockItemsToProto(items []domain.StockItem) []*pb.ProductQuantity {
+	var protoItems []*pb.ProductQuantity
+	for _, item := range items {
+		protoItems = append(protoItems, &pb.ProductQuantity{
+			ProductId: item.ProductID,
+			Quantity:  int32(item.Quantity),
+		})
+	}
+	return protoItems
+}
+
 type CategoryGrpcHandler struct {
 	pb.UnimplementedCategoryServiceServer
 	categoryService service.CategoryService
@@ -371,3 +541,92 @@ func (h *CategoryGrpcHandler) ListCategories(ctx context.Context, req *pb.ListCa
 		Categories: protoCategories,
 	}, nil
 }
+
+type CurrencyGrpcHandler struct {
+	pb.UnimplementedCurrencyServiceServer
+	currencyService service.CurrencyService
+}
+
+func NewCurrencyGrpcHandler(currencyService service.CurrencyService) *CurrencyGrpcHandler {
+	return &CurrencyGrpcHandler{
+		currencyService: currencyService,
+	}
+}
+
+func (h *CurrencyGrpcHandler) ListExchangeRates(ctx context.Context, req *pb.ListExchangeRatesRequest) (*pb.ExchangeRatesResponse, error) {
+	log.Printf("Received ListExchangeRates request")
+
+	rates, err := h.currencyService.ListRates(ctx)
+	if err != nil {
+		log.Printf("Failed to list exchange rates: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to list exchange rates: %v", err)
+	}
+
+	return h.mapExchangeRatesToProto(rates), nil
+}
+
+func (h *CurrencyGrpcHandler) SetExchangeRates(ctx context.Context, req *pb.SetExchangeRatesRequest) (*pb.ExchangeRatesResponse, error) {
+	log.Printf("Received SetExchangeRates request for %d currencies", len(req.Rates))
+
+	rates := make([]domain.ExchangeRate, 0, len(req.Rates))
+	for _, rate := range req.Rates {
+		rates = append(rates, domain.ExchangeRate{
+			Currency: rate.Currency,
+			Rate:     rate.Rate,
+		})
+	}
+
+	updated, err := h.currencyService.SetRates(ctx, rates)
+	if err != nil {
+		log.Printf("Failed to set exchange rates: %v", err)
+		if errors.Is(err, service.ErrInvalidExchangeRate) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to set exchange rates: %v", err)
+	}
+
+	return h.mapExchangeRatesToProto(updated), nil
+}
+
+func (h *CurrencyGrpcHandler) mapExchangeRatesToProto(rates []domain.ExchangeRate) *pb.ExchangeRatesResponse {
+	protoRates := make([]*pb.ExchangeRate, 0, len(rates))
+	for _, rate := range rates {
+		protoRates = append(protoRates, &pb.ExchangeRate{
+			Currency:  rate.Currency,
+			Rate:      rate.Rate,
+			UpdatedAt: timestamppb.New(rate.UpdatedAt),
+		})
+	}
+
+	return &pb.ExchangeRatesResponse{
+		BaseCurrency: h.currencyService.BaseCurrency(),
+		Rates:        protoRates,
+	}
+}
+
+// Helper function to map domain.Money to moneypb.Money
+func mapMoneyToProto(m domain.Money) *moneypb.Money {
+	currency := m.Currency
+	if currency == "" {
+		currency = domain.DefaultCurrency
+	}
+
+	return &moneypb.Money{
+		Amount:   m.Amount,
+		Currency: currency,
+	}
+}
+
+// mapMoneyFromProto takes the exact amount when the client sent one and otherwise the
+// decimal amount older clients send.
+func mapMoneyFromProto(m *moneypb.Money, fallback float64) domain.Money {
+	if m == nil {
+		return domain.MoneyFromFloat(fallback, domain.DefaultCurrency)
+	}
+
+	currency := m.Currency
+	if currency == "" {
+		currency = domain.DefaultCurrency
+	}
+	return domain.Money{Amount: m.Amount, Currency: currency}
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"inventory-service/internal/domain"
+)
+
+var ErrExchangeRateNotFound = errors.New("exchange rate not found")
+
+type ExchangeRateRepository interface {
+	List(ctx context.Context) ([]domain.ExchangeRate, error)
+	Get(ctx context.Context, currency string) (domain.ExchangeRate, error)
+	// Replace swaps the whole exchange-rate table for rates.
+	Replace(ctx context.Context, rates []domain.ExchangeRate) error
+}
+
+type PostgresExchangeRateRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresExchangeRateRepository(db *sql.DB) ExchangeRateRepository {
+	return &PostgresExchangeRateRepository{
+		db: db,
+	}
+}
+
+func (r *PostgresExchangeRateRepository) List(ctx context.Context) ([]domain.ExchangeRate, error) {
+	query := `SELECT currency, rate, updated_at FROM exchange_rates ORDER BY currency`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, errors.New("failed to list exchange rates")
+	}
+	defer rows.Close()
+
+	var rates []domain.ExchangeRate
+	for rows.Next() {
+		var rate domain.ExchangeRate
+		if err := rows.Scan(&rate.Currency, &rate.Rate, &rate.UpdatedAt); err != nil {
+			return nil, errors.New("failed to scan exchange rate")
+		}
+		rates = append(rates, rate)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.New("failed to iterate exchange rates")
+	}
+
+	return rates, nil
+}
+
+func (r *PostgresExchangeRateRepository) Get(ctx context.Context, currency string) (domain.ExchangeRate, error) {
+	query := `SELECT currency, rate, updated_at FROM exchange_rates WHERE currency = $1`
+
+	var rate domain.ExchangeRate
+	err := r.db.QueryRowContext(ctx, query, currency).Scan(&rate.Currency, &rate.Rate, &rate.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ExchangeRate{}, ErrExchangeRateNotFound
+		}
+		return domain.ExchangeRate{}, errors.New("failed to get exchange rate")
+	}
+
+	return rate, nil
+}
+
+func (r *PostgresExchangeRateRepository) Replace(ctx context.Context, rates []domain.ExchangeRate) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.New("failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM exchange_rates`); err != nil {
+		return errors.New("failed to clear exchange rates")
+	}
+
+	now := time.Now()
+	for _, rate := range rates {
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO exchange_rates (currency, rate, updated_at) VALUES ($1, $2, $3)`,
+			rate.Currency, rate.Rate, now,
+		)
+		if err != nil {
+			return errors.New("failed to save exchange rate")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.New("failed to commit transaction")
+	}
+
+	return nil
+}
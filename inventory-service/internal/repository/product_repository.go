@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -22,6 +23,8 @@ type ProductRepository interface {
 	ExistsByID(ctx context.Context, id string) (bool, error)
 	GetByCategory(ctx context.Context, categoryID string) ([]domain.Product, error)
 	UpdateStock(ctx context.Context, productID string, newStock int) error
+	ReserveStock(ctx context.Context, items []domain.StockItem) ([]domain.StockItem, error)
+	ReleaseStock(ctx context.Context, items []domain.StockItem) ([]domain.StockItem, error)
 }
 
 type PostgresProductRepository struct {
@@ -49,7 +52,7 @@ func (r *PostgresProductRepository) Create(ctx context.Context, product domain.P
 		                      frame_size, wheel_size, color, weight, bike_type, 
 		                      created_at, updated_at)
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
-		RETURNING id, name, description, price, stock, category_id, 
+		RETURNING id, name, description, price, stock, reserved, category_id, 
 		          frame_size, wheel_size, color, weight, bike_type, 
 		          created_at, updated_at`
 
@@ -75,6 +78,7 @@ func (r *PostgresProductRepository) Create(ctx context.Context, product domain.P
 		&product.Description,
 		&product.Price,
 		&product.Stock,
+		&product.Reserved,
 		&product.CategoryID,
 		&product.FrameSize,
 		&product.WheelSize,
@@ -101,7 +105,7 @@ func (r *PostgresProductRepository) GetByID(ctx context.Context, id string) (dom
 	}
 
 	query := `
-        SELECT id, name, description, price, stock, category_id, 
+        SELECT id, name, description, price, stock, reserved, category_id, 
                COALESCE(frame_size, '') as frame_size,
                COALESCE(wheel_size, '') as wheel_size,
                COALESCE(color, '') as color,
@@ -118,6 +122,7 @@ func (r *PostgresProductRepository) GetByID(ctx context.Context, id string) (dom
 		&product.Description,
 		&product.Price,
 		&product.Stock,
+		&product.Reserved,
 		&product.CategoryID,
 		&product.FrameSize,
 		&product.WheelSize,
@@ -177,6 +182,9 @@ func (r *PostgresProductRepository) Update(ctx context.Context, product domain.P
 		if isForeignKeyError(err) {
 			return errors.New("invalid category ID")
 		}
+		if isReservedStockError(err) {
+			return errors.New("stock cannot be lower than the quantity reserved for unpaid orders")
+		}
 		return errors.New("failed to update product")
 	}
 
@@ -195,7 +203,7 @@ func (r *PostgresProductRepository) Update(ctx context.Context, product domain.P
 func (r *PostgresProductRepository) List(ctx context.Context, filter domain.ProductFilter) ([]domain.Product, int, error) {
 	// Build the query dynamically based on filters
 	baseQuery := `
-        SELECT id, name, description, price, stock, category_id, 
+        SELECT id, name, description, price, stock, reserved, category_id, 
                COALESCE(frame_size, '') as frame_size,
                COALESCE(wheel_size, '') as wheel_size,
                COALESCE(color, '') as color,
@@ -244,6 +252,7 @@ func (r *PostgresProductRepository) List(ctx context.Context, filter domain.Prod
 			&product.Description,
 			&product.Price,
 			&product.Stock,
+			&product.Reserved,
 			&product.CategoryID,
 			&product.FrameSize,
 			&product.WheelSize,
@@ -338,6 +347,9 @@ func (r *PostgresProductRepository) UpdateStock(ctx context.Context, productID s
 
 	result, err := r.db.ExecContext(ctx, query, newStock, time.Now(), productID)
 	if err != nil {
+		if isReservedStockError(err) {
+			return errors.New("stock cannot be lower than the quantity reserved for unpaid orders")
+		}
 		return errors.New("failed to update stock")
 	}
 
@@ -353,8 +365,92 @@ func (r *PostgresProductRepository) UpdateStock(ctx context.Context, productID s
 	return nil
 }
 
+// ReserveStock decrements stock for every item in one transaction. If any product is
+// missing or short on available stock nothing is changed and the failing items are returned.
+func (r *PostgresProductRepository) ReserveStock(ctx context.Context, items []domain.StockItem) ([]domain.StockItem, error) {
+	query := `UPDATE products SET stock = stock - $1, updated_at = $2 WHERE id = $3 AND stock - reserved >= $1`
+	return r.adjustStock(ctx, query, items)
+}
+
+// ReleaseStock returns previously reserved stock for every item in one transaction.
+// If any product no longer exists nothing is changed and the missing items are returned.
+func (r *PostgresProductRepository) ReleaseStock(ctx context.Context, items []domain.StockItem) ([]domain.StockItem, error) {
+	query := `UPDATE products SET stock = stock + $1, updated_at = $2 WHERE id = $3`
+	return r.adjustStock(ctx, query, items)
+}
+
 // Helper methods
 
+func (r *PostgresProductRepository) adjustStock(ctx context.Context, query string, items []domain.StockItem) ([]domain.StockItem, error) {
+	items, err := mergeStockItems(items)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.New("failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	var unavailable []domain.StockItem
+	for _, item := range items {
+		result, err := tx.ExecContext(ctx, query, item.Quantity, now, item.ProductID)
+		if err != nil {
+			return nil, errors.New("failed to update stock")
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return nil, errors.New("failed to check update result")
+		}
+
+		if rowsAffected == 0 {
+			unavailable = append(unavailable, item)
+		}
+	}
+
+	if len(unavailable) > 0 {
+		return unavailable, nil
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, errors.New("failed to commit transaction")
+	}
+
+	return nil, nil
+}
+
+// mergeStockItems validates the items, sums quantities per product and sorts them by
+// product ID so concurrent adjustments always lock rows in the same order.
+func mergeStockItems(items []domain.StockItem) ([]domain.StockItem, error) {
+	if len(items) == 0 {
+		return nil, errors.New("at least one item is required")
+	}
+
+	quantities := make(map[string]int)
+	for i, item := range items {
+		if item.ProductID == "" {
+			return nil, fmt.Errorf("product ID is required for item %d", i+1)
+		}
+		if item.Quantity <= 0 {
+			return nil, fmt.Errorf("quantity must be positive for item %d", i+1)
+		}
+		quantities[item.ProductID] += item.Quantity
+	}
+
+	merged := make([]domain.StockItem, 0, len(quantities))
+	for productID, quantity := range quantities {
+		merged = append(merged, domain.StockItem{ProductID: productID, Quantity: quantity})
+	}
+	sort.Slice(merged, func(i, j int) bool {
+		return merged[i].ProductID < merged[j].ProductID
+	})
+
+	return merged, nil
+}
+
 func (r *PostgresProductRepository) buildWhereClause(filter domain.ProductFilter) (string, []interface{}) {
 	var conditions []string
 	var args []interface{}
@@ -379,7 +475,7 @@ func (r *PostgresProductRepository) buildWhereClause(filter domain.ProductFilter
 	}
 
 	if filter.InStock != nil && *filter.InStock {
-		conditions = append(conditions, "stock > 0")
+		conditions = append(conditions, "stock - reserved > 0")
 	}
 
 	if filter.BikeType != "" {
@@ -420,7 +516,7 @@ func (r *PostgresProductRepository) validateProduct(product domain.Product) erro
 		return errors.New("product name is required")
 	}
 
-	if product.Price < 0 {
+	if product.Price.IsNegative() {
 		return errors.New("product price cannot be negative")
 	}
 
@@ -454,3 +550,7 @@ func isForeignKeyError(err error) bool {
 	// PostgreSQL specific - adjust for your database
 	return err != nil && strings.Contains(err.Error(), "violates foreign key constraint")
 }
+
+func isReservedStockError(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "products_reserved_check")
+}
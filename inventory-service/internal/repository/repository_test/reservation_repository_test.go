@@ -0,0 +1,411 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"inventory-service/internal/domain"
+	"inventory-service/internal/repository"
+
+	"github.com/google/uuid"
+	_ "github.com/lib/pq"
+)
+
+// openTestDB migrates a fresh schema in the database named by INVENTORY_TEST_DATABASE_URL
+// and connects to it, skipping the test when no database is configured.
+func openTestDB(t *testing.T) *sql.DB {
+	dsn := os.Getenv("INVENTORY_TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("INVENTORY_TEST_DATABASE_URL not set")
+	}
+
+	admin, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	t.Cleanup(func() { admin.Close() })
+
+	schema := "test_" + strings.ReplaceAll(uuid.New().String(), "-", "")
+	if _, err := admin.Exec("CREATE SCHEMA " + schema); err != nil {
+		t.Fatalf("Failed to create schema: %v", err)
+	}
+	t.Cleanup(func() { admin.Exec("DROP SCHEMA " + schema + " CASCADE") })
+
+	separator := "?"
+	if strings.Contains(dsn, "?") {
+		separator = "&"
+	}
+	db, err := sql.Open("postgres", dsn+separator+"search_path="+schema)
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	migrations, err := filepath.Glob("../../../../migrations/inventory/*.up.sql")
+	if err != nil || len(migrations) == 0 {
+		t.Fatalf("Failed to find migrations: %v", err)
+	}
+	sort.Strings(migrations)
+	for _, migration := range migrations {
+		statements, err := os.ReadFile(migration)
+		if err != nil {
+			t.Fatalf("Failed to read %s: %v", migration, err)
+		}
+		if _, err := db.Exec(string(statements)); err != nil {
+			t.Fatalf("Failed to apply %s: %v", migration, err)
+		}
+	}
+
+	return db
+}
+
+func createProduct(t *testing.T, db *sql.DB, stock int) string {
+	categoryID := uuid.New().String()
+	if _, err := db.Exec(`INSERT INTO categories (id, name) VALUES ($1, $2)`, categoryID, "category-"+categoryID); err != nil {
+		t.Fatalf("Failed to create category: %v", err)
+	}
+
+	productID := uuid.New().String()
+	if _, err := db.Exec(
+		`INSERT INTO products (id, name, price, stock, category_id) VALUES ($1, $2, $3, $4, $5)`,
+		productID, "Bike "+productID, "999.00", stock, categoryID,
+	); err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+	return productID
+}
+
+// stockOf returns a product's stock and how much of it is reserved
+func stockOf(t *testing.T, db *sql.DB, productID string) (int, int) {
+	var stock, reserved int
+	if err := db.QueryRow(`SELECT stock, reserved FROM products WHERE id = $1`, productID).Scan(&stock, &reserved); err != nil {
+		t.Fatalf("Failed to read stock of %s: %v", productID, err)
+	}
+	return stock, reserved
+}
+
+func expectStock(t *testing.T, db *sql.DB, productID string, wantStock, wantReserved int) {
+	t.Helper()
+	if stock, reserved := stockOf(t, db, productID); stock != wantStock || reserved != wantReserved {
+		t.Errorf("Expected stock %d with %d reserved, got %d with %d reserved", wantStock, wantReserved, stock, reserved)
+	}
+}
+
+func hold(t *testing.T, repo repository.ReservationRepository, orderID string, expiresAt time.Time, items ...domain.StockItem) {
+	t.Helper()
+	_, unavailable, err := repo.Hold(context.Background(), orderID, items, expiresAt)
+	if err != nil || len(unavailable) > 0 {
+		t.Fatalf("Failed to hold stock for %s: %v, unavailable %v", orderID, err, unavailable)
+	}
+}
+
+func TestReservationRepository_HoldIsAllOrNothing(t *testing.T) {
+	db := openTestDB(t)
+	repo := repository.NewPostgresReservationRepository(db)
+	ctx := context.Background()
+	expiresAt := time.Now().Add(time.Hour)
+
+	bike := createProduct(t, db, 5)
+	helmet := createProduct(t, db, 10)
+
+	first := uuid.New().String()
+	hold(t, repo, first, expiresAt, domain.StockItem{ProductID: bike, Quantity: 3})
+	expectStock(t, db, bike, 5, 3)
+
+	// Only 2 bikes are left, so neither product is held for the second order
+	second := uuid.New().String()
+	reservations, unavailable, err := repo.Hold(ctx, second, []domain.StockItem{
+		{ProductID: helmet, Quantity: 1},
+		{ProductID: bike, Quantity: 3},
+	}, expiresAt)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(reservations) != 0 {
+		t.Errorf("Expected no reservations, got %d", len(reservations))
+	}
+	if len(unavailable) != 1 || unavailable[0].ProductID != bike || unavailable[0].Quantity != 3 {
+		t.Errorf("Expected 3 bikes to be unavailable, got %v", unavailable)
+	}
+	expectStock(t, db, bike, 5, 3)
+	expectStock(t, db, helmet, 10, 0)
+
+	if held, _ := repo.GetByOrderID(ctx, second); len(held) != 0 {
+		t.Errorf("Expected nothing held for the second order, got %d reservations", len(held))
+	}
+
+	// A retried hold returns what is already held instead of holding it again
+	reservations, _, err = repo.Hold(ctx, first, []domain.StockItem{{ProductID: bike, Quantity: 3}}, expiresAt)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(reservations) != 1 || reservations[0].Quantity != 3 {
+		t.Errorf("Expected the existing reservation back, got %v", reservations)
+	}
+	expectStock(t, db, bike, 5, 3)
+}
+
+func TestReservationRepository_Adjust(t *testing.T) {
+	db := openTestDB(t)
+	repo := repository.NewPostgresReservationRepository(db)
+	ctx := context.Background()
+	expiresAt := time.Now().Add(time.Hour)
+
+	bike := createProduct(t, db, 4)
+	helmet := createProduct(t, db, 10)
+	lock := createProduct(t, db, 3)
+
+	orderID := uuid.New().String()
+	hold(t, repo, orderID, expiresAt,
+		domain.StockItem{ProductID: bike, Quantity: 2},
+		domain.StockItem{ProductID: helmet, Quantity: 1},
+	)
+
+	// Down on bikes, helmets dropped, locks added
+	reservations, previous, unavailable, err := repo.Adjust(ctx, orderID, []domain.StockItem{
+		{ProductID: bike, Quantity: 1},
+		{ProductID: lock, Quantity: 2},
+	}, expiresAt.Add(time.Hour))
+	if err != nil || len(unavailable) > 0 {
+		t.Fatalf("Failed to adjust: %v, unavailable %v", err, unavailable)
+	}
+	if len(reservations) != 2 || len(previous) != 2 {
+		t.Errorf("Expected 2 new and 2 previous reservations, got %d and %d", len(reservations), len(previous))
+	}
+	expectStock(t, db, bike, 4, 1)
+	expectStock(t, db, helmet, 10, 0)
+	expectStock(t, db, lock, 3, 2)
+
+	// Up on bikes, holding only the difference
+	if _, _, unavailable, err = repo.Adjust(ctx, orderID, []domain.StockItem{
+		{ProductID: bike, Quantity: 4},
+		{ProductID: lock, Quantity: 2},
+	}, expiresAt); err != nil || len(unavailable) > 0 {
+		t.Fatalf("Failed to adjust: %v, unavailable %v", err, unavailable)
+	}
+	expectStock(t, db, bike, 4, 4)
+
+	// More than there is leaves the hold as it was
+	_, _, unavailable, err = repo.Adjust(ctx, orderID, []domain.StockItem{
+		{ProductID: bike, Quantity: 2},
+		{ProductID: lock, Quantity: 4},
+	}, expiresAt)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(unavailable) != 1 || unavailable[0].ProductID != lock || unavailable[0].Quantity != 4 {
+		t.Errorf("Expected 4 locks to be unavailable, got %v", unavailable)
+	}
+	expectStock(t, db, bike, 4, 4)
+	expectStock(t, db, lock, 3, 2)
+
+	if _, _, _, err := repo.Adjust(ctx, uuid.New().String(), []domain.StockItem{{ProductID: bike, Quantity: 1}}, expiresAt); !errors.Is(err, repository.ErrReservationNotFound) {
+		t.Errorf("Expected ErrReservationNotFound for an order without reservations, got %v", err)
+	}
+
+	if _, _, err := repo.Commit(ctx, orderID); err != nil {
+		t.Fatalf("Failed to commit: %v", err)
+	}
+	if _, _, _, err := repo.Adjust(ctx, orderID, []domain.StockItem{{ProductID: bike, Quantity: 1}}, expiresAt); !errors.Is(err, repository.ErrReservationReleased) {
+		t.Errorf("Expected ErrReservationReleased for a committed order, got %v", err)
+	}
+}
+
+func TestReservationRepository_AdjustRetakesExpiredStock(t *testing.T) {
+	db := openTestDB(t)
+	repo := repository.NewPostgresReservationRepository(db)
+	ctx := context.Background()
+
+	bike := createProduct(t, db, 3)
+	orderID := uuid.New().String()
+	hold(t, repo, orderID, time.Now().Add(-time.Minute), domain.StockItem{ProductID: bike, Quantity: 2})
+
+	if _, err := repo.ExpireDue(ctx, time.Now(), 10); err != nil {
+		t.Fatalf("Failed to expire: %v", err)
+	}
+	expectStock(t, db, bike, 3, 0)
+
+	if _, _, unavailable, err := repo.Adjust(ctx, orderID, []domain.StockItem{{ProductID: bike, Quantity: 3}}, time.Now().Add(time.Hour)); err != nil || len(unavailable) > 0 {
+		t.Fatalf("Failed to adjust: %v, unavailable %v", err, unavailable)
+	}
+	expectStock(t, db, bike, 3, 3)
+}
+
+func TestReservationRepository_Commit(t *testing.T) {
+	db := openTestDB(t)
+	repo := repository.NewPostgresReservationRepository(db)
+	ctx := context.Background()
+
+	bike := createProduct(t, db, 5)
+	orderID := uuid.New().String()
+	hold(t, repo, orderID, time.Now().Add(time.Hour), domain.StockItem{ProductID: bike, Quantity: 2})
+
+	reservations, unavailable, err := repo.Commit(ctx, orderID)
+	if err != nil || len(unavailable) > 0 {
+		t.Fatalf("Failed to commit: %v, unavailable %v", err, unavailable)
+	}
+	if len(reservations) != 1 || reservations[0].Status != domain.ReservationStatusCommitted {
+		t.Errorf("Expected a committed reservation, got %v", reservations)
+	}
+	expectStock(t, db, bike, 3, 0)
+
+	// Committing again sells nothing twice
+	if _, _, err := repo.Commit(ctx, orderID); err != nil {
+		t.Fatalf("Failed to commit again: %v", err)
+	}
+	expectStock(t, db, bike, 3, 0)
+
+	if _, _, err := repo.Commit(ctx, uuid.New().String()); !errors.Is(err, repository.ErrReservationNotFound) {
+		t.Errorf("Expected ErrReservationNotFound, got %v", err)
+	}
+}
+
+func TestReservationRepository_CommitAfterExpiry(t *testing.T) {
+	db := openTestDB(t)
+	repo := repository.NewPostgresReservationRepository(db)
+	ctx := context.Background()
+
+	bike := createProduct(t, db, 3)
+	late := uuid.New().String()
+	hold(t, repo, late, time.Now().Add(-time.Minute), domain.StockItem{ProductID: bike, Quantity: 2})
+
+	tooLate := uuid.New().String()
+	hold(t, repo, tooLate, time.Now().Add(-time.Minute), domain.StockItem{ProductID: bike, Quantity: 1})
+
+	if expired, err := repo.ExpireDue(ctx, time.Now(), 10); err != nil || len(expired) != 2 {
+		t.Fatalf("Expected 2 reservations to expire, got %d: %v", len(expired), err)
+	}
+	expectStock(t, db, bike, 3, 0)
+
+	// Someone else holds the stock that expired
+	other := uuid.New().String()
+	hold(t, repo, other, time.Now().Add(time.Hour), domain.StockItem{ProductID: bike, Quantity: 1})
+
+	// Still available, so the late payment takes the stock again
+	if _, unavailable, err := repo.Commit(ctx, late); err != nil || len(unavailable) > 0 {
+		t.Fatalf("Failed to commit: %v, unavailable %v", err, unavailable)
+	}
+	expectStock(t, db, bike, 1, 1)
+
+	// Gone, so nothing is committed
+	_, unavailable, err := repo.Commit(ctx, tooLate)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(unavailable) != 1 || unavailable[0].ProductID != bike || unavailable[0].Quantity != 1 {
+		t.Errorf("Expected 1 bike to be unavailable, got %v", unavailable)
+	}
+	expectStock(t, db, bike, 1, 1)
+
+	if held, _ := repo.GetByOrderID(ctx, tooLate); len(held) != 1 || held[0].Status != domain.ReservationStatusExpired {
+		t.Errorf("Expected the reservation to stay expired, got %v", held)
+	}
+}
+
+func TestReservationRepository_CommitAfterRelease(t *testing.T) {
+	db := openTestDB(t)
+	repo := repository.NewPostgresReservationRepository(db)
+	ctx := context.Background()
+
+	bike := createProduct(t, db, 3)
+	orderID := uuid.New().String()
+	hold(t, repo, orderID, time.Now().Add(time.Hour), domain.StockItem{ProductID: bike, Quantity: 2})
+
+	if _, err := repo.Release(ctx, orderID); err != nil {
+		t.Fatalf("Failed to release: %v", err)
+	}
+	expectStock(t, db, bike, 3, 0)
+
+	if _, _, err := repo.Commit(ctx, orderID); !errors.Is(err, repository.ErrReservationReleased) {
+		t.Errorf("Expected ErrReservationReleased, got %v", err)
+	}
+	expectStock(t, db, bike, 3, 0)
+}
+
+func TestReservationRepository_Release(t *testing.T) {
+	db := openTestDB(t)
+	repo := repository.NewPostgresReservationRepository(db)
+	ctx := context.Background()
+	expiresAt := time.Now().Add(time.Hour)
+
+	bike := createProduct(t, db, 5)
+
+	held := uuid.New().String()
+	hold(t, repo, held, expiresAt, domain.StockItem{ProductID: bike, Quantity: 1})
+
+	sold := uuid.New().String()
+	hold(t, repo, sold, expiresAt, domain.StockItem{ProductID: bike, Quantity: 2})
+	if _, _, err := repo.Commit(ctx, sold); err != nil {
+		t.Fatalf("Failed to commit: %v", err)
+	}
+	expectStock(t, db, bike, 3, 1)
+
+	if _, err := repo.Release(ctx, held); err != nil {
+		t.Fatalf("Failed to release held stock: %v", err)
+	}
+	expectStock(t, db, bike, 3, 0)
+
+	if _, err := repo.Release(ctx, sold); err != nil {
+		t.Fatalf("Failed to release committed stock: %v", err)
+	}
+	expectStock(t, db, bike, 5, 0)
+
+	// Releasing again hands nothing back twice
+	if _, err := repo.Release(ctx, sold); err != nil {
+		t.Fatalf("Failed to release again: %v", err)
+	}
+	expectStock(t, db, bike, 5, 0)
+
+	if _, err := repo.Release(ctx, uuid.New().String()); !errors.Is(err, repository.ErrReservationNotFound) {
+		t.Errorf("Expected ErrReservationNotFound, got %v", err)
+	}
+}
+
+func TestReservationRepository_ExpireDueInBatches(t *testing.T) {
+	db := openTestDB(t)
+	repo := repository.NewPostgresReservationRepository(db)
+	ctx := context.Background()
+	now := time.Now()
+
+	bike := createProduct(t, db, 10)
+	for i := 0; i < 5; i++ {
+		hold(t, repo, uuid.New().String(), now.Add(-time.Duration(i+1)*time.Minute), domain.StockItem{ProductID: bike, Quantity: 1})
+	}
+	current := uuid.New().String()
+	hold(t, repo, current, now.Add(time.Hour), domain.StockItem{ProductID: bike, Quantity: 2})
+	expectStock(t, db, bike, 10, 7)
+
+	var batches []int
+	for {
+		expired, err := repo.ExpireDue(ctx, now, 2)
+		if err != nil {
+			t.Fatalf("Failed to expire: %v", err)
+		}
+		for _, reservation := range expired {
+			if reservation.Status != domain.ReservationStatusExpired {
+				t.Errorf("Expected an expired reservation, got %s", reservation.Status)
+			}
+		}
+		batches = append(batches, len(expired))
+		if len(expired) < 2 {
+			break
+		}
+	}
+
+	if got := fmt.Sprint(batches); got != "[2 2 1]" {
+		t.Errorf("Expected batches of [2 2 1], got %s", got)
+	}
+	expectStock(t, db, bike, 10, 2)
+
+	if held, _ := repo.GetByOrderID(ctx, current); len(held) != 1 || held[0].Status != domain.ReservationStatusHeld {
+		t.Errorf("Expected the current reservation to stay held, got %v", held)
+	}
+}
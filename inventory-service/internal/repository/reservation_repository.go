@@ -0,0 +1,529 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+	"time"
+
+	"inventory-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrReservationNotFound = errors.New("reservation not found")
+	ErrReservationReleased = errors.New("reservation has been released")
+)
+
+type ReservationRepository interface {
+	// Hold sets stock aside for an order until expiresAt. Holding is all-or-nothing: if any
+	// product is short on available stock nothing is held and the failing items are returned.
+	// An order that already has reservations is left as it is.
+	Hold(ctx context.Context, orderID string, items []domain.StockItem, expiresAt time.Time) ([]domain.Reservation, []domain.StockItem, error)
+	// Adjust changes what is held for an order to items, holding only the difference and
+	// extending the hold until expiresAt. Like Hold it is all-or-nothing. The reservations
+	// replaced or dropped are returned along with the new ones.
+	Adjust(ctx context.Context, orderID string, items []domain.StockItem, expiresAt time.Time) ([]domain.Reservation, []domain.Reservation, []domain.StockItem, error)
+	// Commit turns an order's reservations into a sale. Expired reservations are taken from
+	// available stock again; if that is no longer possible the failing items are returned.
+	Commit(ctx context.Context, orderID string) ([]domain.Reservation, []domain.StockItem, error)
+	// Release hands an order's stock back, whether it was still held or already committed.
+	Release(ctx context.Context, orderID string) ([]domain.Reservation, error)
+	// ExpireDue releases up to limit held reservations whose time ran out before now.
+	ExpireDue(ctx context.Context, now time.Time, limit int) ([]domain.Reservation, error)
+	GetByOrderID(ctx context.Context, orderID string) ([]domain.Reservation, error)
+}
+
+type PostgresReservationRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresReservationRepository(db *sql.DB) ReservationRepository {
+	return &PostgresReservationRepository{
+		db: db,
+	}
+}
+
+const reservationColumns = `id, order_id, product_id, quantity, status, expires_at, created_at, updated_at`
+
+func (r *PostgresReservationRepository) Hold(ctx context.Context, orderID string, items []domain.StockItem, expiresAt time.Time) ([]domain.Reservation, []domain.StockItem, error) {
+	if orderID == "" {
+		return nil, nil, errors.New("order ID is required")
+	}
+
+	items, err := mergeStockItems(items)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, errors.New("failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	existing, err := lockReservations(ctx, tx, orderID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// A retried hold for the same order must not set the stock aside twice
+	if len(existing) > 0 {
+		return existing, nil, nil
+	}
+
+	now := time.Now()
+	holdQuery := `UPDATE products SET reserved = reserved + $1, updated_at = $2 WHERE id = $3 AND stock - reserved >= $1`
+
+	var unavailable []domain.StockItem
+	for _, item := range items {
+		result, err := tx.ExecContext(ctx, holdQuery, item.Quantity, now, item.ProductID)
+		if err != nil {
+			return nil, nil, errors.New("failed to hold stock")
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return nil, nil, errors.New("failed to check update result")
+		}
+
+		if rowsAffected == 0 {
+			unavailable = append(unavailable, item)
+		}
+	}
+
+	if len(unavailable) > 0 {
+		return nil, unavailable, nil
+	}
+
+	insertQuery := `
+		INSERT INTO stock_reservations (id, order_id, product_id, quantity, status, expires_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	reservations := make([]domain.Reservation, 0, len(items))
+	for _, item := range items {
+		reservation := domain.Reservation{
+			ID:        uuid.New().String(),
+			OrderID:   orderID,
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+			Status:    domain.ReservationStatusHeld,
+			ExpiresAt: expiresAt,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+
+		_, err := tx.ExecContext(
+			ctx,
+			insertQuery,
+			reservation.ID,
+			reservation.OrderID,
+			reservation.ProductID,
+			reservation.Quantity,
+			reservation.Status,
+			reservation.ExpiresAt,
+			reservation.CreatedAt,
+			reservation.UpdatedAt,
+		)
+		if err != nil {
+			return nil, nil, errors.New("failed to create reservation")
+		}
+
+		reservations = append(reservations, reservation)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, nil, errors.New("failed to commit transaction")
+	}
+
+	return reservations, nil, nil
+}
+
+func (r *PostgresReservationRepository) Adjust(ctx context.Context, orderID string, items []domain.StockItem, expiresAt time.Time) ([]domain.Reservation, []domain.Reservation, []domain.StockItem, error) {
+	if orderID == "" {
+		return nil, nil, nil, errors.New("order ID is required")
+	}
+
+	items, err := mergeStockItems(items)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, nil, errors.New("failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	previous, err := lockReservations(ctx, tx, orderID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if len(previous) == 0 {
+		return nil, nil, nil, ErrReservationNotFound
+	}
+
+	// Expired reservations no longer hold any stock, so all of their quantity is taken again
+	held := make(map[string]int, len(previous))
+	for _, reservation := range previous {
+		switch reservation.Status {
+		case domain.ReservationStatusCommitted, domain.ReservationStatusReleased:
+			return nil, nil, nil, ErrReservationReleased
+		case domain.ReservationStatusHeld:
+			held[reservation.ProductID] = reservation.Quantity
+		}
+	}
+
+	wanted := make(map[string]int, len(items))
+	for _, item := range items {
+		wanted[item.ProductID] = item.Quantity
+	}
+
+	// Lock products in the same order as holds do
+	productIDs := make([]string, 0, len(held)+len(items))
+	for productID := range held {
+		productIDs = append(productIDs, productID)
+	}
+	for _, item := range items {
+		if _, ok := held[item.ProductID]; !ok {
+			productIDs = append(productIDs, item.ProductID)
+		}
+	}
+	sort.Strings(productIDs)
+
+	now := time.Now()
+	holdQuery := `UPDATE products SET reserved = reserved + $1, updated_at = $2 WHERE id = $3 AND stock - reserved >= $1`
+	releaseQuery := `UPDATE products SET reserved = reserved - $1, updated_at = $2 WHERE id = $3`
+
+	var unavailable []domain.StockItem
+	for _, productID := range productIDs {
+		delta := wanted[productID] - held[productID]
+		switch {
+		case delta > 0:
+			result, err := tx.ExecContext(ctx, holdQuery, delta, now, productID)
+			if err != nil {
+				return nil, nil, nil, errors.New("failed to hold stock")
+			}
+
+			rowsAffected, err := result.RowsAffected()
+			if err != nil {
+				return nil, nil, nil, errors.New("failed to check update result")
+			}
+
+			if rowsAffected == 0 {
+				unavailable = append(unavailable, domain.StockItem{ProductID: productID, Quantity: wanted[productID]})
+			}
+		case delta < 0:
+			if _, err := tx.ExecContext(ctx, releaseQuery, -delta, now, productID); err != nil {
+				return nil, nil, nil, errors.New("failed to release stock")
+			}
+		}
+	}
+
+	if len(unavailable) > 0 {
+		return nil, nil, unavailable, nil
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM stock_reservations WHERE order_id = $1`, orderID); err != nil {
+		return nil, nil, nil, errors.New("failed to update reservation")
+	}
+
+	insertQuery := `
+		INSERT INTO stock_reservations (id, order_id, product_id, quantity, status, expires_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	reservations := make([]domain.Reservation, 0, len(items))
+	for _, item := range items {
+		reservation := domain.Reservation{
+			ID:        uuid.New().String(),
+			OrderID:   orderID,
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+			Status:    domain.ReservationStatusHeld,
+			ExpiresAt: expiresAt,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+
+		_, err := tx.ExecContext(
+			ctx,
+			insertQuery,
+			reservation.ID,
+			reservation.OrderID,
+			reservation.ProductID,
+			reservation.Quantity,
+			reservation.Status,
+			reservation.ExpiresAt,
+			reservation.CreatedAt,
+			reservation.UpdatedAt,
+		)
+		if err != nil {
+			return nil, nil, nil, errors.New("failed to create reservation")
+		}
+
+		reservations = append(reservations, reservation)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, nil, nil, errors.New("failed to commit transaction")
+	}
+
+	return reservations, previous, nil, nil
+}
+
+func (r *PostgresReservationRepository) Commit(ctx context.Context, orderID string) ([]domain.Reservation, []domain.StockItem, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, errors.New("failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	reservations, err := lockReservations(ctx, tx, orderID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(reservations) == 0 {
+		return nil, nil, ErrReservationNotFound
+	}
+
+	now := time.Now()
+	heldQuery := `UPDATE products SET stock = stock - $1, reserved = reserved - $1, updated_at = $2 WHERE id = $3`
+	expiredQuery := `UPDATE products SET stock = stock - $1, updated_at = $2 WHERE id = $3 AND stock - reserved >= $1`
+
+	var unavailable []domain.StockItem
+	for _, reservation := range reservations {
+		var query string
+		switch reservation.Status {
+		case domain.ReservationStatusCommitted:
+			continue
+		case domain.ReservationStatusReleased:
+			return nil, nil, ErrReservationReleased
+		case domain.ReservationStatusHeld:
+			query = heldQuery
+		case domain.ReservationStatusExpired:
+			query = expiredQuery
+		}
+
+		result, err := tx.ExecContext(ctx, query, reservation.Quantity, now, reservation.ProductID)
+		if err != nil {
+			return nil, nil, errors.New("failed to commit stock")
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return nil, nil, errors.New("failed to check update result")
+		}
+
+		if rowsAffected == 0 {
+			unavailable = append(unavailable, domain.StockItem{
+				ProductID: reservation.ProductID,
+				Quantity:  reservation.Quantity,
+			})
+		}
+	}
+
+	if len(unavailable) > 0 {
+		return nil, unavailable, nil
+	}
+
+	if err := setReservationStatus(ctx, tx, orderID, domain.ReservationStatusCommitted, now); err != nil {
+		return nil, nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, nil, errors.New("failed to commit transaction")
+	}
+
+	for i := range reservations {
+		reservations[i].Status = domain.ReservationStatusCommitted
+		reservations[i].UpdatedAt = now
+	}
+
+	return reservations, nil, nil
+}
+
+func (r *PostgresReservationRepository) Release(ctx context.Context, orderID string) ([]domain.Reservation, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.New("failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	reservations, err := lockReservations(ctx, tx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(reservations) == 0 {
+		return nil, ErrReservationNotFound
+	}
+
+	now := time.Now()
+	heldQuery := `UPDATE products SET reserved = reserved - $1, updated_at = $2 WHERE id = $3`
+	committedQuery := `UPDATE products SET stock = stock + $1, updated_at = $2 WHERE id = $3`
+
+	for _, reservation := range reservations {
+		var query string
+		switch reservation.Status {
+		case domain.ReservationStatusHeld:
+			query = heldQuery
+		case domain.ReservationStatusCommitted:
+			query = committedQuery
+		default:
+			// Expired and released reservations no longer hold any stock
+			continue
+		}
+
+		if _, err := tx.ExecContext(ctx, query, reservation.Quantity, now, reservation.ProductID); err != nil {
+			return nil, errors.New("failed to release stock")
+		}
+	}
+
+	if err := setReservationStatus(ctx, tx, orderID, domain.ReservationStatusReleased, now); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, errors.New("failed to commit transaction")
+	}
+
+	for i := range reservations {
+		reservations[i].Status = domain.ReservationStatusReleased
+		reservations[i].UpdatedAt = now
+	}
+
+	return reservations, nil
+}
+
+func (r *PostgresReservationRepository) ExpireDue(ctx context.Context, now time.Time, limit int) ([]domain.Reservation, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.New("failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	// SKIP LOCKED lets several replicas sweep at once without waiting on each other
+	query := `
+		SELECT ` + reservationColumns + `
+		FROM stock_reservations
+		WHERE status = $1 AND expires_at <= $2
+		ORDER BY expires_at
+		LIMIT $3
+		FOR UPDATE SKIP LOCKED`
+
+	rows, err := tx.QueryContext(ctx, query, domain.ReservationStatusHeld, now, limit)
+	if err != nil {
+		return nil, errors.New("failed to get expired reservations")
+	}
+
+	reservations, err := scanReservations(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(reservations) == 0 {
+		return nil, nil
+	}
+
+	// Lock products in the same order as holds do
+	sort.Slice(reservations, func(i, j int) bool {
+		return reservations[i].ProductID < reservations[j].ProductID
+	})
+
+	for i, reservation := range reservations {
+		if _, err := tx.ExecContext(ctx,
+			`UPDATE products SET reserved = reserved - $1, updated_at = $2 WHERE id = $3`,
+			reservation.Quantity, now, reservation.ProductID,
+		); err != nil {
+			return nil, errors.New("failed to release stock")
+		}
+
+		if _, err := tx.ExecContext(ctx,
+			`UPDATE stock_reservations SET status = $1, updated_at = $2 WHERE id = $3`,
+			domain.ReservationStatusExpired, now, reservation.ID,
+		); err != nil {
+			return nil, errors.New("failed to update reservation")
+		}
+
+		reservations[i].Status = domain.ReservationStatusExpired
+		reservations[i].UpdatedAt = now
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, errors.New("failed to commit transaction")
+	}
+
+	return reservations, nil
+}
+
+func (r *PostgresReservationRepository) GetByOrderID(ctx context.Context, orderID string) ([]domain.Reservation, error) {
+	if orderID == "" {
+		return nil, errors.New("order ID is required")
+	}
+
+	query := `SELECT ` + reservationColumns + ` FROM stock_reservations WHERE order_id = $1 ORDER BY product_id`
+
+	rows, err := r.db.QueryContext(ctx, query, orderID)
+	if err != nil {
+		return nil, errors.New("failed to get reservations")
+	}
+
+	return scanReservations(rows)
+}
+
+// lockReservations loads an order's reservations ordered by product so concurrent
+// adjustments always lock product rows in the same order.
+func lockReservations(ctx context.Context, tx *sql.Tx, orderID string) ([]domain.Reservation, error) {
+	query := `SELECT ` + reservationColumns + ` FROM stock_reservations WHERE order_id = $1 ORDER BY product_id FOR UPDATE`
+
+	rows, err := tx.QueryContext(ctx, query, orderID)
+	if err != nil {
+		return nil, errors.New("failed to get reservations")
+	}
+
+	return scanReservations(rows)
+}
+
+func setReservationStatus(ctx context.Context, tx *sql.Tx, orderID string, status domain.ReservationStatus, now time.Time) error {
+	query := `UPDATE stock_reservations SET status = $1, updated_at = $2 WHERE order_id = $3 AND status <> $1`
+
+	if _, err := tx.ExecContext(ctx, query, status, now, orderID); err != nil {
+		return errors.New("failed to update reservation")
+	}
+
+	return nil
+}
+
+func scanReservations(rows *sql.Rows) ([]domain.Reservation, error) {
+	defer rows.Close()
+
+	var reservations []domain.Reservation
+	for rows.Next() {
+		var reservation domain.Reservation
+		err := rows.Scan(
+			&reservation.ID,
+			&reservation.OrderID,
+			&reservation.ProductID,
+			&reservation.Quantity,
+			&reservation.Status,
+			&reservation.ExpiresAt,
+			&reservation.CreatedAt,
+			&reservation.UpdatedAt,
+		)
+		if err != nil {
+			return nil, errors.New("failed to scan reservation")
+		}
+
+		reservations = append(reservations, reservation)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.New("failed to read reservations")
+	}
+
+	return reservations, nil
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"inventory-service/internal/domain"
+	"inventory-service/internal/repository"
+)
+
+var (
+	ErrUnsupportedCurrency = errors.New("unsupported currency")
+	ErrInvalidExchangeRate = errors.New("invalid exchange rate")
+)
+
+// nonCentCurrencies are the ISO 4217 currencies whose minor unit is not a hundredth. Money
+// counts cents, so prices in these would be off by a factor of ten or more.
+var nonCentCurrencies = map[string]bool{
+	// No minor unit
+	"BIF": true, "CLP": true, "DJF": true, "GNF": true, "ISK": true, "JPY": true, "KMF": true,
+	"KRW": true, "PYG": true, "RWF": true, "UGX": true, "UYI": true, "VND": true, "VUV": true,
+	"XAF": true, "XOF": true, "XPF": true,
+	// Thousandths
+	"BHD": true, "IQD": true, "JOD": true, "KWD": true, "LYD": true, "OMR": true, "TND": true,
+	// Ten-thousandths
+	"CLF": true, "UYW": true,
+}
+
+type CurrencyService interface {
+	// BaseCurrency is the currency catalogue prices are stored in.
+	BaseCurrency() string
+	// Rate returns the units of currency that one unit of the base currency buys. The base
+	// currency, and an empty currency, have a rate of 1.
+	Rate(ctx context.Context, currency string) (float64, error)
+	ListRates(ctx context.Context) ([]domain.ExchangeRate, error)
+	// SetRates replaces the exchange-rate table. Currencies left out are no longer supported.
+	SetRates(ctx context.Context, rates []domain.ExchangeRate) ([]domain.ExchangeRate, error)
+	// LoadRates replaces the exchange-rate table with a JSON file mapping currency codes to
+	// rates, e.g. {"EUR": 0.92}.
+	LoadRates(ctx context.Context, path string) ([]domain.ExchangeRate, error)
+}
+
+type currencyService struct {
+	exchangeRateRepo repository.ExchangeRateRepository
+	baseCurrency     string
+}
+
+func NewCurrencyService(exchangeRateRepo repository.ExchangeRateRepository, baseCurrency string) CurrencyService {
+	return &currencyService{
+		exchangeRateRepo: exchangeRateRepo,
+		baseCurrency:     normalizeCurrency(baseCurrency),
+	}
+}
+
+func (s *currencyService) BaseCurrency() string {
+	return s.baseCurrency
+}
+
+func (s *currencyService) Rate(ctx context.Context, currency string) (float64, error) {
+	currency = normalizeCurrency(currency)
+	if currency == "" || currency == s.baseCurrency {
+		return 1, nil
+	}
+
+	rate, err := s.exchangeRateRepo.Get(ctx, currency)
+	if err != nil {
+		if errors.Is(err, repository.ErrExchangeRateNotFound) {
+			return 0, fmt.Errorf("%w: %s", ErrUnsupportedCurrency, currency)
+		}
+		return 0, err
+	}
+
+	return rate.Rate, nil
+}
+
+func (s *currencyService) ListRates(ctx context.Context) ([]domain.ExchangeRate, error) {
+	return s.exchangeRateRepo.List(ctx)
+}
+
+func (s *currencyService) SetRates(ctx context.Context, rates []domain.ExchangeRate) ([]domain.ExchangeRate, error) {
+	seen := make(map[string]bool, len(rates))
+	for i := range rates {
+		currency := normalizeCurrency(rates[i].Currency)
+		if !isCurrencyCode(currency) {
+			return nil, fmt.Errorf("%w: %q is not a currency code", ErrInvalidExchangeRate, rates[i].Currency)
+		}
+		if currency == s.baseCurrency {
+			return nil, fmt.Errorf("%w: %s is the base currency", ErrInvalidExchangeRate, currency)
+		}
+		if nonCentCurrencies[currency] {
+			return nil, fmt.Errorf("%w: %s does not have two decimal places", ErrInvalidExchangeRate, currency)
+		}
+		if seen[currency] {
+			return nil, fmt.Errorf("%w: %s is listed more than once", ErrInvalidExchangeRate, currency)
+		}
+		if rates[i].Rate <= 0 {
+			return nil, fmt.Errorf("%w: rate for %s must be positive", ErrInvalidExchangeRate, currency)
+		}
+
+		seen[currency] = true
+		rates[i].Currency = currency
+	}
+
+	if err := s.exchangeRateRepo.Replace(ctx, rates); err != nil {
+		return nil, err
+	}
+
+	return s.exchangeRateRepo.List(ctx)
+}
+
+func (s *currencyService) LoadRates(ctx context.Context, path string) ([]domain.ExchangeRate, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read exchange rates: %w", err)
+	}
+
+	var table map[string]float64
+	if err := json.Unmarshal(data, &table); err != nil {
+		return nil, fmt.Errorf("failed to parse exchange rates: %w", err)
+	}
+
+	rates := make([]domain.ExchangeRate, 0, len(table))
+	for currency, rate := range table {
+		rates = append(rates, domain.ExchangeRate{Currency: currency, Rate: rate})
+	}
+	sort.Slice(rates, func(i, j int) bool {
+		return rates[i].Currency < rates[j].Currency
+	})
+
+	return s.SetRates(ctx, rates)
+}
+
+func normalizeCurrency(currency string) string {
+	return strings.ToUpper(strings.TrimSpace(currency))
+}
+
+func isCurrencyCode(currency string) bool {
+	if len(currency) != 3 {
+		return false
+	}
+	for _, c := range currency {
+		if c < 'A' || c > 'Z' {
+			return false
+		}
+	}
+	return true
+}
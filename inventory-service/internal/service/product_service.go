@@ -17,6 +17,8 @@ type ProductService interface {
 	UpdateProduct(ctx context.Context, product domain.Product) error
 	DeleteProduct(ctx context.Context, id string) error
 	ListProducts(ctx context.Context, filter domain.ProductFilter) ([]domain.Product, int, error)
+	ReserveStock(ctx context.Context, items []domain.StockItem) ([]domain.StockItem, error)
+	ReleaseStock(ctx context.Context, items []domain.StockItem) ([]domain.StockItem, error)
 }
 
 type productService struct {
@@ -95,3 +97,38 @@ func (s *productService) ListProducts(ctx context.Context, filter domain.Product
 	// Not caching list operations due to complexity of cache invalidation
 	return s.productRepo.List(ctx, filter)
 }
+
+func (s *productService) ReserveStock(ctx context.Context, items []domain.StockItem) ([]domain.StockItem, error) {
+	unavailable, err := s.productRepo.ReserveStock(ctx, items)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(unavailable) == 0 {
+		s.invalidateStockCache(ctx, items)
+	}
+
+	return unavailable, nil
+}
+
+func (s *productService) ReleaseStock(ctx context.Context, items []domain.StockItem) ([]domain.StockItem, error) {
+	unavailable, err := s.productRepo.ReleaseStock(ctx, items)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(unavailable) == 0 {
+		s.invalidateStockCache(ctx, items)
+	}
+
+	return unavailable, nil
+}
+
+func (s *productService) invalidateStockCache(ctx context.Context, items []domain.StockItem) {
+	for _, item := range items {
+		cacheKey := fmt.Sprintf("product:%s", item.ProductID)
+		if err := s.cache.Delete(ctx, cacheKey); err != nil {
+			log.Printf("Failed to invalidate cache for product ID %s: %v", item.ProductID, err)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"inventory-service/internal/cache"
+	"inventory-service/internal/domain"
+	"inventory-service/internal/repository"
+)
+
+type ReservationService interface {
+	HoldStock(ctx context.Context, orderID string, items []domain.StockItem) ([]domain.Reservation, []domain.StockItem, error)
+	// AdjustReservation changes the stock held for an order whose items were edited
+	AdjustReservation(ctx context.Context, orderID string, items []domain.StockItem) ([]domain.Reservation, []domain.StockItem, error)
+	CommitReservation(ctx context.Context, orderID string) ([]domain.Reservation, []domain.StockItem, error)
+	ReleaseReservation(ctx context.Context, orderID string) ([]domain.Reservation, error)
+	ExpireReservations(ctx context.Context, limit int) (int, error)
+}
+
+type reservationService struct {
+	reservationRepo repository.ReservationRepository
+	cache           cache.Cache
+	ttl             time.Duration
+}
+
+func NewReservationService(reservationRepo repository.ReservationRepository, cache cache.Cache, ttl time.Duration) ReservationService {
+	return &reservationService{
+		reservationRepo: reservationRepo,
+		cache:           cache,
+		ttl:             ttl,
+	}
+}
+
+func (s *reservationService) HoldStock(ctx context.Context, orderID string, items []domain.StockItem) ([]domain.Reservation, []domain.StockItem, error) {
+	reservations, unavailable, err := s.reservationRepo.Hold(ctx, orderID, items, time.Now().Add(s.ttl))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s.invalidateReservedProducts(ctx, reservations)
+	return reservations, unavailable, nil
+}
+
+func (s *reservationService) AdjustReservation(ctx context.Context, orderID string, items []domain.StockItem) ([]domain.Reservation, []domain.StockItem, error) {
+	reservations, previous, unavailable, err := s.reservationRepo.Adjust(ctx, orderID, items, time.Now().Add(s.ttl))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s.invalidateReservedProducts(ctx, append(previous, reservations...))
+	return reservations, unavailable, nil
+}
+
+func (s *reservationService) CommitReservation(ctx context.Context, orderID string) ([]domain.Reservation, []domain.StockItem, error) {
+	reservations, unavailable, err := s.reservationRepo.Commit(ctx, orderID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s.invalidateReservedProducts(ctx, reservations)
+	return reservations, unavailable, nil
+}
+
+func (s *reservationService) ReleaseReservation(ctx context.Context, orderID string) ([]domain.Reservation, error) {
+	reservations, err := s.reservationRepo.Release(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.invalidateReservedProducts(ctx, reservations)
+	return reservations, nil
+}
+
+func (s *reservationService) ExpireReservations(ctx context.Context, limit int) (int, error) {
+	reservations, err := s.reservationRepo.ExpireDue(ctx, time.Now(), limit)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, reservation := range reservations {
+		log.Printf("Reservation of %d x product %s for order %s expired", reservation.Quantity, reservation.ProductID, reservation.OrderID)
+	}
+
+	s.invalidateReservedProducts(ctx, reservations)
+	return len(reservations), nil
+}
+
+// invalidateReservedProducts drops cached products whose available stock just changed.
+func (s *reservationService) invalidateReservedProducts(ctx context.Context, reservations []domain.Reservation) {
+	for _, reservation := range reservations {
+		cacheKey := fmt.Sprintf("product:%s", reservation.ProductID)
+		if err := s.cache.Delete(ctx, cacheKey); err != nil {
+			log.Printf("Failed to invalidate cache for product ID %s: %v", reservation.ProductID, err)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// ReservationSweeper hands back stock held for orders that were never paid.
+// It is safe to run on every replica since each expired reservation is claimed by one sweep.
+type ReservationSweeper struct {
+	reservationService ReservationService
+	interval           time.Duration
+	batchSize          int
+}
+
+func NewReservationSweeper(reservationService ReservationService, interval time.Duration, batchSize int) *ReservationSweeper {
+	return &ReservationSweeper{
+		reservationService: reservationService,
+		interval:           interval,
+		batchSize:          batchSize,
+	}
+}
+
+// Run sweeps expired reservations until ctx is cancelled.
+func (s *ReservationSweeper) Run(ctx context.Context) {
+	log.Printf("[RESERVATION-SWEEPER] Starting, sweeping every %s", s.interval)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		// Keep sweeping while full batches come back
+		for {
+			expired, err := s.reservationService.ExpireReservations(ctx, s.batchSize)
+			if err != nil {
+				log.Printf("[RESERVATION-SWEEPER] Failed to expire reservations: %v", err)
+				break
+			}
+			if expired > 0 {
+				log.Printf("[RESERVATION-SWEEPER] Released %d expired reservations", expired)
+			}
+			if expired < s.batchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Printf("[RESERVATION-SWEEPER] Stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
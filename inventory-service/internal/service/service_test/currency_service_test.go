@@ -0,0 +1,65 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"inventory-service/internal/domain"
+	"inventory-service/internal/repository"
+	"inventory-service/internal/service"
+)
+
+// memoryExchangeRates keeps the exchange-rate table in memory
+type memoryExchangeRates struct {
+	repository.ExchangeRateRepository
+	rates []domain.ExchangeRate
+}
+
+func (r *memoryExchangeRates) List(ctx context.Context) ([]domain.ExchangeRate, error) {
+	return r.rates, nil
+}
+
+func (r *memoryExchangeRates) Replace(ctx context.Context, rates []domain.ExchangeRate) error {
+	r.rates = rates
+	return nil
+}
+
+func TestSetRates(t *testing.T) {
+	tests := []struct {
+		name    string
+		rates   []domain.ExchangeRate
+		wantErr bool
+	}{
+		{name: "two-decimal currencies", rates: []domain.ExchangeRate{{Currency: "eur", Rate: 0.92}, {Currency: "GBP", Rate: 0.79}}},
+		{name: "zero-decimal currency", rates: []domain.ExchangeRate{{Currency: "JPY", Rate: 150}}, wantErr: true},
+		{name: "zero-decimal currency among others", rates: []domain.ExchangeRate{{Currency: "EUR", Rate: 0.92}, {Currency: "krw", Rate: 1350}}, wantErr: true},
+		{name: "three-decimal currency", rates: []domain.ExchangeRate{{Currency: "KWD", Rate: 0.31}}, wantErr: true},
+		{name: "base currency", rates: []domain.ExchangeRate{{Currency: "USD", Rate: 1}}, wantErr: true},
+		{name: "not a currency code", rates: []domain.ExchangeRate{{Currency: "EURO", Rate: 0.92}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &memoryExchangeRates{}
+			currencyService := service.NewCurrencyService(repo, "USD")
+
+			_, err := currencyService.SetRates(context.Background(), tt.rates)
+			if tt.wantErr {
+				if !errors.Is(err, service.ErrInvalidExchangeRate) {
+					t.Fatalf("Expected ErrInvalidExchangeRate, got %v", err)
+				}
+				if repo.rates != nil {
+					t.Errorf("Expected the table to be left alone, got %v", repo.rates)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(repo.rates) != len(tt.rates) {
+				t.Errorf("Expected %d rates stored, got %d", len(tt.rates), len(repo.rates))
+			}
+		})
+	}
+}
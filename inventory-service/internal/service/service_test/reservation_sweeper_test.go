@@ -0,0 +1,84 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"inventory-service/internal/service"
+)
+
+// scriptedReservations answers expiry sweeps from results and cancels the sweep after the last one
+type scriptedReservations struct {
+	service.ReservationService
+	results []int
+	err     error
+	limits  []int
+	cancel  context.CancelFunc
+}
+
+func (s *scriptedReservations) ExpireReservations(ctx context.Context, limit int) (int, error) {
+	s.limits = append(s.limits, limit)
+	if len(s.limits) >= len(s.results) {
+		s.cancel()
+	}
+	if len(s.limits) > len(s.results) {
+		return 0, nil
+	}
+
+	expired := s.results[len(s.limits)-1]
+	if expired < 0 {
+		return 0, s.err
+	}
+	return expired, nil
+}
+
+func runSweep(t *testing.T, results []int) *scriptedReservations {
+	ctx, cancel := context.WithCancel(context.Background())
+	reservations := &scriptedReservations{results: results, err: errors.New("database unavailable"), cancel: cancel}
+
+	done := make(chan struct{})
+	go func() {
+		service.NewReservationSweeper(reservations, time.Hour, 3).Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		cancel()
+		t.Fatal("Sweeper did not stop")
+	}
+	return reservations
+}
+
+func TestReservationSweeper_KeepsSweepingFullBatches(t *testing.T) {
+	reservations := runSweep(t, []int{3, 3, 1})
+
+	if len(reservations.limits) != 3 {
+		t.Fatalf("Expected 3 sweeps, got %d", len(reservations.limits))
+	}
+	for _, limit := range reservations.limits {
+		if limit != 3 {
+			t.Errorf("Expected batches of 3, got %d", limit)
+		}
+	}
+}
+
+func TestReservationSweeper_StopsAtEmptyBatch(t *testing.T) {
+	reservations := runSweep(t, []int{3, 0})
+
+	if len(reservations.limits) != 2 {
+		t.Errorf("Expected 2 sweeps, got %d", len(reservations.limits))
+	}
+}
+
+func TestReservationSweeper_WaitsAfterFailure(t *testing.T) {
+	// A failure ends the round even though the batch before it was full
+	reservations := runSweep(t, []int{3, -1})
+
+	if len(reservations.limits) != 2 {
+		t.Errorf("Expected 2 sweeps, got %d", len(reservations.limits))
+	}
+}
@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS exchange_rates (
+    currency CHAR(3) PRIMARY KEY,
+    -- Units of this currency bought by one unit of the base currency
+    rate DECIMAL(18,8) NOT NULL CHECK (rate > 0),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
\ No newline at end of file
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_payments_order_completed;
+
+ALTER TABLE payments DROP COLUMN IF EXISTS transaction_id;
\ No newline at end of file
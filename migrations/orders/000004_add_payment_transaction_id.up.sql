@@ -0,0 +1,3 @@
+ALTER TABLE payments ADD COLUMN transaction_id VARCHAR(100);
+
+CREATE UNIQUE INDEX idx_payments_order_completed ON payments(order_id) WHERE status = 'completed';
\ No newline at end of file
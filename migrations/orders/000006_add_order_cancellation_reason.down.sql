@@ -0,0 +1 @@
+ALTER TABLE orders DROP COLUMN IF EXISTS cancellation_reason;
\ No newline at end of file
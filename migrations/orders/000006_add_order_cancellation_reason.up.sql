@@ -0,0 +1 @@
+ALTER TABLE orders ADD COLUMN cancellation_reason TEXT;
\ No newline at end of file
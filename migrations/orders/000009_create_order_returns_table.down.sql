@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS order_return_items;
+DROP TABLE IF EXISTS order_returns;
+
+ALTER TABLE payments DROP CONSTRAINT IF EXISTS payments_refunded_amount_check;
+ALTER TABLE payments DROP COLUMN IF EXISTS refunded_amount;
\ No newline at end of file
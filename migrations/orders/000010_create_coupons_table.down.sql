@@ -0,0 +1,7 @@
+ALTER TABLE order_items DROP COLUMN IF EXISTS category_id;
+
+ALTER TABLE orders DROP COLUMN IF EXISTS discount;
+ALTER TABLE orders DROP COLUMN IF EXISTS coupon_code;
+
+DROP TABLE IF EXISTS coupon_redemptions;
+DROP TABLE IF EXISTS coupons;
\ No newline at end of file
@@ -0,0 +1,6 @@
+ALTER TABLE order_items DROP COLUMN IF EXISTS weight;
+
+ALTER TABLE orders DROP COLUMN IF EXISTS tax_region;
+ALTER TABLE orders DROP COLUMN IF EXISTS shipping;
+ALTER TABLE orders DROP COLUMN IF EXISTS tax;
+ALTER TABLE orders DROP COLUMN IF EXISTS subtotal;
\ No newline at end of file
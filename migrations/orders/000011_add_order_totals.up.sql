@@ -0,0 +1,9 @@
+ALTER TABLE orders ADD COLUMN subtotal DECIMAL(10,2) NOT NULL DEFAULT 0 CHECK (subtotal >= 0);
+ALTER TABLE orders ADD COLUMN tax DECIMAL(10,2) NOT NULL DEFAULT 0 CHECK (tax >= 0);
+ALTER TABLE orders ADD COLUMN shipping DECIMAL(10,2) NOT NULL DEFAULT 0 CHECK (shipping >= 0);
+ALTER TABLE orders ADD COLUMN tax_region VARCHAR(20);
+
+-- Orders placed before totals were itemised had no tax or shipping
+UPDATE orders SET subtotal = total + discount;
+
+ALTER TABLE order_items ADD COLUMN weight DECIMAL(10,2) NOT NULL DEFAULT 0 CHECK (weight >= 0);
\ No newline at end of file
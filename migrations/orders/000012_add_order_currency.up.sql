@@ -0,0 +1,7 @@
+-- Orders record the currency they were placed in and the rate from the base currency at
+-- checkout; existing orders were all in the base currency
+ALTER TABLE orders ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'USD';
+ALTER TABLE orders ADD COLUMN exchange_rate DECIMAL(18,8) NOT NULL DEFAULT 1 CHECK (exchange_rate > 0);
+
+ALTER TABLE payments ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'USD';
+ALTER TABLE order_returns ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'USD';
\ No newline at end of file
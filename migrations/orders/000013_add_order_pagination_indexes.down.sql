@@ -0,0 +1,5 @@
+CREATE INDEX IF NOT EXISTS idx_orders_user_id ON orders(user_id);
+CREATE INDEX IF NOT EXISTS idx_orders_created_at ON orders(created_at);
+
+DROP INDEX IF EXISTS idx_orders_user_created_at_id;
+DROP INDEX IF EXISTS idx_orders_created_at_id;
\ No newline at end of file
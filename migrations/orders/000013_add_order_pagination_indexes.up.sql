@@ -0,0 +1,6 @@
+-- Keyset pagination walks orders newest first by (created_at, id)
+CREATE INDEX idx_orders_created_at_id ON orders(created_at DESC, id DESC);
+CREATE INDEX idx_orders_user_created_at_id ON orders(user_id, created_at DESC, id DESC);
+
+DROP INDEX IF EXISTS idx_orders_created_at;
+DROP INDEX IF EXISTS idx_orders_user_id;
\ No newline at end of file
@@ -0,0 +1,6 @@
+-- The client's Idempotency-Key for the request that created the order; a retry of that
+-- request finds the order instead of creating another one
+ALTER TABLE orders ADD COLUMN idempotency_key VARCHAR(255);
+
+CREATE UNIQUE INDEX idx_orders_user_idempotency_key ON orders(user_id, idempotency_key)
+    WHERE idempotency_key IS NOT NULL;
\ No newline at end of file
@@ -0,0 +1,10 @@
+-- Carts of signed-in users; guest carts are kept in Redis until the guest signs in.
+-- Only the products and quantities are stored, prices come from the catalogue.
+CREATE TABLE IF NOT EXISTS cart_items (
+    user_id UUID NOT NULL,
+    product_id UUID NOT NULL,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    added_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (user_id, product_id)
+);
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE orders DROP COLUMN IF EXISTS shipping_address;
\ No newline at end of file
@@ -0,0 +1,3 @@
+-- A copy of the address the order ships to, taken when the order is placed, so later
+-- changes to the customer's address book leave the order as it was
+ALTER TABLE orders ADD COLUMN shipping_address JSONB;
\ No newline at end of file
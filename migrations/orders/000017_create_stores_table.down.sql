@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_orders_pickup;
+
+ALTER TABLE orders DROP CONSTRAINT IF EXISTS orders_pickup_check;
+ALTER TABLE orders DROP COLUMN IF EXISTS pickup_date;
+ALTER TABLE orders DROP COLUMN IF EXISTS pickup_store_id;
+
+DROP TABLE IF EXISTS stores;
\ No newline at end of file
@@ -0,0 +1,18 @@
+-- Shops where customers can collect their orders; daily_capacity caps how many orders
+-- can be picked up at a store on one day
+CREATE TABLE IF NOT EXISTS stores (
+    id UUID PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    address TEXT NOT NULL,
+    daily_capacity INTEGER NOT NULL CHECK (daily_capacity > 0),
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Orders collected in store instead of shipped
+ALTER TABLE orders ADD COLUMN pickup_store_id UUID REFERENCES stores(id);
+ALTER TABLE orders ADD COLUMN pickup_date DATE;
+ALTER TABLE orders ADD CONSTRAINT orders_pickup_check CHECK ((pickup_store_id IS NULL) = (pickup_date IS NULL));
+
+CREATE INDEX idx_orders_pickup ON orders(pickup_store_id, pickup_date) WHERE pickup_store_id IS NOT NULL;
\ No newline at end of file
@@ -0,0 +1,14 @@
+-- PostgreSQL cannot drop a value from an enum, so the type is rebuilt without it
+UPDATE orders SET status = 'paid' WHERE status = 'ready_for_pickup';
+DELETE FROM order_status_history WHERE from_status = 'ready_for_pickup' OR to_status = 'ready_for_pickup';
+
+ALTER TYPE order_status RENAME TO order_status_old;
+CREATE TYPE order_status AS ENUM ('pending', 'paid', 'shipped', 'delivered', 'cancelled');
+
+ALTER TABLE orders ALTER COLUMN status DROP DEFAULT;
+ALTER TABLE orders ALTER COLUMN status TYPE order_status USING status::text::order_status;
+ALTER TABLE orders ALTER COLUMN status SET DEFAULT 'pending';
+ALTER TABLE order_status_history ALTER COLUMN from_status TYPE order_status USING from_status::text::order_status;
+ALTER TABLE order_status_history ALTER COLUMN to_status TYPE order_status USING to_status::text::order_status;
+
+DROP TYPE order_status_old;
\ No newline at end of file
@@ -0,0 +1,2 @@
+-- Pickup orders wait in the store between being paid and being collected
+ALTER TYPE order_status ADD VALUE IF NOT EXISTS 'ready_for_pickup' AFTER 'paid';
\ No newline at end of file
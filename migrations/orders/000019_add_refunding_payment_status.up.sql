@@ -0,0 +1,3 @@
+-- A full refund is claimed before the provider is asked for it, so concurrent refunds of one
+-- payment cannot both pay out
+ALTER TYPE payment_status ADD VALUE IF NOT EXISTS 'refunding' AFTER 'completed';
\ No newline at end of file
@@ -0,0 +1,15 @@
+-- PostgreSQL cannot drop a value from an enum, so the type is rebuilt without it
+UPDATE payments SET status = 'completed' WHERE status = 'refund_failed';
+
+DROP INDEX IF EXISTS idx_payments_order_completed;
+
+ALTER TYPE payment_status RENAME TO payment_status_old;
+CREATE TYPE payment_status AS ENUM ('pending', 'completed', 'refunding', 'failed', 'refunded');
+
+ALTER TABLE payments ALTER COLUMN status DROP DEFAULT;
+ALTER TABLE payments ALTER COLUMN status TYPE payment_status USING status::text::payment_status;
+ALTER TABLE payments ALTER COLUMN status SET DEFAULT 'pending';
+
+DROP TYPE payment_status_old;
+
+CREATE UNIQUE INDEX idx_payments_order_completed ON payments(order_id) WHERE status = 'completed';
\ No newline at end of file
@@ -0,0 +1,2 @@
+-- A payment whose automatic refund the provider refused is flagged for someone to refund by hand
+ALTER TYPE payment_status ADD VALUE IF NOT EXISTS 'refund_failed' AFTER 'refunding';
\ No newline at end of file
@@ -0,0 +1,6 @@
+-- Refunds still in flight are kept as given, their amount is already on the payment
+UPDATE order_returns SET status = 'refunded' WHERE status = 'refunding';
+
+ALTER TABLE order_returns DROP CONSTRAINT IF EXISTS order_returns_status_check;
+ALTER TABLE order_returns ADD CONSTRAINT order_returns_status_check
+    CHECK (status IN ('requested', 'approved', 'rejected', 'received', 'refunded'));
\ No newline at end of file
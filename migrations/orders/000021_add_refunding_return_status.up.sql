@@ -0,0 +1,5 @@
+-- A return's refund is reserved on its payment before the provider is asked for it, and the return
+-- waits in refunding until the provider answers
+ALTER TABLE order_returns DROP CONSTRAINT IF EXISTS order_returns_status_check;
+ALTER TABLE order_returns ADD CONSTRAINT order_returns_status_check
+    CHECK (status IN ('requested', 'approved', 'rejected', 'received', 'refunding', 'refunded'));
\ No newline at end of file
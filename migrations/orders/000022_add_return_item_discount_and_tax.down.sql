@@ -0,0 +1,2 @@
+ALTER TABLE order_return_items DROP COLUMN IF EXISTS tax;
+ALTER TABLE order_return_items DROP COLUMN IF EXISTS discount;
\ No newline at end of file
@@ -0,0 +1,11 @@
+-- Returned items carry their share of the order's discount and tax, so returns are refunded what
+-- was actually paid for them
+ALTER TABLE order_return_items ADD COLUMN discount DECIMAL(10,2) NOT NULL DEFAULT 0 CHECK (discount >= 0);
+ALTER TABLE order_return_items ADD COLUMN tax DECIMAL(10,2) NOT NULL DEFAULT 0 CHECK (tax >= 0);
+
+UPDATE order_return_items ri
+SET discount = ROUND(o.discount * ri.price * ri.quantity / o.subtotal, 2),
+    tax = ROUND(o.tax * ri.price * ri.quantity / o.subtotal, 2)
+FROM order_returns r
+JOIN orders o ON o.id = r.order_id
+WHERE r.id = ri.return_id AND o.subtotal > 0;
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE orders DROP COLUMN IF EXISTS idempotency_fingerprint;
\ No newline at end of file
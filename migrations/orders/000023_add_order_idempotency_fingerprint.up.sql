@@ -0,0 +1,3 @@
+-- A fingerprint of the request that created the order, so the idempotency key cannot be
+-- reused for a different order
+ALTER TABLE orders ADD COLUMN idempotency_fingerprint VARCHAR(64);
\ No newline at end of file
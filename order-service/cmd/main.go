@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
@@ -8,7 +9,9 @@ import (
 
 	"order-service/config"
 	"order-service/internal/cache"
+	"order-service/internal/domain"
 	"order-service/internal/handler"
+	"order-service/internal/payment"
 	"order-service/internal/repository"
 	"order-service/internal/service"
 	"proto/order"
@@ -50,17 +53,90 @@ func main() {
 	defer redisCache.Close()
 
 	// Initialize NATS service
-	natsService, err := service.NewNatsService(cfg.NATS.URL)
+	natsService, err := service.NewNatsService(cfg.NATS.URL, service.ConsumerConfig{
+		Stream:     cfg.NATS.Stream,
+		Durable:    cfg.NATS.Durable,
+		MaxDeliver: cfg.NATS.MaxDeliver,
+		AckWait:    cfg.NATS.AckWait,
+		NakDelay:   cfg.NATS.NakDelay,
+	})
 	if err != nil {
 		log.Fatalf("Failed to initialize NATS service: %v", err)
 	}
 	defer natsService.Close()
 
+	// Initialize inventory client used to price orders and carts
+	inventoryService, err := service.NewInventoryService(cfg.Services.Inventory.GrpcURL)
+	if err != nil {
+		log.Fatalf("Failed to initialize inventory service: %v", err)
+	}
+	defer inventoryService.Close()
+
+	// Initialize address book client used to find the addresses orders ship to
+	addressBook, err := service.NewAddressBook(cfg.Services.User.GrpcURL)
+	if err != nil {
+		log.Fatalf("Failed to initialize address book: %v", err)
+	}
+	defer addressBook.Close()
+
+	// Initialize payment provider
+	var paymentProvider payment.Provider
+	switch cfg.Payment.Provider {
+	case "fake":
+		paymentProvider = payment.NewFakeProvider()
+	default:
+		log.Fatalf("Unknown payment provider: %s", cfg.Payment.Provider)
+	}
+	log.Printf("Using %s payment provider", paymentProvider.Name())
+
 	// Initialize repositories
 	orderRepo := repository.NewPostgresOrderRepository(db)
+	paymentRepo := repository.NewPostgresPaymentRepository(db)
+	outboxRepo := repository.NewPostgresOutboxRepository(db)
+	analyticsRepo := repository.NewPostgresAnalyticsRepository(db)
+	returnRepo := repository.NewPostgresReturnRepository(db)
+	couponRepo := repository.NewPostgresCouponRepository(db)
+	userCartRepo := repository.NewPostgresCartRepository(db)
+	guestCartRepo := repository.NewRedisCartRepository(redisCache, cfg.Carts.GuestTTL)
+	storeRepo := repository.NewPostgresStoreRepository(db)
 
 	// Initialize services with cache
-	orderService := service.NewOrderService(orderRepo, natsService, redisCache)
+	couponService := service.NewCouponService(couponRepo, cfg.Pricing.BaseCurrency)
+	pricing := service.NewPricingPipeline(
+		service.SubtotalStep(),
+		service.CouponStep(couponService),
+		service.TaxStep(service.TaxRates{
+			Default: cfg.Pricing.DefaultTaxRate,
+			Regions: cfg.Pricing.TaxRates,
+		}),
+		service.ShippingStep(service.ShippingRates{
+			Base:     domain.MoneyFromFloat(cfg.Pricing.ShippingBaseCost, cfg.Pricing.BaseCurrency),
+			PerKg:    domain.MoneyFromFloat(cfg.Pricing.ShippingCostPerKg, cfg.Pricing.BaseCurrency),
+			FreeOver: domain.MoneyFromFloat(cfg.Pricing.FreeShippingThreshold, cfg.Pricing.BaseCurrency),
+		}),
+	)
+	orderService := service.NewOrderService(orderRepo, inventoryService, addressBook, pricing, redisCache)
+	paymentService := service.NewPaymentService(paymentRepo, orderService, paymentProvider)
+	analyticsService := service.NewAnalyticsService(analyticsRepo, cfg.Pricing.BaseCurrency)
+	returnService := service.NewReturnService(returnRepo, paymentRepo, orderService, paymentProvider)
+	cartService := service.NewCartService(userCartRepo, guestCartRepo, inventoryService, cfg.Pricing.BaseCurrency)
+	pickupService := service.NewPickupService(storeRepo)
+
+	// Start relaying order events from the outbox to NATS
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	outboxRelay := service.NewOutboxRelay(outboxRepo, natsService, cfg.Outbox.PollInterval, cfg.Outbox.BatchSize, cfg.Outbox.MaxBackoff)
+	go outboxRelay.Run(ctx)
+
+	// Cancel orders that were never paid
+	staleOrderCanceller := service.NewStaleOrderCanceller(orderService, cfg.StaleOrders.MaxAge, cfg.StaleOrders.CheckInterval, cfg.StaleOrders.BatchSize)
+	go staleOrderCanceller.Run(ctx)
+
+	// Cancel and refund orders whose stock consumer-service could not reserve
+	if err := natsService.StartConsuming(ctx, handler.NewOrderEventHandler(orderService, paymentService)); err != nil {
+		log.Fatalf("Failed to start consuming: %v", err)
+	}
 
 	// Initialize gRPC server
 	lis, err := net.Listen("tcp", ":"+cfg.Server.GrpcPort)
@@ -71,9 +147,29 @@ func main() {
 	grpcServer := grpc.NewServer()
 
 	// Register order service handler
-	orderHandler := handler.NewOrderGrpcHandler(orderService)
+	orderHandler := handler.NewOrderGrpcHandler(orderService, paymentService)
 	order.RegisterOrderServiceServer(grpcServer, orderHandler)
 
+	// Register analytics service handler
+	analyticsHandler := handler.NewAnalyticsGrpcHandler(analyticsService)
+	order.RegisterAnalyticsServiceServer(grpcServer, analyticsHandler)
+
+	// Register return service handler
+	returnHandler := handler.NewReturnGrpcHandler(returnService)
+	order.RegisterReturnServiceServer(grpcServer, returnHandler)
+
+	// Register coupon service handler
+	couponHandler := handler.NewCouponGrpcHandler(couponService)
+	order.RegisterCouponServiceServer(grpcServer, couponHandler)
+
+	// Register cart service handler
+	cartHandler := handler.NewCartGrpcHandler(cartService)
+	order.RegisterCartServiceServer(grpcServer, cartHandler)
+
+	// Register pickup service handler
+	pickupHandler := handler.NewPickupGrpcHandler(pickupService)
+	order.RegisterPickupServiceServer(grpcServer, pickupHandler)
+
 	// Enable reflection for tools like grpcurl
 	reflection.Register(grpcServer)
 
@@ -4,6 +4,8 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -24,15 +26,51 @@ type Config struct {
 		Inventory struct {
 			GrpcURL string
 		}
+		User struct {
+			GrpcURL string
+		}
 	}
 	NATS struct {
-		URL string
+		URL        string
+		Stream     string
+		Durable    string
+		MaxDeliver int
+		AckWait    time.Duration
+		NakDelay   time.Duration
 	}
 	Redis struct {
 		Addr     string
 		Password string
 		DB       int
 	}
+	Payment struct {
+		Provider string
+	}
+	Outbox struct {
+		PollInterval time.Duration
+		BatchSize    int
+		MaxBackoff   time.Duration
+	}
+	StaleOrders struct {
+		MaxAge        time.Duration
+		CheckInterval time.Duration
+		BatchSize     int
+	}
+	Carts struct {
+		// GuestTTL is how long a guest cart is kept after its last change
+		GuestTTL time.Duration
+	}
+	Pricing struct {
+		// BaseCurrency is the currency catalogue prices, coupons, shipping rates and reports
+		// are in; it has to match the inventory service's
+		BaseCurrency string
+		// TaxRates maps regions such as "DE" or "US-CA" to a rate like 0.19
+		TaxRates              map[string]float64
+		DefaultTaxRate        float64
+		ShippingBaseCost      float64
+		ShippingCostPerKg     float64
+		FreeShippingThreshold float64
+	}
 }
 
 func LoadConfig() *Config {
@@ -52,8 +90,19 @@ func LoadConfig() *Config {
 	config.Server.GrpcPort = getEnv("ORDERS_GRPC_PORT", "50052")
 
 	config.Services.Inventory.GrpcURL = getEnv("INVENTORY_GRPC_URL", "localhost:50051")
+	config.Services.User.GrpcURL = getEnv("USER_GRPC_URL", "localhost:50053")
 
 	config.NATS.URL = getEnv("NATS_URL", "nats://localhost:4222")
+	config.NATS.Stream = getEnv("NATS_STREAM", "BICYCLE_ORDERS")
+	config.NATS.Durable = getEnv("NATS_DURABLE", "order-service")
+
+	maxDeliver, err := strconv.Atoi(getEnv("NATS_MAX_DELIVER", "10"))
+	if err != nil || maxDeliver <= 0 {
+		maxDeliver = 10
+	}
+	config.NATS.MaxDeliver = maxDeliver
+	config.NATS.AckWait = getDuration("NATS_ACK_WAIT", 30*time.Second)
+	config.NATS.NakDelay = getDuration("NATS_NAK_DELAY", 2*time.Second)
 
 	// Redis configuration
 	config.Redis.Addr = getEnv("REDIS_ADDR", "localhost:6379")
@@ -65,6 +114,41 @@ func LoadConfig() *Config {
 	}
 	config.Redis.DB = redisDB
 
+	// Payment configuration
+	config.Payment.Provider = getEnv("PAYMENT_PROVIDER", "fake")
+
+	// Outbox relay configuration
+	config.Outbox.PollInterval = getDuration("OUTBOX_POLL_INTERVAL", time.Second)
+
+	batchSize, err := strconv.Atoi(getEnv("OUTBOX_BATCH_SIZE", "100"))
+	if err != nil || batchSize <= 0 {
+		batchSize = 100
+	}
+	config.Outbox.BatchSize = batchSize
+
+	config.Outbox.MaxBackoff = getDuration("OUTBOX_MAX_BACKOFF", 5*time.Minute)
+
+	// Unpaid order cancellation, matching the inventory reservation TTL by default
+	config.StaleOrders.MaxAge = getDuration("PENDING_ORDER_MAX_AGE", 30*time.Minute)
+	config.StaleOrders.CheckInterval = getDuration("STALE_ORDER_CHECK_INTERVAL", time.Minute)
+
+	staleBatchSize, err := strconv.Atoi(getEnv("STALE_ORDER_BATCH_SIZE", "100"))
+	if err != nil || staleBatchSize <= 0 {
+		staleBatchSize = 100
+	}
+	config.StaleOrders.BatchSize = staleBatchSize
+
+	// Shopping carts
+	config.Carts.GuestTTL = getDuration("GUEST_CART_TTL", 7*24*time.Hour)
+
+	// Order pricing: TAX_RATES is a list like "DE=0.19,US-CA=0.0725"
+	config.Pricing.BaseCurrency = strings.ToUpper(getEnv("BASE_CURRENCY", "USD"))
+	config.Pricing.TaxRates = getRates("TAX_RATES")
+	config.Pricing.DefaultTaxRate = getFloat("DEFAULT_TAX_RATE", 0)
+	config.Pricing.ShippingBaseCost = getFloat("SHIPPING_BASE_COST", 15)
+	config.Pricing.ShippingCostPerKg = getFloat("SHIPPING_COST_PER_KG", 1)
+	config.Pricing.FreeShippingThreshold = getFloat("FREE_SHIPPING_THRESHOLD", 0)
+
 	return config
 }
 
@@ -75,3 +159,38 @@ func getEnv(key, defaultValue string) string {
 	}
 	return value
 }
+
+func getDuration(key string, defaultValue time.Duration) time.Duration {
+	value, err := time.ParseDuration(getEnv(key, defaultValue.String()))
+	if err != nil || value <= 0 {
+		return defaultValue
+	}
+	return value
+}
+
+func getFloat(key string, defaultValue float64) float64 {
+	value, err := strconv.ParseFloat(getEnv(key, ""), 64)
+	if err != nil || value < 0 {
+		return defaultValue
+	}
+	return value
+}
+
+// getRates parses a comma separated list of REGION=rate pairs, skipping malformed entries.
+func getRates(key string) map[string]float64 {
+	rates := make(map[string]float64)
+	for _, pair := range strings.Split(getEnv(key, ""), ",") {
+		region, value, found := strings.Cut(pair, "=")
+		if !found {
+			continue
+		}
+
+		rate, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || rate < 0 {
+			log.Printf("Ignoring invalid rate %q in %s", pair, key)
+			continue
+		}
+		rates[strings.ToUpper(strings.TrimSpace(region))] = rate
+	}
+	return rates
+}
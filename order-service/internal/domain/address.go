@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Address is the address an order ships to. Orders keep their own copy, so later changes to
+// the customer's address book leave placed orders as they were.
+type Address struct {
+	RecipientName string `json:"recipient_name"`
+	Line1         string `json:"line1"`
+	Line2         string `json:"line2,omitempty"`
+	City          string `json:"city"`
+	Region        string `json:"region,omitempty"`
+	PostalCode    string `json:"postal_code"`
+	Country       string `json:"country"`
+	Phone         string `json:"phone,omitempty"`
+}
+
+// Normalize trims the address's fields and upper-cases its country code.
+func (a Address) Normalize() Address {
+	for _, field := range []*string{&a.RecipientName, &a.Line1, &a.Line2, &a.City, &a.Region,
+		&a.PostalCode, &a.Country, &a.Phone} {
+		*field = strings.TrimSpace(*field)
+	}
+	a.Country = strings.ToUpper(a.Country)
+	return a
+}
+
+// Validate checks that the address has the fields needed to deliver to it.
+func (a Address) Validate() error {
+	switch {
+	case a.RecipientName == "":
+		return errors.New("recipient name is required")
+	case a.Line1 == "":
+		return errors.New("address line 1 is required")
+	case a.City == "":
+		return errors.New("city is required")
+	case a.PostalCode == "":
+		return errors.New("postal code is required")
+	case len(a.Country) != 2:
+		return errors.New("country must be a two-letter ISO code")
+	}
+	return nil
+}
+
+// Scan reads an address stored as JSON.
+func (a *Address) Scan(src interface{}) error {
+	var data []byte
+	switch v := src.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into Address", src)
+	}
+	return json.Unmarshal(data, a)
+}
+
+// Value stores the address as JSON.
+func (a Address) Value() (driver.Value, error) {
+	return json.Marshal(a)
+}
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"time"
+)
+
+type ReportPeriod string
+
+const (
+	ReportPeriodDay   ReportPeriod = "day"
+	ReportPeriodWeek  ReportPeriod = "week"
+	ReportPeriodMonth ReportPeriod = "month"
+)
+
+// SalesAttribute is an order item column sales can be broken down by.
+type SalesAttribute string
+
+const (
+	SalesAttributeBikeType  SalesAttribute = "bike_type"
+	SalesAttributeFrameSize SalesAttribute = "frame_size"
+	SalesAttributeColor     SalesAttribute = "color"
+)
+
+// SoldOrderStatuses are the statuses of orders that count as sales.
+var SoldOrderStatuses = []OrderStatus{OrderStatusPaid, OrderStatusReadyForPickup, OrderStatusShipped, OrderStatusDelivered}
+
+// ReportRange covers orders created from From (inclusive) to To (exclusive).
+type ReportRange struct {
+	From time.Time
+	To   time.Time
+}
+
+// RevenueBucket breaks down what customers paid in a period; Revenue includes tax and shipping.
+type RevenueBucket struct {
+	PeriodStart time.Time `json:"period_start"`
+	Revenue     Money     `json:"revenue"`
+	Orders      int       `json:"orders"`
+	Subtotal    Money     `json:"subtotal"`
+	Discount    Money     `json:"discount"`
+	Tax         Money     `json:"tax"`
+	Shipping    Money     `json:"shipping"`
+}
+
+type OrderStatusCount struct {
+	Status OrderStatus `json:"status"`
+	Orders int         `json:"orders"`
+	Total  Money       `json:"total"`
+}
+
+type ProductSales struct {
+	ProductID string `json:"product_id"`
+	Name      string `json:"name"`
+	Units     int    `json:"units"`
+	Revenue   Money  `json:"revenue"`
+	Orders    int    `json:"orders"`
+}
+
+type AttributeSales struct {
+	Value   string `json:"value"`
+	Units   int    `json:"units"`
+	Revenue Money  `json:"revenue"`
+	Orders  int    `json:"orders"`
+}
@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"time"
+)
+
+// MaxCartItemQuantity caps the quantity of a single product in a cart.
+const MaxCartItemQuantity = 99
+
+// Cart is the shopping cart of a signed-in user or of a guest's session. Only products and
+// quantities are stored; the catalogue prices them whenever the cart is shown.
+type Cart struct {
+	UserID    string     `json:"user_id,omitempty"`
+	SessionID string     `json:"session_id,omitempty"`
+	Items     []CartItem `json:"items"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	// Subtotal of the available items, set when the cart is priced
+	Subtotal Money `json:"-"`
+}
+
+type CartItem struct {
+	ProductID string    `json:"product_id"`
+	Quantity  int       `json:"quantity"`
+	AddedAt   time.Time `json:"added_at"`
+	// Set from the catalogue when the cart is priced
+	Name      string `json:"-"`
+	Price     Money  `json:"-"`
+	Available bool   `json:"-"`
+}
+
+// Quantity is the quantity of productID in the cart.
+func (c Cart) Quantity(productID string) int {
+	for _, item := range c.Items {
+		if item.ProductID == productID {
+			return item.Quantity
+		}
+	}
+	return 0
+}
+
+// SetQuantity sets the quantity of productID, adding the product if it is not in the cart
+// yet and removing it for a quantity of zero. Quantities are capped at MaxCartItemQuantity.
+func (c *Cart) SetQuantity(productID string, quantity int, now time.Time) {
+	if quantity > MaxCartItemQuantity {
+		quantity = MaxCartItemQuantity
+	}
+
+	for i, item := range c.Items {
+		if item.ProductID != productID {
+			continue
+		}
+		if quantity <= 0 {
+			c.Items = append(c.Items[:i], c.Items[i+1:]...)
+		} else {
+			c.Items[i].Quantity = quantity
+		}
+		c.UpdatedAt = now
+		return
+	}
+
+	if quantity > 0 {
+		c.Items = append(c.Items, CartItem{ProductID: productID, Quantity: quantity, AddedAt: now})
+		c.UpdatedAt = now
+	}
+}
+
+// Merge adds the items of other to the cart, adding up the quantities of products in both.
+func (c *Cart) Merge(other Cart, now time.Time) {
+	for _, item := range other.Items {
+		c.SetQuantity(item.ProductID, c.Quantity(item.ProductID)+item.Quantity, now)
+	}
+}
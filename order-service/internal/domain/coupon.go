@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"time"
+)
+
+type DiscountType string
+
+const (
+	DiscountTypePercentage DiscountType = "percentage"
+	DiscountTypeFixed      DiscountType = "fixed"
+)
+
+// Coupon is a promotion code taking a percentage or a fixed amount off the eligible items
+// of an order. Zero limits mean unlimited.
+type Coupon struct {
+	ID           string       `json:"id"`
+	Code         string       `json:"code"`
+	Description  string       `json:"description"`
+	DiscountType DiscountType `json:"discount_type"`
+	// DiscountPercent is set for percentage coupons and DiscountAmount for fixed ones
+	DiscountPercent float64 `json:"discount_percent"`
+	DiscountAmount  Money   `json:"discount_amount"`
+	// CategoryID and BikeType restrict the coupon to matching items when set
+	CategoryID     string     `json:"category_id"`
+	BikeType       string     `json:"bike_type"`
+	MinOrderValue  Money      `json:"min_order_value"`
+	StartsAt       *time.Time `json:"starts_at"`
+	EndsAt         *time.Time `json:"ends_at"`
+	MaxUses        int        `json:"max_uses"`
+	MaxUsesPerUser int        `json:"max_uses_per_user"`
+	TimesUsed      int        `json:"times_used"`
+	Active         bool       `json:"active"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// AppliesTo reports whether item is eligible for the coupon.
+func (c Coupon) AppliesTo(item OrderItem) bool {
+	if c.CategoryID != "" && item.CategoryID != c.CategoryID {
+		return false
+	}
+	if c.BikeType != "" && item.BikeType != c.BikeType {
+		return false
+	}
+	return true
+}
+
+// ValidAt reports whether the coupon can be used at t.
+func (c Coupon) ValidAt(t time.Time) bool {
+	if !c.Active {
+		return false
+	}
+	if c.StartsAt != nil && t.Before(*c.StartsAt) {
+		return false
+	}
+	if c.EndsAt != nil && !t.Before(*c.EndsAt) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,213 @@
+package domain_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"order-service/internal/domain"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    int64
+		wantErr bool
+	}{
+		{input: "19.99", want: 1999},
+		{input: "19.9", want: 1990},
+		{input: "19", want: 1900},
+		{input: "19.", want: 1900},
+		{input: ".5", want: 50},
+		{input: "0.01", want: 1},
+		{input: " 7.25 ", want: 725},
+		{input: "19.9900", want: 1999},
+		{input: "-0.50", want: -50},
+		{input: "-12.34", want: -1234},
+		{input: "19.999", wantErr: true},
+		{input: "19.991", wantErr: true},
+		{input: "abc", wantErr: true},
+		{input: "1.x", wantErr: true},
+		{input: "--1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := domain.ParseMoney(tt.input, "EUR")
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ParseMoney(%q): expected error, got %d", tt.input, got.Amount)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseMoney(%q): unexpected error: %v", tt.input, err)
+			continue
+		}
+		if got.Amount != tt.want || got.Currency != "EUR" {
+			t.Errorf("ParseMoney(%q) = %d %s, want %d EUR", tt.input, got.Amount, got.Currency, tt.want)
+		}
+	}
+}
+
+func TestMoneyFromFloatRoundsToNearestCent(t *testing.T) {
+	tests := []struct {
+		input float64
+		want  int64
+	}{
+		{input: 19.99, want: 1999},
+		{input: 0.1 + 0.2, want: 30},
+		{input: 1.005, want: 100},
+		{input: 2.675, want: 268},
+		{input: -4.995, want: -500},
+	}
+
+	for _, tt := range tests {
+		if got := domain.MoneyFromFloat(tt.input, "USD"); got.Amount != tt.want {
+			t.Errorf("MoneyFromFloat(%v) = %d, want %d", tt.input, got.Amount, tt.want)
+		}
+	}
+}
+
+func TestMoneyString(t *testing.T) {
+	tests := []struct {
+		amount int64
+		want   string
+	}{
+		{amount: 1999, want: "19.99"},
+		{amount: 5, want: "0.05"},
+		{amount: 0, want: "0.00"},
+		{amount: -50, want: "-0.50"},
+		{amount: -1234, want: "-12.34"},
+	}
+
+	for _, tt := range tests {
+		if got := domain.NewMoney(tt.amount, "USD").String(); got != tt.want {
+			t.Errorf("Money{%d}.String() = %q, want %q", tt.amount, got, tt.want)
+		}
+	}
+}
+
+func TestMoneyScan(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     interface{}
+		want    int64
+		wantErr bool
+	}{
+		{name: "decimal bytes", src: []byte("1299.50"), want: 129950},
+		{name: "decimal string", src: "0.07", want: 7},
+		{name: "trailing zeros", src: []byte("10.0000"), want: 1000},
+		{name: "negative", src: []byte("-3.10"), want: -310},
+		{name: "integer", src: int64(42), want: 4200},
+		{name: "float", src: 19.99, want: 1999},
+		{name: "null", src: nil, want: 0},
+		{name: "sub-cent decimal", src: []byte("1.234"), wantErr: true},
+		{name: "unsupported type", src: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		var m domain.Money
+		err := m.Scan(tt.src)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("%s: expected error, got %d", tt.name, m.Amount)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.name, err)
+			continue
+		}
+		if m.Amount != tt.want || m.Currency != domain.DefaultCurrency {
+			t.Errorf("%s: got %d %s, want %d %s", tt.name, m.Amount, m.Currency, tt.want, domain.DefaultCurrency)
+		}
+	}
+}
+
+func TestMoneyValueRoundTripsThroughScan(t *testing.T) {
+	for _, amount := range []int64{0, 1, 99, 1999, -250, 123456789} {
+		value, err := domain.NewMoney(amount, domain.DefaultCurrency).Value()
+		if err != nil {
+			t.Fatalf("Value(%d): unexpected error: %v", amount, err)
+		}
+
+		var m domain.Money
+		if err := m.Scan(value); err != nil {
+			t.Fatalf("Scan(%v): unexpected error: %v", value, err)
+		}
+		if m.Amount != amount {
+			t.Errorf("Round trip of %d gave %d", amount, m.Amount)
+		}
+	}
+}
+
+func TestMoneyUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		want     int64
+		currency string
+	}{
+		{name: "exact object", input: `{"amount":1999,"currency":"EUR"}`, want: 1999, currency: "EUR"},
+		{name: "legacy float", input: `19.99`, want: 1999, currency: domain.DefaultCurrency},
+		{name: "legacy float needing rounding", input: `0.30000000000000004`, want: 30, currency: domain.DefaultCurrency},
+		{name: "legacy integer", input: `5`, want: 500, currency: domain.DefaultCurrency},
+		{name: "null", input: `null`, want: 0, currency: ""},
+	}
+
+	for _, tt := range tests {
+		var m domain.Money
+		if err := json.Unmarshal([]byte(tt.input), &m); err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.name, err)
+			continue
+		}
+		if m.Amount != tt.want || m.Currency != tt.currency {
+			t.Errorf("%s: got %d %q, want %d %q", tt.name, m.Amount, m.Currency, tt.want, tt.currency)
+		}
+	}
+
+	var m domain.Money
+	if err := json.Unmarshal([]byte(`"19.99"`), &m); err == nil {
+		t.Errorf("Expected a quoted amount to be rejected, got %d", m.Amount)
+	}
+}
+
+func TestMoneyConvert(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount int64
+		rate   float64
+		want   int64
+	}{
+		{name: "identity", amount: 1999, rate: 1, want: 1999},
+		{name: "exact", amount: 10000, rate: 0.92, want: 9200},
+		{name: "rounds half away from zero", amount: 5, rate: 0.5, want: 3},
+		{name: "rounds down", amount: 1999, rate: 0.9234, want: 1846},
+		{name: "negative rounds away from zero", amount: -5, rate: 0.5, want: -3},
+		{name: "to a weaker currency", amount: 1999, rate: 157.31, want: 314463},
+	}
+
+	for _, tt := range tests {
+		got := domain.NewMoney(tt.amount, "USD").Convert(tt.rate, "EUR")
+		if got.Amount != tt.want || got.Currency != "EUR" {
+			t.Errorf("%s: got %d %s, want %d EUR", tt.name, got.Amount, got.Currency, tt.want)
+		}
+	}
+}
+
+func TestMoneyArithmeticTakesCurrencyFromNonZeroSide(t *testing.T) {
+	var zero domain.Money
+	price := domain.NewMoney(1250, "EUR")
+
+	if sum := zero.Add(price.Mul(3)); sum.Amount != 3750 || sum.Currency != "EUR" {
+		t.Errorf("Expected 3750 EUR, got %d %s", sum.Amount, sum.Currency)
+	}
+	if diff := price.Sub(domain.NewMoney(1500, "EUR")); diff.Amount != -250 || !diff.IsNegative() {
+		t.Errorf("Expected -250, got %d", diff.Amount)
+	}
+	if tax := price.MulRate(0.19); tax.Amount != 238 {
+		t.Errorf("Expected tax of 238, got %d", tax.Amount)
+	}
+	if min := price.Min(domain.NewMoney(999, "EUR")); min.Amount != 999 {
+		t.Errorf("Expected 999, got %d", min.Amount)
+	}
+}
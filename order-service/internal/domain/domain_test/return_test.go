@@ -0,0 +1,73 @@
+package domain_test
+
+import (
+	"testing"
+
+	"order-service/internal/domain"
+)
+
+func TestReturnValue(t *testing.T) {
+	bike := domain.OrderItem{ID: "item-1", ProductID: "bike", Quantity: 2, Price: domain.NewMoney(3000, "USD")}
+	helmet := domain.OrderItem{ID: "item-2", ProductID: "helmet", Quantity: 1, Price: domain.NewMoney(4000, "USD")}
+
+	// 100.00 of items, 10.00 off and 9% tax on the 90.00 left
+	discounted := domain.Order{
+		Items:    []domain.OrderItem{bike, helmet},
+		Subtotal: domain.NewMoney(10000, "USD"),
+		Discount: domain.NewMoney(1000, "USD"),
+		Tax:      domain.NewMoney(810, "USD"),
+		Shipping: domain.NewMoney(500, "USD"),
+		Currency: "USD",
+	}
+	undiscounted := domain.Order{
+		Items:    []domain.OrderItem{bike, helmet},
+		Subtotal: domain.NewMoney(10000, "USD"),
+		Currency: "USD",
+	}
+
+	tests := []struct {
+		name     string
+		order    domain.Order
+		returned map[domain.OrderItem]int
+		want     int64
+	}{
+		{
+			name:     "one bike of a discounted, taxed order",
+			order:    discounted,
+			returned: map[domain.OrderItem]int{bike: 1},
+			want:     3000 - 300 + 243,
+		},
+		{
+			name:     "helmet of a discounted, taxed order",
+			order:    discounted,
+			returned: map[domain.OrderItem]int{helmet: 1},
+			want:     4000 - 400 + 324,
+		},
+		{
+			name:     "everything of a discounted, taxed order is all but shipping",
+			order:    discounted,
+			returned: map[domain.OrderItem]int{bike: 2, helmet: 1},
+			want:     10000 - 1000 + 810,
+		},
+		{
+			name:     "order without discount or tax",
+			order:    undiscounted,
+			returned: map[domain.OrderItem]int{bike: 2},
+			want:     6000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ret domain.Return
+			for item, quantity := range tt.returned {
+				ret.Items = append(ret.Items, tt.order.NewReturnItem(item, quantity))
+			}
+
+			value := ret.Value()
+			if value.Amount != tt.want || value.Currency != "USD" {
+				t.Errorf("Value() = %d %s, want %d USD", value.Amount, value.Currency, tt.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package domain
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"time"
 )
 
@@ -12,31 +15,110 @@ const (
 	OrderStatusShipped   OrderStatus = "shipped"
 	OrderStatusDelivered OrderStatus = "delivered"
 	OrderStatusCancelled OrderStatus = "cancelled"
+	// OrderStatusReadyForPickup is a paid pickup order waiting in the store; it becomes
+	// delivered once the customer collects it
+	OrderStatusReadyForPickup OrderStatus = "ready_for_pickup"
 )
 
+// Order totals are itemised: Total is Subtotal - Discount + Tax + Shipping. TaxRegion is the
+// region whose tax rate was charged, e.g. "US-CA" or "DE". All amounts are in Currency, which
+// one unit of the base currency bought ExchangeRate of at checkout.
 type Order struct {
-	ID        string      `json:"id"`
-	UserID    string      `json:"user_id"`
-	Status    OrderStatus `json:"status"`
-	Total     float64     `json:"total"`
-	Items     []OrderItem `json:"items"`
-	CreatedAt time.Time   `json:"created_at"`
-	UpdatedAt time.Time   `json:"updated_at"`
+	ID           string      `json:"id"`
+	UserID       string      `json:"user_id"`
+	Status       OrderStatus `json:"status"`
+	Total        Money       `json:"total"`
+	Subtotal     Money       `json:"subtotal"`
+	CouponCode   string      `json:"coupon_code,omitempty"`
+	Discount     Money       `json:"discount"`
+	Tax          Money       `json:"tax"`
+	Shipping     Money       `json:"shipping"`
+	TaxRegion    string      `json:"tax_region,omitempty"`
+	Currency     string      `json:"currency"`
+	ExchangeRate float64     `json:"exchange_rate"`
+	// ShippingAddress is the copy of the address the order ships to, if it was given one
+	ShippingAddress *Address `json:"shipping_address,omitempty"`
+	// AddressID picks the shipping address from the user's address book when the order is
+	// placed; only the copy in ShippingAddress is stored
+	AddressID string `json:"-"`
+	// Pickup is set for orders collected in store instead of shipped
+	Pickup *Pickup `json:"pickup,omitempty"`
+	// IdempotencyKey is the client's key for the request that created the order, if any, and
+	// IdempotencyFingerprint the RequestFingerprint of that request
+	IdempotencyKey         string      `json:"idempotency_key,omitempty"`
+	IdempotencyFingerprint string      `json:"-"`
+	Items                  []OrderItem `json:"items"`
+	CancellationReason     string      `json:"cancellation_reason"`
+	Shipments              []Shipment  `json:"shipments,omitempty"`
+	CreatedAt              time.Time   `json:"created_at"`
+	UpdatedAt              time.Time   `json:"updated_at"`
 }
 
 type OrderItem struct {
-	ID        string  `json:"id"`
-	OrderID   string  `json:"order_id"`
-	ProductID string  `json:"product_id"`
-	Name      string  `json:"name"`
-	Price     float64 `json:"price"`
-	Quantity  int     `json:"quantity"`
-	FrameSize string  `json:"frame_size"`
-	WheelSize string  `json:"wheel_size"`
-	Color     string  `json:"color"`
-	BikeType  string  `json:"bike_type"`
+	ID        string `json:"id"`
+	OrderID   string `json:"order_id"`
+	ProductID string `json:"product_id"`
+	Name      string `json:"name"`
+	Price     Money  `json:"price"`
+	Quantity  int    `json:"quantity"`
+	FrameSize string `json:"frame_size"`
+	WheelSize string `json:"wheel_size"`
+	Color     string `json:"color"`
+	BikeType  string `json:"bike_type"`
+	// CategoryID is the product's category when the order was placed
+	CategoryID string `json:"category_id"`
+	// Weight is the weight of one unit in kg, used to work out shipping
+	Weight float64 `json:"weight"`
 }
 
+// SetCurrency puts the order's amounts, including item prices, in currency. Amounts are
+// stored without their currency, so repositories call this once an order is loaded.
+func (o *Order) SetCurrency(currency string) {
+	o.Currency = currency
+	for _, amount := range []*Money{&o.Total, &o.Subtotal, &o.Discount, &o.Tax, &o.Shipping} {
+		amount.Currency = currency
+	}
+	for i := range o.Items {
+		o.Items[i].Price.Currency = currency
+	}
+}
+
+// RequestFingerprint identifies what the customer asked for when placing the order, so a
+// retry with the same idempotency key can be told apart from a different order reusing it.
+func (o Order) RequestFingerprint() string {
+	type requestItem struct {
+		ProductID string `json:"product_id"`
+		Quantity  int    `json:"quantity"`
+		Price     Money  `json:"price"`
+	}
+	request := struct {
+		Items           []requestItem `json:"items"`
+		CouponCode      string        `json:"coupon_code"`
+		TaxRegion       string        `json:"tax_region"`
+		Currency        string        `json:"currency"`
+		AddressID       string        `json:"address_id"`
+		ShippingAddress *Address      `json:"shipping_address"`
+		Pickup          *Pickup       `json:"pickup"`
+	}{
+		CouponCode:      o.CouponCode,
+		TaxRegion:       o.TaxRegion,
+		Currency:        o.Currency,
+		AddressID:       o.AddressID,
+		ShippingAddress: o.ShippingAddress,
+		Pickup:          o.Pickup,
+	}
+	for _, item := range o.Items {
+		request.Items = append(request.Items, requestItem{ProductID: item.ProductID, Quantity: item.Quantity, Price: item.Price})
+	}
+
+	// Marshalling plain structs cannot fail
+	data, _ := json.Marshal(request)
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+// OrderFilter selects orders, newest first. After continues a listing from a cursor instead
+// of skipping Page-1 pages.
 type OrderFilter struct {
 	UserID   string
 	Status   OrderStatus
@@ -44,4 +126,20 @@ type OrderFilter struct {
 	ToDate   *time.Time
 	Page     int
 	PageSize int
+	After    *OrderCursor
+}
+
+// OrderCursor is the position of an order in a newest-first listing.
+type OrderCursor struct {
+	CreatedAt time.Time `json:"created_at"`
+	ID        string    `json:"id"`
+}
+
+// OrderPage is one page of a listing. Total counts the orders on all pages and is only worked
+// out when the listing does not continue from a cursor, 0 otherwise. Next is where the
+// following page starts, nil on the last page.
+type OrderPage struct {
+	Orders []Order
+	Total  int
+	Next   *OrderCursor
 }
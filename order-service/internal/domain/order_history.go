@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"time"
+)
+
+const (
+	ActorRoleUser   = "user"
+	ActorRoleAdmin  = "admin"
+	ActorRoleSystem = "system"
+)
+
+// Actor is whoever asked for an order to change: a user, an admin or the system itself.
+type Actor struct {
+	ID   string `json:"id"`
+	Role string `json:"role"`
+}
+
+var SystemActor = Actor{Role: ActorRoleSystem}
+
+type OrderStatusChange struct {
+	ID         string      `json:"id"`
+	OrderID    string      `json:"order_id"`
+	FromStatus OrderStatus `json:"from_status"`
+	ToStatus   OrderStatus `json:"to_status"`
+	ActorID    string      `json:"actor_id"`
+	ActorRole  string      `json:"actor_role"`
+	Reason     string      `json:"reason"`
+	CreatedAt  time.Time   `json:"created_at"`
+	// ExpectedTotal, when set, only lets the change through while the order's total is still
+	// this amount. It guards the change and is not recorded.
+	ExpectedTotal *Money `json:"-"`
+}
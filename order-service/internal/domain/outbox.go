@@ -0,0 +1,17 @@
+package domain
+
+import (
+	"time"
+)
+
+type OutboxMessage struct {
+	ID            string     `json:"id"`
+	AggregateID   string     `json:"aggregate_id"`
+	Subject       string     `json:"subject"`
+	Payload       []byte     `json:"payload"`
+	Attempts      int        `json:"attempts"`
+	LastError     string     `json:"last_error"`
+	NextAttemptAt time.Time  `json:"next_attempt_at"`
+	CreatedAt     time.Time  `json:"created_at"`
+	SentAt        *time.Time `json:"sent_at"`
+}
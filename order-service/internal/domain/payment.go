@@ -11,14 +11,23 @@ const (
 	PaymentStatusCompleted PaymentStatus = "completed"
 	PaymentStatusFailed    PaymentStatus = "failed"
 	PaymentStatusRefunded  PaymentStatus = "refunded"
+	// PaymentStatusRefunding is a completed payment whose full refund has been claimed and is
+	// being sent to the provider; it goes on to refunded, or back if the provider refuses
+	PaymentStatusRefunding PaymentStatus = "refunding"
+	// PaymentStatusRefundFailed is a payment of a cancelled order whose automatic refund the
+	// provider refused; it has to be refunded by hand
+	PaymentStatusRefundFailed PaymentStatus = "refund_failed"
 )
 
 type Payment struct {
-	ID        string        `json:"id"`
-	OrderID   string        `json:"order_id"`
-	Amount    float64       `json:"amount"`
-	Status    PaymentStatus `json:"status"`
-	Method    string        `json:"method"`
-	CreatedAt time.Time     `json:"created_at"`
-	UpdatedAt time.Time     `json:"updated_at"`
+	ID      string `json:"id"`
+	OrderID string `json:"order_id"`
+	Amount  Money  `json:"amount"`
+	// RefundedAmount is how much has been given back so far, in full or through returns
+	RefundedAmount Money         `json:"refunded_amount"`
+	Status         PaymentStatus `json:"status"`
+	Method         string        `json:"method"`
+	TransactionID  string        `json:"transaction_id"`
+	CreatedAt      time.Time     `json:"created_at"`
+	UpdatedAt      time.Time     `json:"updated_at"`
 }
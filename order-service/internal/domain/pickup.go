@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// PickupDateLayout is the layout of pickup dates, which are days in the store's local time.
+const PickupDateLayout = "2006-01-02"
+
+// Store is a shop where customers can collect their orders. DailyCapacity caps how many
+// orders can be picked up there on one day.
+type Store struct {
+	ID            string    `json:"id"`
+	Name          string    `json:"name"`
+	Address       string    `json:"address"`
+	DailyCapacity int       `json:"daily_capacity"`
+	Active        bool      `json:"active"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// Pickup is where and on which day an order is collected. Date is in PickupDateLayout.
+type Pickup struct {
+	StoreID string `json:"store_id"`
+	Date    string `json:"date"`
+}
+
+// Scan reads a pickup stored as JSON.
+func (p *Pickup) Scan(src interface{}) error {
+	var data []byte
+	switch v := src.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into Pickup", src)
+	}
+	return json.Unmarshal(data, p)
+}
+
+// PickupSlot is one day at a store and how many of its pickups are taken. Cancelled orders
+// give their slot back.
+type PickupSlot struct {
+	StoreID  string `json:"store_id"`
+	Date     string `json:"date"`
+	Capacity int    `json:"capacity"`
+	Booked   int    `json:"booked"`
+}
+
+// Remaining is how many more orders can be picked up in the slot.
+func (s PickupSlot) Remaining() int {
+	if s.Booked >= s.Capacity {
+		return 0
+	}
+	return s.Capacity - s.Booked
+}
+
+// StorePickups are the orders to be collected at a store on one day.
+type StorePickups struct {
+	Store  Store   `json:"store"`
+	Date   string  `json:"date"`
+	Orders []Order `json:"orders"`
+}
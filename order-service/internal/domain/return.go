@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"time"
+)
+
+type ReturnStatus string
+
+const (
+	ReturnStatusRequested ReturnStatus = "requested"
+	ReturnStatusApproved  ReturnStatus = "approved"
+	ReturnStatusRejected  ReturnStatus = "rejected"
+	ReturnStatusReceived  ReturnStatus = "received"
+	// ReturnStatusRefunding is a received return whose refund has been reserved on the payment
+	// and is being sent to the provider
+	ReturnStatusRefunding ReturnStatus = "refunding"
+	ReturnStatusRefunded  ReturnStatus = "refunded"
+)
+
+// Return is a customer's request to send back some of a delivered order's items.
+type Return struct {
+	ID             string       `json:"id"`
+	OrderID        string       `json:"order_id"`
+	UserID         string       `json:"user_id"`
+	Status         ReturnStatus `json:"status"`
+	Reason         string       `json:"reason"`
+	Items          []ReturnItem `json:"items"`
+	ResolutionNote string       `json:"resolution_note"`
+	Restocked      bool         `json:"restocked"`
+	RefundAmount   Money        `json:"refund_amount"`
+	PaymentID      string       `json:"payment_id"`
+	CreatedAt      time.Time    `json:"created_at"`
+	UpdatedAt      time.Time    `json:"updated_at"`
+}
+
+// ReturnItem is part of an order item being returned, priced as it was sold. Discount and Tax
+// are the returned units' share of the order's discount and tax.
+type ReturnItem struct {
+	OrderItemID string `json:"order_item_id"`
+	ProductID   string `json:"product_id"`
+	Quantity    int    `json:"quantity"`
+	Price       Money  `json:"price"`
+	Discount    Money  `json:"discount"`
+	Tax         Money  `json:"tax"`
+}
+
+// NewReturnItem prices quantity units of item as they were paid for, giving them a share of
+// the order's discount and tax in proportion to their part of its subtotal.
+func (o Order) NewReturnItem(item OrderItem, quantity int) ReturnItem {
+	returned := ReturnItem{
+		OrderItemID: item.ID,
+		ProductID:   item.ProductID,
+		Quantity:    quantity,
+		Price:       item.Price,
+		Discount:    NewMoney(0, item.Price.Currency),
+		Tax:         NewMoney(0, item.Price.Currency),
+	}
+
+	if o.Subtotal.Amount > 0 {
+		share := float64(item.Price.Mul(quantity).Amount) / float64(o.Subtotal.Amount)
+		returned.Discount = o.Discount.MulRate(share)
+		returned.Tax = o.Tax.MulRate(share)
+	}
+	return returned
+}
+
+// Value is what the returned items were paid for: their price less their share of the
+// discount, plus their share of the tax.
+func (r Return) Value() Money {
+	var value Money
+	for _, item := range r.Items {
+		value = value.Add(item.Price.Mul(item.Quantity)).Sub(item.Discount).Add(item.Tax)
+	}
+	return value
+}
+
+type ReturnFilter struct {
+	Status   ReturnStatus
+	Page     int
+	PageSize int
+}
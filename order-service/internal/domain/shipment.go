@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"time"
+)
+
+// Shipment is a parcel handed to a carrier with some or all of an order's items.
+type Shipment struct {
+	ID             string         `json:"id"`
+	OrderID        string         `json:"order_id"`
+	Carrier        string         `json:"carrier"`
+	TrackingNumber string         `json:"tracking_number"`
+	ShippedAt      time.Time      `json:"shipped_at"`
+	Items          []ShipmentItem `json:"items"`
+	CreatedAt      time.Time      `json:"created_at"`
+}
+
+type ShipmentItem struct {
+	OrderItemID string `json:"order_item_id"`
+	ProductID   string `json:"product_id"`
+	Quantity    int    `json:"quantity"`
+}
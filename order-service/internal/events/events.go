@@ -0,0 +1,203 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"order-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+const (
+	SubjectOrderCreated     = "bicycle.order.created"
+	SubjectOrderPaid        = "bicycle.order.paid"
+	SubjectOrderCancelled   = "bicycle.order.cancelled"
+	SubjectOrderStockFailed = "bicycle.order.stock_failed"
+	SubjectOrderReturned    = "bicycle.order.returned"
+	SubjectOrderUpdated     = "bicycle.order.updated"
+)
+
+type OrderCreatedEvent struct {
+	EventID string `json:"event_id"`
+	OrderID string `json:"order_id"`
+	UserID  string `json:"user_id"`
+	// Total is kept as a decimal for existing consumers; TotalMoney is exact
+	Total      float64          `json:"total"`
+	TotalMoney domain.Money     `json:"total_money"`
+	Status     string           `json:"status"`
+	Items      []OrderItemEvent `json:"items"`
+	// PickupDate is the day a pickup order is collected in store, empty for shipped orders
+	PickupDate string    `json:"pickup_date,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type OrderPaidEvent struct {
+	EventID string           `json:"event_id"`
+	OrderID string           `json:"order_id"`
+	UserID  string           `json:"user_id"`
+	Items   []OrderItemEvent `json:"items"`
+	PaidAt  time.Time        `json:"paid_at"`
+}
+
+// OrderUpdatedEvent announces that the items of a pending order were changed. Items is the
+// whole new list, so inventory can set the order's reservation to it whatever it held before.
+type OrderUpdatedEvent struct {
+	EventID    string           `json:"event_id"`
+	OrderID    string           `json:"order_id"`
+	UserID     string           `json:"user_id"`
+	Total      float64          `json:"total"`
+	TotalMoney domain.Money     `json:"total_money"`
+	Items      []OrderItemEvent `json:"items"`
+	UpdatedAt  time.Time        `json:"updated_at"`
+}
+
+type OrderCancelledEvent struct {
+	EventID        string           `json:"event_id"`
+	OrderID        string           `json:"order_id"`
+	UserID         string           `json:"user_id"`
+	PreviousStatus string           `json:"previous_status"`
+	Items          []OrderItemEvent `json:"items"`
+	CancelledAt    time.Time        `json:"cancelled_at"`
+}
+
+// OrderStockFailedEvent is published by consumer-service when an order's stock could not be
+// reserved. Any partial reservation has already been rolled back.
+type OrderStockFailedEvent struct {
+	EventID          string           `json:"event_id"`
+	OrderID          string           `json:"order_id"`
+	Reason           string           `json:"reason"`
+	UnavailableItems []OrderItemEvent `json:"unavailable_items"`
+	FailedAt         time.Time        `json:"failed_at"`
+}
+
+// OrderReturnedEvent asks inventory to put the items of a received return back on hand.
+type OrderReturnedEvent struct {
+	EventID    string           `json:"event_id"`
+	ReturnID   string           `json:"return_id"`
+	OrderID    string           `json:"order_id"`
+	UserID     string           `json:"user_id"`
+	Items      []OrderItemEvent `json:"items"`
+	ReceivedAt time.Time        `json:"received_at"`
+}
+
+type OrderItemEvent struct {
+	ProductID string `json:"product_id"`
+	Quantity  int    `json:"quantity"`
+	FrameSize string `json:"frame_size"`
+	WheelSize string `json:"wheel_size"`
+	Color     string `json:"color"`
+	BikeType  string `json:"bike_type"`
+}
+
+// OrderCreated builds the outbox message announcing a newly stored order.
+func OrderCreated(order domain.Order) (domain.OutboxMessage, error) {
+	var pickupDate string
+	if order.Pickup != nil {
+		pickupDate = order.Pickup.Date
+	}
+
+	eventID := uuid.New().String()
+	return newOutboxMessage(eventID, SubjectOrderCreated, order.ID, OrderCreatedEvent{
+		EventID:    eventID,
+		OrderID:    order.ID,
+		UserID:     order.UserID,
+		Total:      order.Total.Float64(),
+		TotalMoney: order.Total,
+		Status:     string(order.Status),
+		Items:      mapOrderItemEvents(order.Items),
+		PickupDate: pickupDate,
+		CreatedAt:  order.CreatedAt,
+	})
+}
+
+// OrderPaid builds the outbox message asking inventory to commit the order's reserved stock.
+func OrderPaid(order domain.Order) (domain.OutboxMessage, error) {
+	eventID := uuid.New().String()
+	return newOutboxMessage(eventID, SubjectOrderPaid, order.ID, OrderPaidEvent{
+		EventID: eventID,
+		OrderID: order.ID,
+		UserID:  order.UserID,
+		Items:   mapOrderItemEvents(order.Items),
+		PaidAt:  time.Now(),
+	})
+}
+
+// OrderUpdated builds the outbox message asking inventory to hold the order's new items.
+func OrderUpdated(order domain.Order) (domain.OutboxMessage, error) {
+	eventID := uuid.New().String()
+	return newOutboxMessage(eventID, SubjectOrderUpdated, order.ID, OrderUpdatedEvent{
+		EventID:    eventID,
+		OrderID:    order.ID,
+		UserID:     order.UserID,
+		Total:      order.Total.Float64(),
+		TotalMoney: order.Total,
+		Items:      mapOrderItemEvents(order.Items),
+		UpdatedAt:  order.UpdatedAt,
+	})
+}
+
+// OrderCancelled builds the outbox message asking inventory to take back the order's stock.
+func OrderCancelled(order domain.Order, previousStatus domain.OrderStatus) (domain.OutboxMessage, error) {
+	eventID := uuid.New().String()
+	return newOutboxMessage(eventID, SubjectOrderCancelled, order.ID, OrderCancelledEvent{
+		EventID:        eventID,
+		OrderID:        order.ID,
+		UserID:         order.UserID,
+		PreviousStatus: string(previousStatus),
+		Items:          mapOrderItemEvents(order.Items),
+		CancelledAt:    time.Now(),
+	})
+}
+
+// OrderReturned builds the outbox message asking inventory to restock a received return.
+func OrderReturned(ret domain.Return) (domain.OutboxMessage, error) {
+	items := make([]OrderItemEvent, len(ret.Items))
+	for i, item := range ret.Items {
+		items[i] = OrderItemEvent{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+		}
+	}
+
+	eventID := uuid.New().String()
+	return newOutboxMessage(eventID, SubjectOrderReturned, ret.OrderID, OrderReturnedEvent{
+		EventID:    eventID,
+		ReturnID:   ret.ID,
+		OrderID:    ret.OrderID,
+		UserID:     ret.UserID,
+		Items:      items,
+		ReceivedAt: time.Now(),
+	})
+}
+
+// newOutboxMessage stores the event under its own ID so consumers and the stream can deduplicate it.
+func newOutboxMessage(eventID, subject, aggregateID string, event interface{}) (domain.OutboxMessage, error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return domain.OutboxMessage{}, fmt.Errorf("failed to marshal %s event: %v", subject, err)
+	}
+
+	return domain.OutboxMessage{
+		ID:          eventID,
+		AggregateID: aggregateID,
+		Subject:     subject,
+		Payload:     payload,
+	}, nil
+}
+
+func mapOrderItemEvents(items []domain.OrderItem) []OrderItemEvent {
+	events := make([]OrderItemEvent, len(items))
+	for i, item := range items {
+		events[i] = OrderItemEvent{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+			FrameSize: item.FrameSize,
+			WheelSize: item.WheelSize,
+			Color:     item.Color,
+			BikeType:  item.BikeType,
+		}
+	}
+	return events
+}
@@ -0,0 +1,171 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	pb "proto/order"
+
+	"order-service/internal/domain"
+	"order-service/internal/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type AnalyticsGrpcHandler struct {
+	pb.UnimplementedAnalyticsServiceServer
+	analyticsService service.AnalyticsService
+}
+
+func NewAnalyticsGrpcHandler(analyticsService service.AnalyticsService) *AnalyticsGrpcHandler {
+	return &AnalyticsGrpcHandler{
+		analyticsService: analyticsService,
+	}
+}
+
+func (h *AnalyticsGrpcHandler) GetRevenueReport(ctx context.Context, req *pb.RevenueReportRequest) (*pb.RevenueReportResponse, error) {
+	log.Printf("Received GetRevenueReport request by %s", req.Period)
+
+	period := domain.ReportPeriod(req.Period)
+	if period == "" {
+		period = domain.ReportPeriodDay
+	}
+
+	buckets, err := h.analyticsService.GetRevenue(ctx, period, mapReportRangeFromProto(req.Range))
+	if err != nil {
+		log.Printf("Failed to get revenue report: %v", err)
+		return nil, reportError("revenue report", err)
+	}
+
+	response := &pb.RevenueReportResponse{Period: string(period)}
+	var revenue, subtotal, discount, tax, shipping domain.Money
+	for _, bucket := range buckets {
+		response.Buckets = append(response.Buckets, &pb.RevenueBucket{
+			PeriodStart:   timestamppb.New(bucket.PeriodStart),
+			Revenue:       bucket.Revenue.Float64(),
+			Orders:        int32(bucket.Orders),
+			Subtotal:      bucket.Subtotal.Float64(),
+			Discount:      bucket.Discount.Float64(),
+			Tax:           bucket.Tax.Float64(),
+			Shipping:      bucket.Shipping.Float64(),
+			RevenueMoney:  mapMoneyToProto(bucket.Revenue),
+			SubtotalMoney: mapMoneyToProto(bucket.Subtotal),
+			DiscountMoney: mapMoneyToProto(bucket.Discount),
+			TaxMoney:      mapMoneyToProto(bucket.Tax),
+			ShippingMoney: mapMoneyToProto(bucket.Shipping),
+		})
+		response.TotalOrders += int32(bucket.Orders)
+		revenue = revenue.Add(bucket.Revenue)
+		subtotal = subtotal.Add(bucket.Subtotal)
+		discount = discount.Add(bucket.Discount)
+		tax = tax.Add(bucket.Tax)
+		shipping = shipping.Add(bucket.Shipping)
+	}
+
+	response.TotalRevenue = revenue.Float64()
+	response.TotalSubtotal = subtotal.Float64()
+	response.TotalDiscount = discount.Float64()
+	response.TotalTax = tax.Float64()
+	response.TotalShipping = shipping.Float64()
+	response.TotalRevenueMoney = mapMoneyToProto(revenue)
+	response.TotalSubtotalMoney = mapMoneyToProto(subtotal)
+	response.TotalDiscountMoney = mapMoneyToProto(discount)
+	response.TotalTaxMoney = mapMoneyToProto(tax)
+	response.TotalShippingMoney = mapMoneyToProto(shipping)
+
+	return response, nil
+}
+
+func (h *AnalyticsGrpcHandler) GetOrderStatusReport(ctx context.Context, req *pb.ReportRange) (*pb.OrderStatusReportResponse, error) {
+	log.Printf("Received GetOrderStatusReport request")
+
+	counts, err := h.analyticsService.GetOrderStatusCounts(ctx, mapReportRangeFromProto(req))
+	if err != nil {
+		log.Printf("Failed to get order status report: %v", err)
+		return nil, reportError("order status report", err)
+	}
+
+	response := &pb.OrderStatusReportResponse{}
+	for _, count := range counts {
+		response.Statuses = append(response.Statuses, &pb.OrderStatusCount{
+			Status:     string(count.Status),
+			Orders:     int32(count.Orders),
+			Total:      count.Total.Float64(),
+			TotalMoney: mapMoneyToProto(count.Total),
+		})
+	}
+
+	return response, nil
+}
+
+func (h *AnalyticsGrpcHandler) GetTopProducts(ctx context.Context, req *pb.TopProductsRequest) (*pb.TopProductsResponse, error) {
+	log.Printf("Received GetTopProducts request for %d products", req.Limit)
+
+	products, err := h.analyticsService.GetTopProducts(ctx, mapReportRangeFromProto(req.Range), int(req.Limit))
+	if err != nil {
+		log.Printf("Failed to get top products: %v", err)
+		return nil, reportError("top products", err)
+	}
+
+	response := &pb.TopProductsResponse{}
+	for _, product := range products {
+		response.Products = append(response.Products, &pb.ProductSales{
+			ProductId:    product.ProductID,
+			Name:         product.Name,
+			Units:        int32(product.Units),
+			Revenue:      product.Revenue.Float64(),
+			RevenueMoney: mapMoneyToProto(product.Revenue),
+			Orders:       int32(product.Orders),
+		})
+	}
+
+	return response, nil
+}
+
+func (h *AnalyticsGrpcHandler) GetSalesByAttribute(ctx context.Context, req *pb.SalesByAttributeRequest) (*pb.SalesByAttributeResponse, error) {
+	log.Printf("Received GetSalesByAttribute request by %s", req.Attribute)
+
+	sales, err := h.analyticsService.GetSalesByAttribute(ctx, domain.SalesAttribute(req.Attribute), mapReportRangeFromProto(req.Range))
+	if err != nil {
+		log.Printf("Failed to get sales by attribute: %v", err)
+		return nil, reportError("sales by attribute", err)
+	}
+
+	response := &pb.SalesByAttributeResponse{Attribute: req.Attribute}
+	for _, sale := range sales {
+		response.Values = append(response.Values, &pb.AttributeSales{
+			Value:        sale.Value,
+			Units:        int32(sale.Units),
+			Revenue:      sale.Revenue.Float64(),
+			RevenueMoney: mapMoneyToProto(sale.Revenue),
+			Orders:       int32(sale.Orders),
+		})
+	}
+
+	return response, nil
+}
+
+func reportError(report string, err error) error {
+	if errors.Is(err, service.ErrInvalidReport) {
+		return status.Errorf(codes.InvalidArgument, "failed to get %s: %v", report, err)
+	}
+	return status.Errorf(codes.Internal, "failed to get %s: %v", report, err)
+}
+
+func mapReportRangeFromProto(r *pb.ReportRange) domain.ReportRange {
+	var reportRange domain.ReportRange
+	if r == nil {
+		return reportRange
+	}
+
+	if r.From != nil {
+		reportRange.From = r.From.AsTime()
+	}
+	if r.To != nil {
+		reportRange.To = r.To.AsTime()
+	}
+	return reportRange
+}
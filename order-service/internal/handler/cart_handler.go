@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	pb "proto/order"
+
+	"order-service/internal/domain"
+	"order-service/internal/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type CartGrpcHandler struct {
+	pb.UnimplementedCartServiceServer
+	cartService service.CartService
+}
+
+func NewCartGrpcHandler(cartService service.CartService) *CartGrpcHandler {
+	return &CartGrpcHandler{
+		cartService: cartService,
+	}
+}
+
+func (h *CartGrpcHandler) GetCart(ctx context.Context, req *pb.CartRequest) (*pb.CartResponse, error) {
+	log.Printf("Received GetCart request")
+
+	cart, err := h.cartService.GetCart(ctx, mapCartOwnerFromProto(req.Owner))
+	return h.respond(ctx, "get cart", cart, req.Currency, err)
+}
+
+func (h *CartGrpcHandler) AddCartItem(ctx context.Context, req *pb.CartItemRequest) (*pb.CartResponse, error) {
+	log.Printf("Received AddCartItem request for product: %s", req.ProductId)
+
+	cart, err := h.cartService.AddItem(ctx, mapCartOwnerFromProto(req.Owner), req.ProductId, int(req.Quantity))
+	return h.respond(ctx, "add cart item", cart, req.Currency, err)
+}
+
+func (h *CartGrpcHandler) UpdateCartItem(ctx context.Context, req *pb.CartItemRequest) (*pb.CartResponse, error) {
+	log.Printf("Received UpdateCartItem request for product: %s", req.ProductId)
+
+	cart, err := h.cartService.UpdateItem(ctx, mapCartOwnerFromProto(req.Owner), req.ProductId, int(req.Quantity))
+	return h.respond(ctx, "update cart item", cart, req.Currency, err)
+}
+
+func (h *CartGrpcHandler) RemoveCartItem(ctx context.Context, req *pb.CartItemRequest) (*pb.CartResponse, error) {
+	log.Printf("Received RemoveCartItem request for product: %s", req.ProductId)
+
+	cart, err := h.cartService.RemoveItem(ctx, mapCartOwnerFromProto(req.Owner), req.ProductId)
+	return h.respond(ctx, "remove cart item", cart, req.Currency, err)
+}
+
+func (h *CartGrpcHandler) ClearCart(ctx context.Context, req *pb.CartRequest) (*pb.CartResponse, error) {
+	log.Printf("Received ClearCart request")
+
+	cart, err := h.cartService.ClearCart(ctx, mapCartOwnerFromProto(req.Owner))
+	return h.respond(ctx, "clear cart", cart, req.Currency, err)
+}
+
+func (h *CartGrpcHandler) MergeCarts(ctx context.Context, req *pb.MergeCartsRequest) (*pb.CartResponse, error) {
+	log.Printf("Received MergeCarts request for user: %s", req.UserId)
+
+	cart, err := h.cartService.MergeCarts(ctx, req.SessionId, req.UserId)
+	return h.respond(ctx, "merge carts", cart, req.Currency, err)
+}
+
+// respond prices the cart an operation returned, or maps the error it failed with.
+func (h *CartGrpcHandler) respond(ctx context.Context, action string, cart domain.Cart, currency string, err error) (*pb.CartResponse, error) {
+	if err == nil {
+		cart, err = h.cartService.PriceCart(ctx, cart, currency)
+	}
+	if err != nil {
+		log.Printf("Failed to %s: %v", action, err)
+		return nil, cartError(action, err)
+	}
+
+	return mapCartToProto(cart), nil
+}
+
+func cartError(action string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidCartRequest), errors.Is(err, service.ErrUnsupportedCurrency):
+		return status.Errorf(codes.InvalidArgument, "failed to %s: %v", action, err)
+	case errors.Is(err, service.ErrProductNotFound), errors.Is(err, service.ErrCartItemNotFound):
+		return status.Errorf(codes.NotFound, "failed to %s: %v", action, err)
+	}
+	return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+}
+
+func mapCartOwnerFromProto(owner *pb.CartOwner) service.CartOwner {
+	return service.CartOwner{
+		UserID:    owner.GetUserId(),
+		SessionID: owner.GetSessionId(),
+	}
+}
+
+func mapCartToProto(cart domain.Cart) *pb.CartResponse {
+	response := &pb.CartResponse{
+		UserId:    cart.UserID,
+		SessionId: cart.SessionID,
+		Subtotal:  mapMoneyToProto(cart.Subtotal),
+	}
+
+	if !cart.UpdatedAt.IsZero() {
+		response.UpdatedAt = timestamppb.New(cart.UpdatedAt)
+	}
+
+	for _, item := range cart.Items {
+		cartItem := &pb.CartItem{
+			ProductId: item.ProductID,
+			Name:      item.Name,
+			Quantity:  int32(item.Quantity),
+			Available: item.Available,
+			AddedAt:   timestamppb.New(item.AddedAt),
+		}
+		if item.Price.Currency != "" {
+			cartItem.Price = mapMoneyToProto(item.Price)
+			cartItem.LineTotal = mapMoneyToProto(item.Price.Mul(item.Quantity))
+		}
+		response.Items = append(response.Items, cartItem)
+	}
+
+	return response
+}
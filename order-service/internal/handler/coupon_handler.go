@@ -0,0 +1,175 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	pb "proto/order"
+
+	"order-service/internal/domain"
+	"order-service/internal/repository"
+	"order-service/internal/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type CouponGrpcHandler struct {
+	pb.UnimplementedCouponServiceServer
+	couponService service.CouponService
+}
+
+func NewCouponGrpcHandler(couponService service.CouponService) *CouponGrpcHandler {
+	return &CouponGrpcHandler{
+		couponService: couponService,
+	}
+}
+
+func (h *CouponGrpcHandler) CreateCoupon(ctx context.Context, req *pb.CouponRequest) (*pb.CouponResponse, error) {
+	log.Printf("Received CreateCoupon request for code: %s", req.Code)
+
+	coupon, err := h.couponService.CreateCoupon(ctx, mapCouponFromProto(req))
+	if err != nil {
+		log.Printf("Failed to create coupon: %v", err)
+		return nil, couponError("create coupon", err)
+	}
+
+	return mapCouponToProto(coupon), nil
+}
+
+func (h *CouponGrpcHandler) GetCoupon(ctx context.Context, req *pb.CouponIDRequest) (*pb.CouponResponse, error) {
+	log.Printf("Received GetCoupon request for ID: %s", req.Id)
+
+	coupon, err := h.couponService.GetCoupon(ctx, req.Id)
+	if err != nil {
+		log.Printf("Failed to get coupon: %v", err)
+		return nil, couponError("get coupon", err)
+	}
+
+	return mapCouponToProto(coupon), nil
+}
+
+func (h *CouponGrpcHandler) UpdateCoupon(ctx context.Context, req *pb.CouponRequest) (*pb.CouponResponse, error) {
+	log.Printf("Received UpdateCoupon request for ID: %s", req.Id)
+
+	coupon, err := h.couponService.UpdateCoupon(ctx, mapCouponFromProto(req))
+	if err != nil {
+		log.Printf("Failed to update coupon: %v", err)
+		return nil, couponError("update coupon", err)
+	}
+
+	return mapCouponToProto(coupon), nil
+}
+
+func (h *CouponGrpcHandler) DeleteCoupon(ctx context.Context, req *pb.CouponIDRequest) (*pb.DeleteCouponResponse, error) {
+	log.Printf("Received DeleteCoupon request for ID: %s", req.Id)
+
+	if err := h.couponService.DeleteCoupon(ctx, req.Id); err != nil {
+		log.Printf("Failed to delete coupon: %v", err)
+		return nil, couponError("delete coupon", err)
+	}
+
+	return &pb.DeleteCouponResponse{
+		Success: true,
+		Message: "Coupon deleted successfully",
+	}, nil
+}
+
+func (h *CouponGrpcHandler) ListCoupons(ctx context.Context, req *pb.ListCouponsRequest) (*pb.ListCouponsResponse, error) {
+	log.Printf("Received ListCoupons request")
+
+	coupons, err := h.couponService.ListCoupons(ctx)
+	if err != nil {
+		log.Printf("Failed to list coupons: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to list coupons: %v", err)
+	}
+
+	response := &pb.ListCouponsResponse{}
+	for _, coupon := range coupons {
+		response.Coupons = append(response.Coupons, mapCouponToProto(coupon))
+	}
+
+	return response, nil
+}
+
+func couponError(action string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidCoupon):
+		return status.Errorf(codes.InvalidArgument, "failed to %s: %v", action, err)
+	case errors.Is(err, repository.ErrCouponNotFound):
+		return status.Errorf(codes.NotFound, "failed to %s: %v", action, err)
+	case errors.Is(err, repository.ErrCouponCodeTaken):
+		return status.Errorf(codes.AlreadyExists, "failed to %s: %v", action, err)
+	}
+	return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+}
+
+func mapCouponFromProto(req *pb.CouponRequest) domain.Coupon {
+	coupon := domain.Coupon{
+		ID:             req.Id,
+		Code:           req.Code,
+		Description:    req.Description,
+		DiscountType:   domain.DiscountType(req.DiscountType),
+		CategoryID:     req.CategoryId,
+		BikeType:       req.BikeType,
+		MinOrderValue:  mapMoneyFromProto(req.MinOrderValueMoney, req.MinOrderValue),
+		MaxUses:        int(req.MaxUses),
+		MaxUsesPerUser: int(req.MaxUsesPerUser),
+		Active:         req.Active,
+	}
+
+	if coupon.DiscountType == domain.DiscountTypeFixed {
+		coupon.DiscountAmount = mapMoneyFromProto(req.DiscountAmount, req.DiscountValue)
+	} else {
+		coupon.DiscountPercent = req.DiscountValue
+	}
+
+	if req.StartsAt != nil {
+		startsAt := req.StartsAt.AsTime()
+		coupon.StartsAt = &startsAt
+	}
+	if req.EndsAt != nil {
+		endsAt := req.EndsAt.AsTime()
+		coupon.EndsAt = &endsAt
+	}
+
+	return coupon
+}
+
+// Helper function to map domain.Coupon to pb.CouponResponse
+func mapCouponToProto(coupon domain.Coupon) *pb.CouponResponse {
+	response := &pb.CouponResponse{
+		Id:                 coupon.ID,
+		Code:               coupon.Code,
+		Description:        coupon.Description,
+		DiscountType:       string(coupon.DiscountType),
+		DiscountValue:      coupon.DiscountPercent,
+		CategoryId:         coupon.CategoryID,
+		BikeType:           coupon.BikeType,
+		MinOrderValue:      coupon.MinOrderValue.Float64(),
+		MinOrderValueMoney: mapMoneyToProto(coupon.MinOrderValue),
+		MaxUses:            int32(coupon.MaxUses),
+		MaxUsesPerUser:     int32(coupon.MaxUsesPerUser),
+		TimesUsed:          int32(coupon.TimesUsed),
+		Active:             coupon.Active,
+		CreatedAt:          timestamppb.New(coupon.CreatedAt),
+		UpdatedAt:          timestamppb.New(coupon.UpdatedAt),
+	}
+
+	// Fixed coupons also report their amount as discount_value for older clients
+	if coupon.DiscountType == domain.DiscountTypeFixed {
+		response.DiscountValue = coupon.DiscountAmount.Float64()
+		response.DiscountAmount = mapMoneyToProto(coupon.DiscountAmount)
+	}
+
+	if coupon.StartsAt != nil {
+		response.StartsAt = timestamppb.New(*coupon.StartsAt)
+	}
+	if coupon.EndsAt != nil {
+		response.EndsAt = timestamppb.New(*coupon.EndsAt)
+	}
+
+	return response
+}
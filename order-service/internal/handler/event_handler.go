@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"context"
+	"log"
+
+	"order-service/internal/events"
+	"order-service/internal/service"
+)
+
+type orderEventHandler struct {
+	orderService   service.OrderService
+	paymentService service.PaymentService
+}
+
+func NewOrderEventHandler(orderService service.OrderService, paymentService service.PaymentService) service.OrderEventHandler {
+	return &orderEventHandler{
+		orderService:   orderService,
+		paymentService: paymentService,
+	}
+}
+
+func (h *orderEventHandler) HandleOrderStockFailed(ctx context.Context, event events.OrderStockFailedEvent) error {
+	log.Printf("[ORDER-HANDLER] Cancelling order %s, stock could not be reserved: %s", event.OrderID, event.Reason)
+
+	reason := event.Reason
+	if reason == "" {
+		reason = "stock could not be reserved"
+	}
+
+	if err := h.orderService.CancelUnfulfillableOrder(ctx, event.OrderID, reason); err != nil {
+		log.Printf("[ORDER-HANDLER] Failed to cancel order %s: %v", event.OrderID, err)
+		return err
+	}
+
+	// An order paid before its stock failed gets its money back. This also runs for redelivered
+	// events, so a refund cut short last time is picked up again.
+	if err := h.paymentService.RefundCancelledOrder(ctx, event.OrderID); err != nil {
+		log.Printf("[ORDER-HANDLER] Failed to refund cancelled order %s: %v", event.OrderID, err)
+		return err
+	}
+
+	log.Printf("[ORDER-HANDLER] Cancelled order %s", event.OrderID)
+	return nil
+}
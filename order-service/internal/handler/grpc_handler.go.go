@@ -2,11 +2,17 @@ package handler
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"log"
+	"strings"
 
+	moneypb "proto/money"
 	pb "proto/order"
 
 	"order-service/internal/domain"
+	"order-service/internal/repository"
 	"order-service/internal/service"
 
 	"google.golang.org/grpc/codes"
@@ -16,12 +22,14 @@ import (
 
 type OrderGrpcHandler struct {
 	pb.UnimplementedOrderServiceServer
-	orderService service.OrderService
+	orderService   service.OrderService
+	paymentService service.PaymentService
 }
 
-func NewOrderGrpcHandler(orderService service.OrderService) *OrderGrpcHandler {
+func NewOrderGrpcHandler(orderService service.OrderService, paymentService service.PaymentService) *OrderGrpcHandler {
 	return &OrderGrpcHandler{
-		orderService: orderService,
+		orderService:   orderService,
+		paymentService: paymentService,
 	}
 }
 
@@ -33,7 +41,7 @@ func (h *OrderGrpcHandler) CreateOrder(ctx context.Context, req *pb.CreateOrderR
 		orderItems = append(orderItems, domain.OrderItem{
 			ProductID: item.ProductId,
 			Name:      item.Name,
-			Price:     item.Price,
+			Price:     mapMoneyFromProto(item.PriceMoney, item.Price),
 			Quantity:  int(item.Quantity),
 			FrameSize: item.FrameSize,
 			WheelSize: item.WheelSize,
@@ -43,14 +51,36 @@ func (h *OrderGrpcHandler) CreateOrder(ctx context.Context, req *pb.CreateOrderR
 	}
 
 	order := domain.Order{
-		UserID: req.UserId,
-		Items:  orderItems,
-		Status: domain.OrderStatusPending,
+		UserID:         req.UserId,
+		Items:          orderItems,
+		Status:         domain.OrderStatusPending,
+		CouponCode:     req.CouponCode,
+		TaxRegion:      req.TaxRegion,
+		Currency:       req.Currency,
+		IdempotencyKey: req.IdempotencyKey,
+		AddressID:      req.AddressId,
+		Pickup:         mapPickupFromProto(req.Pickup),
+	}
+	if req.ShippingAddress != nil {
+		address := mapAddressFromProto(req.ShippingAddress)
+		order.ShippingAddress = &address
 	}
 
 	createdOrder, err := h.orderService.CreateOrder(ctx, order)
 	if err != nil {
 		log.Printf("Failed to create order: %v", err)
+		if errors.Is(err, service.ErrProductNotFound) || errors.Is(err, service.ErrPriceMismatch) ||
+			errors.Is(err, service.ErrCouponNotApplicable) || errors.Is(err, service.ErrUnsupportedCurrency) ||
+			errors.Is(err, service.ErrInvalidIdempotencyKey) || errors.Is(err, service.ErrInvalidAddress) ||
+			errors.Is(err, service.ErrAddressNotFound) || errors.Is(err, service.ErrInvalidPickup) {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to create order: %v", err)
+		}
+		if errors.Is(err, service.ErrInsufficientStock) || errors.Is(err, repository.ErrPickupSlotFull) {
+			return nil, status.Errorf(codes.FailedPrecondition, "failed to create order: %v", err)
+		}
+		if errors.Is(err, service.ErrIdempotencyKeyReused) {
+			return nil, status.Errorf(codes.AlreadyExists, "failed to create order: %v", err)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to create order: %v", err)
 	}
 
@@ -78,6 +108,8 @@ func (h *OrderGrpcHandler) UpdateOrderStatus(ctx context.Context, req *pb.Update
 		orderStatus = domain.OrderStatusPending
 	case pb.OrderStatus_PAID:
 		orderStatus = domain.OrderStatusPaid
+	case pb.OrderStatus_READY_FOR_PICKUP:
+		orderStatus = domain.OrderStatusReadyForPickup
 	case pb.OrderStatus_SHIPPED:
 		orderStatus = domain.OrderStatusShipped
 	case pb.OrderStatus_DELIVERED:
@@ -88,8 +120,11 @@ func (h *OrderGrpcHandler) UpdateOrderStatus(ctx context.Context, req *pb.Update
 		return nil, status.Errorf(codes.InvalidArgument, "invalid order status")
 	}
 
-	if err := h.orderService.UpdateOrderStatus(ctx, req.Id, orderStatus); err != nil {
+	if err := h.orderService.UpdateOrderStatus(ctx, req.Id, orderStatus, mapActorFromProto(req.Actor), req.Reason); err != nil {
 		log.Printf("Failed to update order status: %v", err)
+		if errors.Is(err, service.ErrOrderPaid) || errors.Is(err, repository.ErrOrderChanged) {
+			return nil, status.Errorf(codes.FailedPrecondition, "failed to update order status: %v", err)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to update order status: %v", err)
 	}
 
@@ -103,76 +138,293 @@ func (h *OrderGrpcHandler) UpdateOrderStatus(ctx context.Context, req *pb.Update
 	return mapOrderToProto(updatedOrder), nil
 }
 
+func (h *OrderGrpcHandler) UpdateOrderItems(ctx context.Context, req *pb.UpdateOrderItemsRequest) (*pb.OrderResponse, error) {
+	log.Printf("Received UpdateOrderItems request for ID: %s", req.Id)
+
+	var orderItems []domain.OrderItem
+	for _, item := range req.Items {
+		orderItems = append(orderItems, domain.OrderItem{
+			ProductID: item.ProductId,
+			Price:     mapMoneyFromProto(item.PriceMoney, item.Price),
+			Quantity:  int(item.Quantity),
+		})
+	}
+
+	if err := h.orderService.UpdateOrderItems(ctx, req.Id, orderItems, mapActorFromProto(req.Actor)); err != nil {
+		log.Printf("Failed to update order items: %v", err)
+		switch {
+		case errors.Is(err, repository.ErrOrderNotFound):
+			return nil, status.Errorf(codes.NotFound, "failed to update order items: %v", err)
+		case errors.Is(err, service.ErrInvalidOrderItems), errors.Is(err, service.ErrProductNotFound),
+			errors.Is(err, service.ErrPriceMismatch), errors.Is(err, service.ErrCouponNotApplicable):
+			return nil, status.Errorf(codes.InvalidArgument, "failed to update order items: %v", err)
+		case errors.Is(err, repository.ErrOrderNotPending), errors.Is(err, service.ErrInsufficientStock):
+			return nil, status.Errorf(codes.FailedPrecondition, "failed to update order items: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update order items: %v", err)
+	}
+
+	updatedOrder, err := h.orderService.GetOrderByID(ctx, req.Id)
+	if err != nil {
+		log.Printf("Failed to get updated order: %v", err)
+		return nil, status.Errorf(codes.NotFound, "failed to get updated order: %v", err)
+	}
+
+	return mapOrderToProto(updatedOrder), nil
+}
+
 func (h *OrderGrpcHandler) ListOrders(ctx context.Context, req *pb.ListOrdersRequest) (*pb.ListOrdersResponse, error) {
 	log.Printf("Received ListOrders request")
 
-	filter := domain.OrderFilter{
-		UserID:   req.Filter.UserId,
-		Page:     int(req.Filter.Page),
-		PageSize: int(req.Filter.PageSize),
+	filter, err := mapOrderFilterFromProto(req.Filter)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
-	switch req.Filter.Status {
-	case pb.OrderStatus_PENDING:
-		filter.Status = domain.OrderStatusPending
-	case pb.OrderStatus_PAID:
-		filter.Status = domain.OrderStatusPaid
-	case pb.OrderStatus_SHIPPED:
-		filter.Status = domain.OrderStatusShipped
-	case pb.OrderStatus_DELIVERED:
-		filter.Status = domain.OrderStatusDelivered
-	case pb.OrderStatus_CANCELLED:
-		filter.Status = domain.OrderStatusCancelled
+	page, err := h.orderService.ListOrders(ctx, filter)
+	if err != nil {
+		log.Printf("Failed to list orders: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to list orders: %v", err)
+	}
+
+	return mapOrderPageToProto(page, filter), nil
+}
+
+func (h *OrderGrpcHandler) GetUserOrders(ctx context.Context, req *pb.UserOrdersRequest) (*pb.ListOrdersResponse, error) {
+	log.Printf("Received GetUserOrders request for user: %s", req.UserId)
+
+	filter, err := mapOrderFilterFromProto(req.Filter)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	page, err := h.orderService.GetUserOrders(ctx, req.UserId, filter)
+	if err != nil {
+		log.Printf("Failed to get user orders: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get user orders: %v", err)
+	}
+
+	return mapOrderPageToProto(page, filter), nil
+}
+
+func (h *OrderGrpcHandler) CreatePayment(ctx context.Context, req *pb.CreatePaymentRequest) (*pb.PaymentResponse, error) {
+	log.Printf("Received CreatePayment request for order: %s", req.OrderId)
+
+	payment, err := h.paymentService.CreatePayment(ctx, req.OrderId, req.Method, mapActorFromProto(req.Actor))
+	if err != nil {
+		log.Printf("Failed to create payment: %v", err)
+		if payment.ID != "" {
+			return nil, status.Errorf(codes.FailedPrecondition, "payment %s failed: %v", payment.ID, err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create payment: %v", err)
+	}
+
+	return mapPaymentToProto(payment), nil
+}
+
+func (h *OrderGrpcHandler) GetPayment(ctx context.Context, req *pb.PaymentIDRequest) (*pb.PaymentResponse, error) {
+	log.Printf("Received GetPayment request for ID: %s", req.Id)
+
+	payment, err := h.paymentService.GetPayment(ctx, req.Id)
+	if err != nil {
+		log.Printf("Failed to get payment: %v", err)
+		return nil, status.Errorf(codes.NotFound, "payment not found: %v", err)
+	}
+
+	return mapPaymentToProto(payment), nil
+}
+
+func (h *OrderGrpcHandler) RefundPayment(ctx context.Context, req *pb.RefundPaymentRequest) (*pb.PaymentResponse, error) {
+	log.Printf("Received RefundPayment request for ID: %s", req.Id)
+
+	payment, err := h.paymentService.RefundPayment(ctx, req.Id, mapActorFromProto(req.Actor), req.Reason)
+	if err != nil {
+		log.Printf("Failed to refund payment: %v", err)
+		if errors.Is(err, service.ErrPaymentNotRefundable) || errors.Is(err, repository.ErrPaymentChanged) {
+			return nil, status.Errorf(codes.FailedPrecondition, "failed to refund payment: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to refund payment: %v", err)
+	}
+
+	return mapPaymentToProto(payment), nil
+}
+
+func (h *OrderGrpcHandler) GetOrderHistory(ctx context.Context, req *pb.OrderIDRequest) (*pb.OrderHistoryResponse, error) {
+	log.Printf("Received GetOrderHistory request for ID: %s", req.Id)
+
+	changes, err := h.orderService.GetOrderHistory(ctx, req.Id)
+	if err != nil {
+		log.Printf("Failed to get order history: %v", err)
+		return nil, status.Errorf(codes.NotFound, "order not found: %v", err)
+	}
+
+	var protoChanges []*pb.OrderStatusChange
+	for _, change := range changes {
+		protoChanges = append(protoChanges, &pb.OrderStatusChange{
+			Id:         change.ID,
+			OrderId:    change.OrderID,
+			FromStatus: string(change.FromStatus),
+			ToStatus:   string(change.ToStatus),
+			ActorId:    change.ActorID,
+			ActorRole:  change.ActorRole,
+			Reason:     change.Reason,
+			CreatedAt:  timestamppb.New(change.CreatedAt),
+		})
+	}
+
+	return &pb.OrderHistoryResponse{
+		Changes: protoChanges,
+	}, nil
+}
+
+func (h *OrderGrpcHandler) CreateShipment(ctx context.Context, req *pb.CreateShipmentRequest) (*pb.ShipmentResponse, error) {
+	log.Printf("Received CreateShipment request for order: %s", req.OrderId)
+
+	var items []domain.ShipmentItem
+	for _, item := range req.Items {
+		items = append(items, domain.ShipmentItem{
+			OrderItemID: item.OrderItemId,
+			Quantity:    int(item.Quantity),
+		})
+	}
+
+	shipment := domain.Shipment{
+		OrderID:        req.OrderId,
+		Carrier:        req.Carrier,
+		TrackingNumber: req.TrackingNumber,
+		Items:          items,
+	}
+	if req.ShippedAt != nil {
+		shipment.ShippedAt = req.ShippedAt.AsTime()
 	}
 
-	if req.Filter.FromDate != nil {
-		fromDate := req.Filter.FromDate.AsTime()
+	created, err := h.orderService.CreateShipment(ctx, shipment, mapActorFromProto(req.Actor))
+	if err != nil {
+		log.Printf("Failed to create shipment: %v", err)
+		switch {
+		case errors.Is(err, service.ErrInvalidShipment):
+			return nil, status.Errorf(codes.InvalidArgument, "failed to create shipment: %v", err)
+		case errors.Is(err, service.ErrOrderNotShippable):
+			return nil, status.Errorf(codes.FailedPrecondition, "failed to create shipment: %v", err)
+		case errors.Is(err, repository.ErrTrackingNumberInUse):
+			return nil, status.Errorf(codes.AlreadyExists, "failed to create shipment: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create shipment: %v", err)
+	}
+
+	return mapShipmentToProto(created), nil
+}
+
+func (h *OrderGrpcHandler) GetShipments(ctx context.Context, req *pb.OrderIDRequest) (*pb.ShipmentsResponse, error) {
+	log.Printf("Received GetShipments request for order: %s", req.Id)
+
+	shipments, err := h.orderService.GetShipments(ctx, req.Id)
+	if err != nil {
+		log.Printf("Failed to get shipments: %v", err)
+		return nil, status.Errorf(codes.NotFound, "order not found: %v", err)
+	}
+
+	return &pb.ShipmentsResponse{
+		Shipments: mapShipmentsToProto(shipments),
+	}, nil
+}
+
+// Requests without an actor come from internal callers rather than a user
+func mapActorFromProto(actor *pb.Actor) domain.Actor {
+	if actor == nil {
+		return domain.SystemActor
+	}
+	return domain.Actor{
+		ID:   actor.Id,
+		Role: actor.Role,
+	}
+}
+
+func mapOrderFilterFromProto(f *pb.OrderFilter) (domain.OrderFilter, error) {
+	var filter domain.OrderFilter
+	if f == nil {
+		return filter, nil
+	}
+
+	filter.UserID = f.UserId
+	filter.Page = int(f.Page)
+	filter.PageSize = int(f.PageSize)
+
+	if f.Status != nil {
+		switch *f.Status {
+		case pb.OrderStatus_PENDING:
+			filter.Status = domain.OrderStatusPending
+		case pb.OrderStatus_PAID:
+			filter.Status = domain.OrderStatusPaid
+		case pb.OrderStatus_READY_FOR_PICKUP:
+			filter.Status = domain.OrderStatusReadyForPickup
+		case pb.OrderStatus_SHIPPED:
+			filter.Status = domain.OrderStatusShipped
+		case pb.OrderStatus_DELIVERED:
+			filter.Status = domain.OrderStatusDelivered
+		case pb.OrderStatus_CANCELLED:
+			filter.Status = domain.OrderStatusCancelled
+		}
+	}
+
+	if f.FromDate != nil {
+		fromDate := f.FromDate.AsTime()
 		filter.FromDate = &fromDate
 	}
 
-	if req.Filter.ToDate != nil {
-		toDate := req.Filter.ToDate.AsTime()
+	if f.ToDate != nil {
+		toDate := f.ToDate.AsTime()
 		filter.ToDate = &toDate
 	}
 
-	orders, total, err := h.orderService.ListOrders(ctx, filter)
-	if err != nil {
-		log.Printf("Failed to list orders: %v", err)
-		return nil, status.Errorf(codes.Internal, "failed to list orders: %v", err)
+	if f.PageToken != "" {
+		cursor, err := decodePageToken(f.PageToken)
+		if err != nil {
+			return filter, err
+		}
+		filter.After = cursor
 	}
 
+	return filter, nil
+}
+
+func mapOrderPageToProto(page domain.OrderPage, filter domain.OrderFilter) *pb.ListOrdersResponse {
 	var protoOrders []*pb.OrderResponse
-	for _, order := range orders {
+	for _, order := range page.Orders {
 		protoOrders = append(protoOrders, mapOrderToProto(order))
 	}
 
-	return &pb.ListOrdersResponse{
+	response := &pb.ListOrdersResponse{
 		Orders:   protoOrders,
-		Total:    int32(total),
+		Total:    int32(page.Total),
 		Page:     int32(filter.Page),
 		PageSize: int32(filter.PageSize),
-	}, nil
+	}
+	if page.Next != nil {
+		response.NextPageToken = encodePageToken(*page.Next)
+	}
+
+	return response
 }
 
-func (h *OrderGrpcHandler) GetUserOrders(ctx context.Context, req *pb.UserIDRequest) (*pb.ListOrdersResponse, error) {
-	log.Printf("Received GetUserOrders request for user: %s", req.UserId)
+// Page tokens are opaque to clients: the URL-safe base64 of the JSON cursor.
+func encodePageToken(cursor domain.OrderCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
 
-	orders, err := h.orderService.GetUserOrders(ctx, req.UserId)
+func decodePageToken(token string) (*domain.OrderCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
 	if err != nil {
-		log.Printf("Failed to get user orders: %v", err)
-		return nil, status.Errorf(codes.Internal, "failed to get user orders: %v", err)
+		return nil, errors.New("invalid page token")
 	}
 
-	var protoOrders []*pb.OrderResponse
-	for _, order := range orders {
-		protoOrders = append(protoOrders, mapOrderToProto(order))
+	var cursor domain.OrderCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.ID == "" || cursor.CreatedAt.IsZero() {
+		return nil, errors.New("invalid page token")
 	}
 
-	return &pb.ListOrdersResponse{
-		Orders: protoOrders,
-		Total:  int32(len(orders)),
-		Page:   1,
-	}, nil
+	return &cursor, nil
 }
 
 // Helper function to map domain.Order to pb.OrderResponse
@@ -183,6 +435,8 @@ func mapOrderToProto(order domain.Order) *pb.OrderResponse {
 		status = pb.OrderStatus_PENDING
 	case domain.OrderStatusPaid:
 		status = pb.OrderStatus_PAID
+	case domain.OrderStatusReadyForPickup:
+		status = pb.OrderStatus_READY_FOR_PICKUP
 	case domain.OrderStatusShipped:
 		status = pb.OrderStatus_SHIPPED
 	case domain.OrderStatusDelivered:
@@ -194,26 +448,183 @@ func mapOrderToProto(order domain.Order) *pb.OrderResponse {
 	var items []*pb.OrderItemResponse
 	for _, item := range order.Items {
 		items = append(items, &pb.OrderItemResponse{
-			Id:        item.ID,
-			OrderId:   item.OrderID,
-			ProductId: item.ProductID,
-			Name:      item.Name,
-			Price:     item.Price,
-			Quantity:  int32(item.Quantity),
-			FrameSize: item.FrameSize,
-			WheelSize: item.WheelSize,
-			Color:     item.Color,
-			BikeType:  item.BikeType,
+			Id:         item.ID,
+			OrderId:    item.OrderID,
+			ProductId:  item.ProductID,
+			Name:       item.Name,
+			Price:      item.Price.Float64(),
+			PriceMoney: mapMoneyToProto(item.Price),
+			Quantity:   int32(item.Quantity),
+			FrameSize:  item.FrameSize,
+			WheelSize:  item.WheelSize,
+			Color:      item.Color,
+			BikeType:   item.BikeType,
+			CategoryId: item.CategoryID,
+			Weight:     item.Weight,
+		})
+	}
+
+	response := &pb.OrderResponse{
+		Id:                 order.ID,
+		UserId:             order.UserID,
+		Status:             status,
+		Total:              order.Total.Float64(),
+		Subtotal:           order.Subtotal.Float64(),
+		CouponCode:         order.CouponCode,
+		Discount:           order.Discount.Float64(),
+		Tax:                order.Tax.Float64(),
+		Shipping:           order.Shipping.Float64(),
+		TotalMoney:         mapMoneyToProto(order.Total),
+		SubtotalMoney:      mapMoneyToProto(order.Subtotal),
+		DiscountMoney:      mapMoneyToProto(order.Discount),
+		TaxMoney:           mapMoneyToProto(order.Tax),
+		ShippingMoney:      mapMoneyToProto(order.Shipping),
+		TaxRegion:          order.TaxRegion,
+		Currency:           order.Currency,
+		ExchangeRate:       order.ExchangeRate,
+		Items:              items,
+		CancellationReason: order.CancellationReason,
+		Shipments:          mapShipmentsToProto(order.Shipments),
+		Pickup:             mapPickupToProto(order.Pickup),
+		CreatedAt:          timestamppb.New(order.CreatedAt),
+		UpdatedAt:          timestamppb.New(order.UpdatedAt),
+	}
+	if order.ShippingAddress != nil {
+		response.ShippingAddress = mapAddressToProto(*order.ShippingAddress)
+	}
+
+	return response
+}
+
+func mapAddressFromProto(address *pb.ShippingAddress) domain.Address {
+	return domain.Address{
+		RecipientName: address.RecipientName,
+		Line1:         address.Line1,
+		Line2:         address.Line2,
+		City:          address.City,
+		Region:        address.Region,
+		PostalCode:    address.PostalCode,
+		Country:       address.Country,
+		Phone:         address.Phone,
+	}
+}
+
+func mapAddressToProto(address domain.Address) *pb.ShippingAddress {
+	return &pb.ShippingAddress{
+		RecipientName: address.RecipientName,
+		Line1:         address.Line1,
+		Line2:         address.Line2,
+		City:          address.City,
+		Region:        address.Region,
+		PostalCode:    address.PostalCode,
+		Country:       address.Country,
+		Phone:         address.Phone,
+	}
+}
+
+func mapPickupFromProto(pickup *pb.Pickup) *domain.Pickup {
+	if pickup == nil {
+		return nil
+	}
+	return &domain.Pickup{
+		StoreID: pickup.StoreId,
+		Date:    pickup.Date,
+	}
+}
+
+func mapPickupToProto(pickup *domain.Pickup) *pb.Pickup {
+	if pickup == nil {
+		return nil
+	}
+	return &pb.Pickup{
+		StoreId: pickup.StoreID,
+		Date:    pickup.Date,
+	}
+}
+
+// Helper function to map domain.Shipment to pb.ShipmentResponse
+func mapShipmentToProto(shipment domain.Shipment) *pb.ShipmentResponse {
+	var items []*pb.ShipmentItem
+	for _, item := range shipment.Items {
+		items = append(items, &pb.ShipmentItem{
+			OrderItemId: item.OrderItemID,
+			ProductId:   item.ProductID,
+			Quantity:    int32(item.Quantity),
 		})
 	}
 
-	return &pb.OrderResponse{
-		Id:        order.ID,
-		UserId:    order.UserID,
-		Status:    status,
-		Total:     order.Total,
-		Items:     items,
-		CreatedAt: timestamppb.New(order.CreatedAt),
-		UpdatedAt: timestamppb.New(order.UpdatedAt),
+	return &pb.ShipmentResponse{
+		Id:             shipment.ID,
+		OrderId:        shipment.OrderID,
+		Carrier:        shipment.Carrier,
+		TrackingNumber: shipment.TrackingNumber,
+		ShippedAt:      timestamppb.New(shipment.ShippedAt),
+		Items:          items,
+		CreatedAt:      timestamppb.New(shipment.CreatedAt),
+	}
+}
+
+func mapShipmentsToProto(shipments []domain.Shipment) []*pb.ShipmentResponse {
+	var protoShipments []*pb.ShipmentResponse
+	for _, shipment := range shipments {
+		protoShipments = append(protoShipments, mapShipmentToProto(shipment))
+	}
+	return protoShipments
+}
+
+// Helper function to map domain.Payment to pb.PaymentResponse
+func mapPaymentToProto(payment domain.Payment) *pb.PaymentResponse {
+	var status pb.PaymentStatus
+	switch payment.Status {
+	case domain.PaymentStatusPending:
+		status = pb.PaymentStatus_PAYMENT_PENDING
+	case domain.PaymentStatusCompleted:
+		status = pb.PaymentStatus_PAYMENT_COMPLETED
+	case domain.PaymentStatusFailed:
+		status = pb.PaymentStatus_PAYMENT_FAILED
+	case domain.PaymentStatusRefunded:
+		status = pb.PaymentStatus_PAYMENT_REFUNDED
+	case domain.PaymentStatusRefunding:
+		status = pb.PaymentStatus_PAYMENT_REFUNDING
+	case domain.PaymentStatusRefundFailed:
+		status = pb.PaymentStatus_PAYMENT_REFUND_FAILED
+	}
+
+	return &pb.PaymentResponse{
+		Id:                  payment.ID,
+		OrderId:             payment.OrderID,
+		Amount:              payment.Amount.Float64(),
+		RefundedAmount:      payment.RefundedAmount.Float64(),
+		AmountMoney:         mapMoneyToProto(payment.Amount),
+		RefundedAmountMoney: mapMoneyToProto(payment.RefundedAmount),
+		Status:              status,
+		Method:              payment.Method,
+		TransactionId:       payment.TransactionID,
+		CreatedAt:           timestamppb.New(payment.CreatedAt),
+		UpdatedAt:           timestamppb.New(payment.UpdatedAt),
+	}
+}
+
+// Helper function to map domain.Money to moneypb.Money
+func mapMoneyToProto(m domain.Money) *moneypb.Money {
+	currency := m.Currency
+	if currency == "" {
+		currency = domain.DefaultCurrency
+	}
+
+	return &moneypb.Money{
+		Amount:   m.Amount,
+		Currency: currency,
 	}
 }
+
+// mapMoneyFromProto takes the exact amount when the client sent one and otherwise the
+// decimal amount older clients send. An amount without a currency is left without one; it is
+// in whatever currency it applies to, such as the order's for a refund.
+func mapMoneyFromProto(m *moneypb.Money, fallback float64) domain.Money {
+	if m == nil {
+		return domain.MoneyFromFloat(fallback, "")
+	}
+
+	return domain.NewMoney(m.Amount, strings.ToUpper(strings.TrimSpace(m.Currency)))
+}
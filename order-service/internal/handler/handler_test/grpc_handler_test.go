@@ -0,0 +1,99 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"order-service/internal/domain"
+	"order-service/internal/handler"
+	"order-service/internal/service"
+	pb "proto/order"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeOrderService records the filters it is listed with and returns page
+type fakeOrderService struct {
+	service.OrderService
+	page    domain.OrderPage
+	filters []domain.OrderFilter
+}
+
+func (s *fakeOrderService) ListOrders(ctx context.Context, filter domain.OrderFilter) (domain.OrderPage, error) {
+	s.filters = append(s.filters, filter)
+	return s.page, nil
+}
+
+func TestListOrders_PageTokenRoundTrips(t *testing.T) {
+	cursor := domain.OrderCursor{
+		CreatedAt: time.Date(2026, 10, 17, 9, 30, 15, 123456789, time.UTC),
+		ID:        "8d3f2a4e-1b7c-4c55-9a0e-2f6d1c9b7e21",
+	}
+	orders := &fakeOrderService{page: domain.OrderPage{
+		Orders: []domain.Order{{ID: cursor.ID, CreatedAt: cursor.CreatedAt}},
+		Total:  25,
+		Next:   &cursor,
+	}}
+	h := handler.NewOrderGrpcHandler(orders, nil)
+
+	first, err := h.ListOrders(context.Background(), &pb.ListOrdersRequest{Filter: &pb.OrderFilter{PageSize: 1}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if first.NextPageToken == "" {
+		t.Fatal("Expected a next page token")
+	}
+
+	orders.page = domain.OrderPage{}
+	second, err := h.ListOrders(context.Background(), &pb.ListOrdersRequest{
+		Filter: &pb.OrderFilter{PageSize: 1, PageToken: first.NextPageToken},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if second.NextPageToken != "" {
+		t.Errorf("Expected no token on the last page, got %q", second.NextPageToken)
+	}
+
+	if len(orders.filters) != 2 {
+		t.Fatalf("Expected 2 listings, got %d", len(orders.filters))
+	}
+	if orders.filters[0].After != nil {
+		t.Errorf("Expected the first page to start at the top, got %+v", *orders.filters[0].After)
+	}
+	after := orders.filters[1].After
+	if after == nil {
+		t.Fatal("Expected the second page to continue from the cursor")
+	}
+	if after.ID != cursor.ID || !after.CreatedAt.Equal(cursor.CreatedAt) {
+		t.Errorf("Expected cursor %+v, got %+v", cursor, *after)
+	}
+}
+
+func TestListOrders_InvalidPageToken(t *testing.T) {
+	tokens := map[string]string{
+		"not base64":        "not a token!",
+		"padded base64":     base64.URLEncoding.EncodeToString([]byte(`{"created_at":"2026-10-17T09:30:15Z","id":"a"}`)),
+		"not json":          base64.RawURLEncoding.EncodeToString([]byte("page 2")),
+		"empty cursor":      base64.RawURLEncoding.EncodeToString([]byte(`{}`)),
+		"missing id":        base64.RawURLEncoding.EncodeToString([]byte(`{"created_at":"2026-10-17T09:30:15Z"}`)),
+		"missing timestamp": base64.RawURLEncoding.EncodeToString([]byte(`{"id":"a"}`)),
+		"bad timestamp":     base64.RawURLEncoding.EncodeToString([]byte(`{"created_at":"yesterday","id":"a"}`)),
+	}
+
+	for name, token := range tokens {
+		orders := &fakeOrderService{}
+		h := handler.NewOrderGrpcHandler(orders, nil)
+
+		_, err := h.ListOrders(context.Background(), &pb.ListOrdersRequest{Filter: &pb.OrderFilter{PageToken: token}})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: expected InvalidArgument, got %v", name, err)
+		}
+		if len(orders.filters) != 0 {
+			t.Errorf("%s: expected no listing for an invalid token", name)
+		}
+	}
+}
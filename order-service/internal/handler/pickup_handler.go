@@ -0,0 +1,156 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	pb "proto/order"
+
+	"order-service/internal/domain"
+	"order-service/internal/repository"
+	"order-service/internal/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PickupGrpcHandler struct {
+	pb.UnimplementedPickupServiceServer
+	pickupService service.PickupService
+}
+
+func NewPickupGrpcHandler(pickupService service.PickupService) *PickupGrpcHandler {
+	return &PickupGrpcHandler{
+		pickupService: pickupService,
+	}
+}
+
+func (h *PickupGrpcHandler) CreateStore(ctx context.Context, req *pb.StoreRequest) (*pb.StoreResponse, error) {
+	log.Printf("Received CreateStore request for store: %s", req.Name)
+
+	store, err := h.pickupService.CreateStore(ctx, mapStoreFromProto(req))
+	if err != nil {
+		log.Printf("Failed to create store: %v", err)
+		return nil, pickupError("create store", err)
+	}
+
+	return mapStoreToProto(store), nil
+}
+
+func (h *PickupGrpcHandler) GetStore(ctx context.Context, req *pb.StoreIDRequest) (*pb.StoreResponse, error) {
+	log.Printf("Received GetStore request for ID: %s", req.Id)
+
+	store, err := h.pickupService.GetStore(ctx, req.Id)
+	if err != nil {
+		log.Printf("Failed to get store: %v", err)
+		return nil, pickupError("get store", err)
+	}
+
+	return mapStoreToProto(store), nil
+}
+
+func (h *PickupGrpcHandler) UpdateStore(ctx context.Context, req *pb.StoreRequest) (*pb.StoreResponse, error) {
+	log.Printf("Received UpdateStore request for ID: %s", req.Id)
+
+	store, err := h.pickupService.UpdateStore(ctx, mapStoreFromProto(req))
+	if err != nil {
+		log.Printf("Failed to update store: %v", err)
+		return nil, pickupError("update store", err)
+	}
+
+	return mapStoreToProto(store), nil
+}
+
+func (h *PickupGrpcHandler) ListStores(ctx context.Context, req *pb.ListStoresRequest) (*pb.ListStoresResponse, error) {
+	log.Printf("Received ListStores request")
+
+	stores, err := h.pickupService.ListStores(ctx, req.IncludeInactive)
+	if err != nil {
+		log.Printf("Failed to list stores: %v", err)
+		return nil, pickupError("list stores", err)
+	}
+
+	response := &pb.ListStoresResponse{}
+	for _, store := range stores {
+		response.Stores = append(response.Stores, mapStoreToProto(store))
+	}
+
+	return response, nil
+}
+
+func (h *PickupGrpcHandler) GetPickupSlots(ctx context.Context, req *pb.PickupSlotsRequest) (*pb.PickupSlotsResponse, error) {
+	log.Printf("Received GetPickupSlots request for store ID: %s", req.StoreId)
+
+	slots, err := h.pickupService.GetPickupSlots(ctx, req.StoreId, req.From, int(req.Days))
+	if err != nil {
+		log.Printf("Failed to get pickup slots: %v", err)
+		return nil, pickupError("get pickup slots", err)
+	}
+
+	response := &pb.PickupSlotsResponse{StoreId: req.StoreId}
+	for _, slot := range slots {
+		response.Slots = append(response.Slots, &pb.PickupSlot{
+			Date:      slot.Date,
+			Capacity:  int32(slot.Capacity),
+			Booked:    int32(slot.Booked),
+			Remaining: int32(slot.Remaining()),
+		})
+	}
+
+	return response, nil
+}
+
+func (h *PickupGrpcHandler) GetDailyPickups(ctx context.Context, req *pb.DailyPickupsRequest) (*pb.DailyPickupsResponse, error) {
+	log.Printf("Received GetDailyPickups request for date: %s", req.Date)
+
+	pickups, err := h.pickupService.GetDailyPickups(ctx, req.Date, req.StoreId)
+	if err != nil {
+		log.Printf("Failed to get daily pickups: %v", err)
+		return nil, pickupError("get daily pickups", err)
+	}
+
+	response := &pb.DailyPickupsResponse{Date: req.Date}
+	for _, storePickups := range pickups {
+		entry := &pb.StorePickups{Store: mapStoreToProto(storePickups.Store)}
+		for _, order := range storePickups.Orders {
+			entry.Orders = append(entry.Orders, mapOrderToProto(order))
+		}
+		response.Stores = append(response.Stores, entry)
+	}
+
+	return response, nil
+}
+
+func pickupError(action string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidStore), errors.Is(err, service.ErrInvalidPickup):
+		return status.Errorf(codes.InvalidArgument, "failed to %s: %v", action, err)
+	case errors.Is(err, repository.ErrStoreNotFound):
+		return status.Errorf(codes.NotFound, "failed to %s: %v", action, err)
+	}
+	return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+}
+
+func mapStoreFromProto(req *pb.StoreRequest) domain.Store {
+	return domain.Store{
+		ID:            req.Id,
+		Name:          req.Name,
+		Address:       req.Address,
+		DailyCapacity: int(req.DailyCapacity),
+		Active:        req.Active,
+	}
+}
+
+func mapStoreToProto(store domain.Store) *pb.StoreResponse {
+	return &pb.StoreResponse{
+		Id:            store.ID,
+		Name:          store.Name,
+		Address:       store.Address,
+		DailyCapacity: int32(store.DailyCapacity),
+		Active:        store.Active,
+		CreatedAt:     timestamppb.New(store.CreatedAt),
+		UpdatedAt:     timestamppb.New(store.UpdatedAt),
+	}
+}
@@ -0,0 +1,194 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	pb "proto/order"
+
+	"order-service/internal/domain"
+	"order-service/internal/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ReturnGrpcHandler struct {
+	pb.UnimplementedReturnServiceServer
+	returnService service.ReturnService
+}
+
+func NewReturnGrpcHandler(returnService service.ReturnService) *ReturnGrpcHandler {
+	return &ReturnGrpcHandler{
+		returnService: returnService,
+	}
+}
+
+func (h *ReturnGrpcHandler) RequestReturn(ctx context.Context, req *pb.RequestReturnRequest) (*pb.ReturnResponse, error) {
+	log.Printf("Received RequestReturn request for order: %s", req.OrderId)
+
+	var items []domain.ReturnItem
+	for _, item := range req.Items {
+		items = append(items, domain.ReturnItem{
+			OrderItemID: item.OrderItemId,
+			Quantity:    int(item.Quantity),
+		})
+	}
+
+	ret, err := h.returnService.RequestReturn(ctx, req.OrderId, items, req.Reason)
+	if err != nil {
+		log.Printf("Failed to request return: %v", err)
+		return nil, returnError("request return", err)
+	}
+
+	return mapReturnToProto(ret), nil
+}
+
+func (h *ReturnGrpcHandler) GetReturn(ctx context.Context, req *pb.ReturnIDRequest) (*pb.ReturnResponse, error) {
+	log.Printf("Received GetReturn request for ID: %s", req.Id)
+
+	ret, err := h.returnService.GetReturn(ctx, req.Id)
+	if err != nil {
+		log.Printf("Failed to get return: %v", err)
+		return nil, status.Errorf(codes.NotFound, "return not found: %v", err)
+	}
+
+	return mapReturnToProto(ret), nil
+}
+
+func (h *ReturnGrpcHandler) GetOrderReturns(ctx context.Context, req *pb.OrderReturnsRequest) (*pb.ReturnsResponse, error) {
+	log.Printf("Received GetOrderReturns request for order: %s", req.OrderId)
+
+	returns, err := h.returnService.GetOrderReturns(ctx, req.OrderId)
+	if err != nil {
+		log.Printf("Failed to get order returns: %v", err)
+		return nil, status.Errorf(codes.NotFound, "order not found: %v", err)
+	}
+
+	return mapReturnsToProto(returns, len(returns)), nil
+}
+
+func (h *ReturnGrpcHandler) ListReturns(ctx context.Context, req *pb.ListReturnsRequest) (*pb.ReturnsResponse, error) {
+	log.Printf("Received ListReturns request")
+
+	filter := domain.ReturnFilter{
+		Status:   domain.ReturnStatus(req.Status),
+		Page:     int(req.Page),
+		PageSize: int(req.PageSize),
+	}
+
+	switch filter.Status {
+	case "", domain.ReturnStatusRequested, domain.ReturnStatusApproved, domain.ReturnStatusRejected,
+		domain.ReturnStatusReceived, domain.ReturnStatusRefunding, domain.ReturnStatusRefunded:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid return status")
+	}
+
+	returns, total, err := h.returnService.ListReturns(ctx, filter)
+	if err != nil {
+		log.Printf("Failed to list returns: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to list returns: %v", err)
+	}
+
+	return mapReturnsToProto(returns, total), nil
+}
+
+func (h *ReturnGrpcHandler) ApproveReturn(ctx context.Context, req *pb.ResolveReturnRequest) (*pb.ReturnResponse, error) {
+	log.Printf("Received ApproveReturn request for ID: %s", req.Id)
+
+	ret, err := h.returnService.ApproveReturn(ctx, req.Id, req.Note)
+	if err != nil {
+		log.Printf("Failed to approve return: %v", err)
+		return nil, returnError("approve return", err)
+	}
+
+	return mapReturnToProto(ret), nil
+}
+
+func (h *ReturnGrpcHandler) RejectReturn(ctx context.Context, req *pb.ResolveReturnRequest) (*pb.ReturnResponse, error) {
+	log.Printf("Received RejectReturn request for ID: %s", req.Id)
+
+	ret, err := h.returnService.RejectReturn(ctx, req.Id, req.Note)
+	if err != nil {
+		log.Printf("Failed to reject return: %v", err)
+		return nil, returnError("reject return", err)
+	}
+
+	return mapReturnToProto(ret), nil
+}
+
+func (h *ReturnGrpcHandler) ReceiveReturn(ctx context.Context, req *pb.ReceiveReturnRequest) (*pb.ReturnResponse, error) {
+	log.Printf("Received ReceiveReturn request for ID: %s (restock: %t)", req.Id, req.Restock)
+
+	ret, err := h.returnService.ReceiveReturn(ctx, req.Id, req.Restock)
+	if err != nil {
+		log.Printf("Failed to receive return: %v", err)
+		return nil, returnError("receive return", err)
+	}
+
+	return mapReturnToProto(ret), nil
+}
+
+func (h *ReturnGrpcHandler) RefundReturn(ctx context.Context, req *pb.RefundReturnRequest) (*pb.ReturnResponse, error) {
+	log.Printf("Received RefundReturn request for ID: %s", req.Id)
+
+	ret, err := h.returnService.RefundReturn(ctx, req.Id, mapMoneyFromProto(req.AmountMoney, req.Amount))
+	if err != nil {
+		log.Printf("Failed to refund return: %v", err)
+		return nil, returnError("refund return", err)
+	}
+
+	return mapReturnToProto(ret), nil
+}
+
+func returnError(action string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidReturn):
+		return status.Errorf(codes.InvalidArgument, "failed to %s: %v", action, err)
+	case errors.Is(err, service.ErrReturnNotAllowed):
+		return status.Errorf(codes.FailedPrecondition, "failed to %s: %v", action, err)
+	}
+	return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+}
+
+// Helper function to map domain.Return to pb.ReturnResponse
+func mapReturnToProto(ret domain.Return) *pb.ReturnResponse {
+	var items []*pb.ReturnItem
+	for _, item := range ret.Items {
+		items = append(items, &pb.ReturnItem{
+			OrderItemId: item.OrderItemID,
+			ProductId:   item.ProductID,
+			Quantity:    int32(item.Quantity),
+			Price:       item.Price.Float64(),
+			PriceMoney:  mapMoneyToProto(item.Price),
+			Discount:    mapMoneyToProto(item.Discount),
+			Tax:         mapMoneyToProto(item.Tax),
+		})
+	}
+
+	return &pb.ReturnResponse{
+		Id:                ret.ID,
+		OrderId:           ret.OrderID,
+		UserId:            ret.UserID,
+		Status:            string(ret.Status),
+		Reason:            ret.Reason,
+		Items:             items,
+		ResolutionNote:    ret.ResolutionNote,
+		Restocked:         ret.Restocked,
+		RefundAmount:      ret.RefundAmount.Float64(),
+		RefundAmountMoney: mapMoneyToProto(ret.RefundAmount),
+		PaymentId:         ret.PaymentID,
+		CreatedAt:         timestamppb.New(ret.CreatedAt),
+		UpdatedAt:         timestamppb.New(ret.UpdatedAt),
+	}
+}
+
+func mapReturnsToProto(returns []domain.Return, total int) *pb.ReturnsResponse {
+	response := &pb.ReturnsResponse{Total: int32(total)}
+	for _, ret := range returns {
+		response.Returns = append(response.Returns, mapReturnToProto(ret))
+	}
+	return response
+}
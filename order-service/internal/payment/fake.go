@@ -0,0 +1,47 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"order-service/internal/domain"
+)
+
+// DeclinedMethod makes the fake provider decline a charge, so failure paths can be exercised locally.
+const DeclinedMethod = "declined_card"
+
+type fakeProvider struct{}
+
+// NewFakeProvider returns a deterministic provider for local runs: every charge is approved
+// unless the method is DeclinedMethod, and transaction IDs are derived from the payment ID.
+func NewFakeProvider() Provider {
+	return &fakeProvider{}
+}
+
+func (p *fakeProvider) Name() string {
+	return "fake"
+}
+
+func (p *fakeProvider) Charge(ctx context.Context, req ChargeRequest) (ChargeResult, error) {
+	log.Printf("[FAKE-PAYMENT] Charging %s %s for order %s using %s", req.Amount, req.Amount.Currency, req.OrderID, req.Method)
+
+	if req.Method == DeclinedMethod {
+		return ChargeResult{Approved: false, Reason: "card declined"}, nil
+	}
+
+	return ChargeResult{
+		TransactionID: fmt.Sprintf("fake_%s", req.PaymentID),
+		Approved:      true,
+	}, nil
+}
+
+func (p *fakeProvider) Refund(ctx context.Context, transactionID string, amount domain.Money) error {
+	if !strings.HasPrefix(transactionID, "fake_") {
+		return ErrUnknownTransaction
+	}
+
+	log.Printf("[FAKE-PAYMENT] Refunded %s %s for transaction %s", amount, amount.Currency, transactionID)
+	return nil
+}
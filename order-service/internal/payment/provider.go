@@ -0,0 +1,30 @@
+package payment
+
+import (
+	"context"
+	"errors"
+
+	"order-service/internal/domain"
+)
+
+// Provider is implemented by every payment gateway order-service can charge through.
+type Provider interface {
+	Name() string
+	Charge(ctx context.Context, req ChargeRequest) (ChargeResult, error)
+	Refund(ctx context.Context, transactionID string, amount domain.Money) error
+}
+
+type ChargeRequest struct {
+	PaymentID string
+	OrderID   string
+	Amount    domain.Money
+	Method    string
+}
+
+type ChargeResult struct {
+	TransactionID string
+	Approved      bool
+	Reason        string
+}
+
+var ErrUnknownTransaction = errors.New("unknown transaction")
@@ -0,0 +1,181 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"order-service/internal/domain"
+)
+
+// AnalyticsRepository aggregates orders for sales reports. Periods are bucketed in the
+// database time zone, and amounts are converted back into the base currency at the exchange
+// rate of each order, rounding per order.
+type AnalyticsRepository interface {
+	RevenueByPeriod(ctx context.Context, period domain.ReportPeriod, r domain.ReportRange) ([]domain.RevenueBucket, error)
+	CountByStatus(ctx context.Context, r domain.ReportRange) ([]domain.OrderStatusCount, error)
+	TopProducts(ctx context.Context, r domain.ReportRange, limit int) ([]domain.ProductSales, error)
+	SalesByAttribute(ctx context.Context, attribute domain.SalesAttribute, r domain.ReportRange) ([]domain.AttributeSales, error)
+}
+
+type PostgresAnalyticsRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresAnalyticsRepository(db *sql.DB) AnalyticsRepository {
+	return &PostgresAnalyticsRepository{
+		db: db,
+	}
+}
+
+// salesAttributeColumns maps each attribute to its order_items column; only these are ever
+// interpolated into a query.
+var salesAttributeColumns = map[domain.SalesAttribute]string{
+	domain.SalesAttributeBikeType:  "oi.bike_type",
+	domain.SalesAttributeFrameSize: "oi.frame_size",
+	domain.SalesAttributeColor:     "oi.color",
+}
+
+func (r *PostgresAnalyticsRepository) RevenueByPeriod(ctx context.Context, period domain.ReportPeriod, reportRange domain.ReportRange) ([]domain.RevenueBucket, error) {
+	soldClause, args := soldStatusClause("o.status", 4)
+
+	query := fmt.Sprintf(`
+		SELECT date_trunc($1, o.created_at) AS period_start, COALESCE(SUM(ROUND(o.total / o.exchange_rate, 2)), 0), COUNT(*),
+		       COALESCE(SUM(ROUND(o.subtotal / o.exchange_rate, 2)), 0), COALESCE(SUM(ROUND(o.discount / o.exchange_rate, 2)), 0),
+		       COALESCE(SUM(ROUND(o.tax / o.exchange_rate, 2)), 0), COALESCE(SUM(ROUND(o.shipping / o.exchange_rate, 2)), 0)
+		FROM orders o
+		WHERE o.created_at >= $2 AND o.created_at < $3 AND %s
+		GROUP BY period_start
+		ORDER BY period_start`, soldClause)
+
+	args = append([]interface{}{string(period), reportRange.From, reportRange.To}, args...)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.New("failed to get revenue report")
+	}
+	defer rows.Close()
+
+	var buckets []domain.RevenueBucket
+	for rows.Next() {
+		var bucket domain.RevenueBucket
+		if err := rows.Scan(&bucket.PeriodStart, &bucket.Revenue, &bucket.Orders,
+			&bucket.Subtotal, &bucket.Discount, &bucket.Tax, &bucket.Shipping); err != nil {
+			return nil, errors.New("failed to scan revenue bucket")
+		}
+		buckets = append(buckets, bucket)
+	}
+
+	return buckets, nil
+}
+
+func (r *PostgresAnalyticsRepository) CountByStatus(ctx context.Context, reportRange domain.ReportRange) ([]domain.OrderStatusCount, error) {
+	query := `
+		SELECT status, COUNT(*), COALESCE(SUM(ROUND(total / exchange_rate, 2)), 0)
+		FROM orders
+		WHERE created_at >= $1 AND created_at < $2
+		GROUP BY status
+		ORDER BY status`
+
+	rows, err := r.db.QueryContext(ctx, query, reportRange.From, reportRange.To)
+	if err != nil {
+		return nil, errors.New("failed to get order status report")
+	}
+	defer rows.Close()
+
+	var counts []domain.OrderStatusCount
+	for rows.Next() {
+		var count domain.OrderStatusCount
+		if err := rows.Scan(&count.Status, &count.Orders, &count.Total); err != nil {
+			return nil, errors.New("failed to scan order status count")
+		}
+		counts = append(counts, count)
+	}
+
+	return counts, nil
+}
+
+func (r *PostgresAnalyticsRepository) TopProducts(ctx context.Context, reportRange domain.ReportRange, limit int) ([]domain.ProductSales, error) {
+	soldClause, args := soldStatusClause("o.status", 4)
+
+	query := fmt.Sprintf(`
+		SELECT oi.product_id, MAX(oi.name), SUM(oi.quantity), SUM(ROUND(oi.price * oi.quantity / o.exchange_rate, 2)),
+		       COUNT(DISTINCT oi.order_id)
+		FROM order_items oi
+		JOIN orders o ON o.id = oi.order_id
+		WHERE o.created_at >= $1 AND o.created_at < $2 AND %s
+		GROUP BY oi.product_id
+		ORDER BY SUM(oi.quantity) DESC, 4 DESC
+		LIMIT $3`, soldClause)
+
+	args = append([]interface{}{reportRange.From, reportRange.To, limit}, args...)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.New("failed to get top products")
+	}
+	defer rows.Close()
+
+	var products []domain.ProductSales
+	for rows.Next() {
+		var product domain.ProductSales
+		if err := rows.Scan(&product.ProductID, &product.Name, &product.Units, &product.Revenue, &product.Orders); err != nil {
+			return nil, errors.New("failed to scan product sales")
+		}
+		products = append(products, product)
+	}
+
+	return products, nil
+}
+
+func (r *PostgresAnalyticsRepository) SalesByAttribute(ctx context.Context, attribute domain.SalesAttribute, reportRange domain.ReportRange) ([]domain.AttributeSales, error) {
+	column, ok := salesAttributeColumns[attribute]
+	if !ok {
+		return nil, fmt.Errorf("unsupported sales attribute %q", attribute)
+	}
+
+	soldClause, args := soldStatusClause("o.status", 3)
+
+	query := fmt.Sprintf(`
+		SELECT COALESCE(NULLIF(%[1]s, ''), 'unknown') AS value,
+		       SUM(oi.quantity), SUM(ROUND(oi.price * oi.quantity / o.exchange_rate, 2)), COUNT(DISTINCT oi.order_id)
+		FROM order_items oi
+		JOIN orders o ON o.id = oi.order_id
+		WHERE o.created_at >= $1 AND o.created_at < $2 AND %[2]s
+		GROUP BY value
+		ORDER BY 3 DESC`, column, soldClause)
+
+	args = append([]interface{}{reportRange.From, reportRange.To}, args...)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.New("failed to get sales by attribute")
+	}
+	defer rows.Close()
+
+	var sales []domain.AttributeSales
+	for rows.Next() {
+		var sale domain.AttributeSales
+		if err := rows.Scan(&sale.Value, &sale.Units, &sale.Revenue, &sale.Orders); err != nil {
+			return nil, errors.New("failed to scan attribute sales")
+		}
+		sales = append(sales, sale)
+	}
+
+	return sales, nil
+}
+
+// soldStatusClause builds "column IN (...)" for the statuses that count as sales, numbering
+// its placeholders from firstArg.
+func soldStatusClause(column string, firstArg int) (string, []interface{}) {
+	placeholders := make([]string, len(domain.SoldOrderStatuses))
+	args := make([]interface{}, len(domain.SoldOrderStatuses))
+	for i, status := range domain.SoldOrderStatuses {
+		placeholders[i] = fmt.Sprintf("$%d", firstArg+i)
+		args[i] = status
+	}
+
+	return fmt.Sprintf("%s IN (%s)", column, strings.Join(placeholders, ", ")), args
+}
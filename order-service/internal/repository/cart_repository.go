@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"order-service/internal/cache"
+	"order-service/internal/domain"
+)
+
+// CartRepository stores carts by owner: a user ID for user carts, a session ID for guest carts.
+type CartRepository interface {
+	// Get returns the owner's cart, which is empty if the owner has none
+	Get(ctx context.Context, ownerID string) (domain.Cart, error)
+	// Save replaces the owner's cart
+	Save(ctx context.Context, ownerID string, cart domain.Cart) error
+	Delete(ctx context.Context, ownerID string) error
+}
+
+// PostgresCartRepository keeps the carts of signed-in users.
+type PostgresCartRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresCartRepository(db *sql.DB) CartRepository {
+	return &PostgresCartRepository{
+		db: db,
+	}
+}
+
+func (r *PostgresCartRepository) Get(ctx context.Context, userID string) (domain.Cart, error) {
+	if userID == "" {
+		return domain.Cart{}, errors.New("user ID is required")
+	}
+
+	query := `
+		SELECT product_id, quantity, added_at, updated_at
+		FROM cart_items
+		WHERE user_id = $1
+		ORDER BY added_at, product_id`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return domain.Cart{}, errors.New("failed to get cart")
+	}
+	defer rows.Close()
+
+	cart := domain.Cart{UserID: userID}
+	for rows.Next() {
+		var item domain.CartItem
+		var updatedAt time.Time
+		if err := rows.Scan(&item.ProductID, &item.Quantity, &item.AddedAt, &updatedAt); err != nil {
+			return domain.Cart{}, errors.New("failed to scan cart item")
+		}
+
+		cart.Items = append(cart.Items, item)
+		if updatedAt.After(cart.UpdatedAt) {
+			cart.UpdatedAt = updatedAt
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return domain.Cart{}, errors.New("failed to get cart")
+	}
+
+	return cart, nil
+}
+
+func (r *PostgresCartRepository) Save(ctx context.Context, userID string, cart domain.Cart) error {
+	if userID == "" {
+		return errors.New("user ID is required")
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.New("failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM cart_items WHERE user_id = $1`, userID); err != nil {
+		return errors.New("failed to clear cart")
+	}
+
+	if cart.UpdatedAt.IsZero() {
+		cart.UpdatedAt = time.Now()
+	}
+
+	for _, item := range cart.Items {
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO cart_items (user_id, product_id, quantity, added_at, updated_at) VALUES ($1, $2, $3, $4, $5)`,
+			userID, item.ProductID, item.Quantity, item.AddedAt, cart.UpdatedAt,
+		)
+		if err != nil {
+			return errors.New("failed to save cart item")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.New("failed to commit transaction")
+	}
+
+	return nil
+}
+
+func (r *PostgresCartRepository) Delete(ctx context.Context, userID string) error {
+	if userID == "" {
+		return errors.New("user ID is required")
+	}
+
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM cart_items WHERE user_id = $1`, userID); err != nil {
+		return errors.New("failed to delete cart")
+	}
+
+	return nil
+}
+
+// redisCartRepository keeps guest carts by session. They expire ttl after their last change.
+type redisCartRepository struct {
+	cache cache.Cache
+	ttl   time.Duration
+}
+
+func NewRedisCartRepository(c cache.Cache, ttl time.Duration) CartRepository {
+	return &redisCartRepository{
+		cache: c,
+		ttl:   ttl,
+	}
+}
+
+func (r *redisCartRepository) Get(ctx context.Context, sessionID string) (domain.Cart, error) {
+	if sessionID == "" {
+		return domain.Cart{}, errors.New("session ID is required")
+	}
+
+	var cart domain.Cart
+	err := r.cache.Get(ctx, r.key(sessionID), &cart)
+	if err == cache.ErrCacheMiss {
+		return domain.Cart{SessionID: sessionID}, nil
+	}
+	if err != nil {
+		return domain.Cart{}, fmt.Errorf("failed to get cart: %v", err)
+	}
+
+	cart.SessionID = sessionID
+	return cart, nil
+}
+
+func (r *redisCartRepository) Save(ctx context.Context, sessionID string, cart domain.Cart) error {
+	if sessionID == "" {
+		return errors.New("session ID is required")
+	}
+
+	cart.SessionID = sessionID
+	if err := r.cache.Set(ctx, r.key(sessionID), cart, r.ttl); err != nil {
+		return fmt.Errorf("failed to save cart: %v", err)
+	}
+
+	return nil
+}
+
+func (r *redisCartRepository) Delete(ctx context.Context, sessionID string) error {
+	if sessionID == "" {
+		return errors.New("session ID is required")
+	}
+
+	if err := r.cache.Delete(ctx, r.key(sessionID)); err != nil {
+		return fmt.Errorf("failed to delete cart: %v", err)
+	}
+
+	return nil
+}
+
+func (r *redisCartRepository) key(sessionID string) string {
+	return "cart:session:" + sessionID
+}
@@ -0,0 +1,332 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"order-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrCouponNotFound  = errors.New("coupon not found")
+	ErrCouponCodeTaken = errors.New("coupon code is already in use")
+	ErrCouponUsedUp    = errors.New("coupon has reached its usage limit")
+)
+
+type CouponRepository interface {
+	Create(ctx context.Context, coupon domain.Coupon) (domain.Coupon, error)
+	GetByID(ctx context.Context, id string) (domain.Coupon, error)
+	GetByCode(ctx context.Context, code string) (domain.Coupon, error)
+	List(ctx context.Context) ([]domain.Coupon, error)
+	Update(ctx context.Context, coupon domain.Coupon) (domain.Coupon, error)
+	Delete(ctx context.Context, id string) error
+	// CountUserRedemptions counts the orders userID placed with the coupon that were not cancelled
+	CountUserRedemptions(ctx context.Context, couponID, userID string) (int, error)
+}
+
+type PostgresCouponRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresCouponRepository(db *sql.DB) CouponRepository {
+	return &PostgresCouponRepository{
+		db: db,
+	}
+}
+
+const couponColumns = `id, code, COALESCE(description, ''), discount_type, discount_value, COALESCE(category_id::text, ''),
+		       COALESCE(bike_type, ''), min_order_value, starts_at, ends_at, max_uses, max_uses_per_user,
+		       times_used, active, created_at, updated_at`
+
+func (r *PostgresCouponRepository) Create(ctx context.Context, coupon domain.Coupon) (domain.Coupon, error) {
+	coupon.ID = uuid.New().String()
+	coupon.TimesUsed = 0
+	coupon.CreatedAt = time.Now()
+	coupon.UpdatedAt = coupon.CreatedAt
+
+	query := `
+		INSERT INTO coupons (id, code, description, discount_type, discount_value, category_id, bike_type,
+		                     min_order_value, starts_at, ends_at, max_uses, max_uses_per_user, active,
+		                     created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)`
+
+	_, err := r.db.ExecContext(
+		ctx,
+		query,
+		coupon.ID,
+		coupon.Code,
+		nullString(coupon.Description),
+		coupon.DiscountType,
+		couponDiscountValue(coupon),
+		nullString(coupon.CategoryID),
+		nullString(coupon.BikeType),
+		coupon.MinOrderValue,
+		coupon.StartsAt,
+		coupon.EndsAt,
+		coupon.MaxUses,
+		coupon.MaxUsesPerUser,
+		coupon.Active,
+		coupon.CreatedAt,
+		coupon.UpdatedAt,
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return domain.Coupon{}, ErrCouponCodeTaken
+		}
+		return domain.Coupon{}, errors.New("failed to create coupon")
+	}
+
+	return coupon, nil
+}
+
+func (r *PostgresCouponRepository) GetByID(ctx context.Context, id string) (domain.Coupon, error) {
+	if id == "" {
+		return domain.Coupon{}, errors.New("coupon ID is required")
+	}
+
+	query := `SELECT ` + couponColumns + ` FROM coupons WHERE id = $1`
+
+	return scanCoupon(r.db.QueryRowContext(ctx, query, id))
+}
+
+func (r *PostgresCouponRepository) GetByCode(ctx context.Context, code string) (domain.Coupon, error) {
+	query := `SELECT ` + couponColumns + ` FROM coupons WHERE code = $1`
+
+	return scanCoupon(r.db.QueryRowContext(ctx, query, code))
+}
+
+func (r *PostgresCouponRepository) List(ctx context.Context) ([]domain.Coupon, error) {
+	query := `SELECT ` + couponColumns + ` FROM coupons ORDER BY created_at DESC`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, errors.New("failed to list coupons")
+	}
+	defer rows.Close()
+
+	var coupons []domain.Coupon
+	for rows.Next() {
+		coupon, err := scanCoupon(rows)
+		if err != nil {
+			return nil, err
+		}
+		coupons = append(coupons, coupon)
+	}
+
+	return coupons, nil
+}
+
+func (r *PostgresCouponRepository) Update(ctx context.Context, coupon domain.Coupon) (domain.Coupon, error) {
+	if coupon.ID == "" {
+		return domain.Coupon{}, errors.New("coupon ID is required")
+	}
+
+	coupon.UpdatedAt = time.Now()
+
+	query := `
+		UPDATE coupons
+		SET code = $1, description = $2, discount_type = $3, discount_value = $4, category_id = $5,
+		    bike_type = $6, min_order_value = $7, starts_at = $8, ends_at = $9, max_uses = $10,
+		    max_uses_per_user = $11, active = $12, updated_at = $13
+		WHERE id = $14
+		RETURNING times_used, created_at`
+
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		coupon.Code,
+		nullString(coupon.Description),
+		coupon.DiscountType,
+		couponDiscountValue(coupon),
+		nullString(coupon.CategoryID),
+		nullString(coupon.BikeType),
+		coupon.MinOrderValue,
+		coupon.StartsAt,
+		coupon.EndsAt,
+		coupon.MaxUses,
+		coupon.MaxUsesPerUser,
+		coupon.Active,
+		coupon.UpdatedAt,
+		coupon.ID,
+	).Scan(&coupon.TimesUsed, &coupon.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.Coupon{}, ErrCouponNotFound
+		}
+		if isUniqueViolation(err) {
+			return domain.Coupon{}, ErrCouponCodeTaken
+		}
+		return domain.Coupon{}, errors.New("failed to update coupon")
+	}
+
+	return coupon, nil
+}
+
+func (r *PostgresCouponRepository) Delete(ctx context.Context, id string) error {
+	if id == "" {
+		return errors.New("coupon ID is required")
+	}
+
+	result, err := r.db.ExecContext(ctx, `DELETE FROM coupons WHERE id = $1`, id)
+	if err != nil {
+		return errors.New("failed to delete coupon")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.New("failed to check delete result")
+	}
+
+	if rowsAffected == 0 {
+		return ErrCouponNotFound
+	}
+
+	return nil
+}
+
+func (r *PostgresCouponRepository) CountUserRedemptions(ctx context.Context, couponID, userID string) (int, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM coupon_redemptions WHERE coupon_id = $1 AND user_id = $2`,
+		couponID, userID,
+	).Scan(&count)
+	if err != nil {
+		return 0, errors.New("failed to count coupon redemptions")
+	}
+
+	return count, nil
+}
+
+// redeemCoupon records that order used its coupon, enforcing the coupon's usage limits under
+// a lock on the coupon so concurrent orders cannot exceed them.
+func redeemCoupon(ctx context.Context, tx *sql.Tx, order domain.Order) error {
+	var couponID string
+	var maxUses, maxUsesPerUser, timesUsed int
+	err := tx.QueryRowContext(ctx,
+		`SELECT id, max_uses, max_uses_per_user, times_used FROM coupons WHERE code = $1 FOR UPDATE`,
+		order.CouponCode,
+	).Scan(&couponID, &maxUses, &maxUsesPerUser, &timesUsed)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrCouponNotFound
+		}
+		return errors.New("failed to get coupon")
+	}
+
+	if maxUses > 0 && timesUsed >= maxUses {
+		return ErrCouponUsedUp
+	}
+
+	if maxUsesPerUser > 0 {
+		var used int
+		err := tx.QueryRowContext(ctx,
+			`SELECT COUNT(*) FROM coupon_redemptions WHERE coupon_id = $1 AND user_id = $2`,
+			couponID, order.UserID,
+		).Scan(&used)
+		if err != nil {
+			return errors.New("failed to count coupon redemptions")
+		}
+		if used >= maxUsesPerUser {
+			return ErrCouponUsedUp
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO coupon_redemptions (id, coupon_id, order_id, user_id, discount, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		uuid.New().String(), couponID, order.ID, order.UserID, order.Discount, order.CreatedAt,
+	)
+	if err != nil {
+		return errors.New("failed to record coupon redemption")
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`UPDATE coupons SET times_used = times_used + 1, updated_at = $1 WHERE id = $2`,
+		order.CreatedAt, couponID,
+	)
+	if err != nil {
+		return errors.New("failed to update coupon usage")
+	}
+
+	return nil
+}
+
+// releaseCoupon gives a cancelled order's use of its coupon back.
+func releaseCoupon(ctx context.Context, tx *sql.Tx, orderID string, now time.Time) error {
+	query := `
+		WITH released AS (
+			DELETE FROM coupon_redemptions WHERE order_id = $1 RETURNING coupon_id
+		)
+		UPDATE coupons
+		SET times_used = times_used - 1, updated_at = $2
+		FROM released
+		WHERE coupons.id = released.coupon_id`
+
+	if _, err := tx.ExecContext(ctx, query, orderID, now); err != nil {
+		return errors.New("failed to release coupon")
+	}
+
+	return nil
+}
+
+// couponDiscountValue is what goes in the discount_value column: the percentage of percentage
+// coupons and the amount of fixed ones.
+func couponDiscountValue(coupon domain.Coupon) interface{} {
+	if coupon.DiscountType == domain.DiscountTypeFixed {
+		return coupon.DiscountAmount
+	}
+	return coupon.DiscountPercent
+}
+
+type couponScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanCoupon(row couponScanner) (domain.Coupon, error) {
+	var coupon domain.Coupon
+	var discountValue domain.Money
+	var startsAt, endsAt sql.NullTime
+	err := row.Scan(
+		&coupon.ID,
+		&coupon.Code,
+		&coupon.Description,
+		&coupon.DiscountType,
+		&discountValue,
+		&coupon.CategoryID,
+		&coupon.BikeType,
+		&coupon.MinOrderValue,
+		&startsAt,
+		&endsAt,
+		&coupon.MaxUses,
+		&coupon.MaxUsesPerUser,
+		&coupon.TimesUsed,
+		&coupon.Active,
+		&coupon.CreatedAt,
+		&coupon.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.Coupon{}, ErrCouponNotFound
+		}
+		return domain.Coupon{}, errors.New("failed to scan coupon")
+	}
+
+	if coupon.DiscountType == domain.DiscountTypeFixed {
+		coupon.DiscountAmount = discountValue
+	} else {
+		coupon.DiscountPercent = discountValue.Float64()
+	}
+
+	if startsAt.Valid {
+		coupon.StartsAt = &startsAt.Time
+	}
+	if endsAt.Valid {
+		coupon.EndsAt = &endsAt.Time
+	}
+
+	return coupon, nil
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"order-service/internal/domain"
+	"order-service/internal/events"
 
 	"github.com/google/uuid"
 )
@@ -16,17 +17,60 @@ import (
 type OrderRepository interface {
 	Create(ctx context.Context, order domain.Order) (domain.Order, error)
 	GetByID(ctx context.Context, id string) (domain.Order, error)
-	Update(ctx context.Context, order domain.Order) error
+	// GetByIdempotencyKey finds the order userID created with the idempotency key, along with
+	// the fingerprint of the request that created it
+	GetByIdempotencyKey(ctx context.Context, userID, key string) (domain.Order, error)
+	// Update stores the order and enqueues any outbox messages in the same transaction
+	Update(ctx context.Context, order domain.Order, messages ...domain.OutboxMessage) error
+	// UpdateItems locks the order, lets edit decide its new items and totals, and stores them
+	// with the status change and outbox messages edit returns in the same transaction. The
+	// order stays locked while edit runs, so it cannot be paid or cancelled meanwhile.
+	UpdateItems(ctx context.Context, orderID string, edit OrderEditFunc) error
 	Delete(ctx context.Context, id string) error
-	List(ctx context.Context, filter domain.OrderFilter) ([]domain.Order, int, error)
+	List(ctx context.Context, filter domain.OrderFilter) (domain.OrderPage, error)
 	GetUserOrders(ctx context.Context, userID string) ([]domain.Order, error)
 	// Add missing methods
 	ExistsByID(ctx context.Context, id string) (bool, error)
-	UpdateStatus(ctx context.Context, orderID string, status domain.OrderStatus) error
+	// UpdateStatus applies change if the order is still in change.FromStatus, recording it in the
+	// status history and enqueueing any outbox messages in the same transaction
+	UpdateStatus(ctx context.Context, change domain.OrderStatusChange, messages ...domain.OutboxMessage) error
+	GetStatusHistory(ctx context.Context, orderID string) ([]domain.OrderStatusChange, error)
 	GetOrdersByStatus(ctx context.Context, status domain.OrderStatus) ([]domain.Order, error)
+	// ExpireOrders claims up to limit orders that have been in status since before createdBefore
+	// and applies the change expire returns for each one in the same transaction. Orders claimed
+	// by another replica are skipped. Returns the IDs of the orders that were changed.
+	ExpireOrders(ctx context.Context, status domain.OrderStatus, createdBefore time.Time, limit int, expire ExpireOrderFunc) ([]string, error)
 	GetOrdersByDateRange(ctx context.Context, startDate, endDate time.Time) ([]domain.Order, error)
+	// CreateShipment locks the order, lets build decide the shipment and any status change from
+	// the order and the quantities already shipped, and stores both in the same transaction.
+	CreateShipment(ctx context.Context, orderID string, build ShipmentFunc) (domain.Shipment, error)
+	GetShipments(ctx context.Context, orderID string) ([]domain.Shipment, error)
 }
 
+// ExpireOrderFunc decides the status change and outbox messages for an expired order.
+type ExpireOrderFunc func(order domain.Order) (domain.OrderStatusChange, []domain.OutboxMessage, error)
+
+// OrderEditFunc decides the new items and totals of an order, the status change recording the
+// edit and the outbox messages announcing it.
+type OrderEditFunc func(order domain.Order) (domain.Order, domain.OrderStatusChange, []domain.OutboxMessage, error)
+
+// ShipmentFunc decides the shipment for an order given the quantity already shipped per order
+// item, and the status change it causes, if any.
+type ShipmentFunc func(order domain.Order, shipped map[string]int) (domain.Shipment, *domain.OrderStatusChange, error)
+
+var (
+	ErrOrderNotFound       = errors.New("order not found")
+	ErrTrackingNumberInUse = errors.New("tracking number is already used by another shipment")
+	ErrIdempotencyKeyUsed  = errors.New("idempotency key was already used for another order")
+	ErrOrderNotPending     = errors.New("order is no longer pending")
+	ErrOrderChanged        = errors.New("order was changed concurrently")
+)
+
+const orderColumns = `id, user_id, status, total, COALESCE(cancellation_reason, ''), COALESCE(coupon_code, ''), discount,
+	subtotal, tax, shipping, COALESCE(tax_region, ''), currency, exchange_rate, shipping_address,
+	CASE WHEN pickup_store_id IS NOT NULL THEN json_build_object('store_id', pickup_store_id, 'date', pickup_date) END,
+	created_at, updated_at`
+
 type PostgresOrderRepository struct {
 	db *sql.DB
 }
@@ -50,8 +94,10 @@ func (r *PostgresOrderRepository) Create(ctx context.Context, order domain.Order
 	}
 	defer tx.Rollback()
 
-	// Set order defaults
-	order.ID = uuid.New().String()
+	// Set order defaults, keeping an ID the caller already used to hold stock
+	if order.ID == "" {
+		order.ID = uuid.New().String()
+	}
 	order.CreatedAt = time.Now()
 	order.UpdatedAt = time.Now()
 
@@ -59,15 +105,36 @@ func (r *PostgresOrderRepository) Create(ctx context.Context, order domain.Order
 		order.Status = domain.OrderStatusPending
 	}
 
-	// Calculate total if not provided
-	if order.Total == 0 {
-		order.Total = r.calculateTotal(order.Items)
+	if order.Currency == "" {
+		order.Currency = domain.DefaultCurrency
+	}
+	if order.ExchangeRate <= 0 {
+		order.ExchangeRate = 1
+	}
+
+	// Fill in totals that were not provided; a coupon may legitimately bring the total down to zero
+	if order.Subtotal.IsZero() {
+		order.Subtotal = r.calculateTotal(order.Items)
+	}
+	if order.Total.IsZero() && order.Discount.IsZero() {
+		order.Total = order.Subtotal.Add(order.Tax).Add(order.Shipping)
+	}
+
+	// Take the pickup slot before the order so the store's capacity is checked under its lock
+	var pickupStoreID, pickupDate interface{}
+	if order.Pickup != nil {
+		if err = bookPickupSlot(ctx, tx, *order.Pickup); err != nil {
+			return domain.Order{}, err
+		}
+		pickupStoreID, pickupDate = order.Pickup.StoreID, order.Pickup.Date
 	}
 
 	// Insert order
 	orderQuery := `
-		INSERT INTO orders (id, user_id, status, total, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO orders (id, user_id, status, total, subtotal, coupon_code, discount, tax, shipping, tax_region,
+		                    currency, exchange_rate, shipping_address, pickup_store_id, pickup_date, idempotency_key,
+		                    idempotency_fingerprint, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)
 		RETURNING id, user_id, status, total, created_at, updated_at`
 
 	err = tx.QueryRowContext(
@@ -77,6 +144,19 @@ func (r *PostgresOrderRepository) Create(ctx context.Context, order domain.Order
 		order.UserID,
 		order.Status,
 		order.Total,
+		order.Subtotal,
+		nullString(order.CouponCode),
+		order.Discount,
+		order.Tax,
+		order.Shipping,
+		nullString(order.TaxRegion),
+		order.Currency,
+		order.ExchangeRate,
+		order.ShippingAddress,
+		pickupStoreID,
+		pickupDate,
+		nullString(order.IdempotencyKey),
+		nullString(order.IdempotencyFingerprint),
 		order.CreatedAt,
 		order.UpdatedAt,
 	).Scan(
@@ -88,8 +168,12 @@ func (r *PostgresOrderRepository) Create(ctx context.Context, order domain.Order
 		&order.UpdatedAt,
 	)
 	if err != nil {
+		if order.IdempotencyKey != "" && isUniqueViolation(err) {
+			return domain.Order{}, ErrIdempotencyKeyUsed
+		}
 		return domain.Order{}, errors.New("failed to create order")
 	}
+	order.SetCurrency(order.Currency)
 
 	// Insert order items
 	for i := range order.Items {
@@ -98,8 +182,8 @@ func (r *PostgresOrderRepository) Create(ctx context.Context, order domain.Order
 
 		itemQuery := `
 			INSERT INTO order_items (id, order_id, product_id, name, price, quantity, 
-			                         frame_size, wheel_size, color, bike_type)
-			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+			                         frame_size, wheel_size, color, bike_type, category_id, weight)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`
 
 		_, err = tx.ExecContext(
 			ctx,
@@ -114,12 +198,41 @@ func (r *PostgresOrderRepository) Create(ctx context.Context, order domain.Order
 			nullString(order.Items[i].WheelSize),
 			nullString(order.Items[i].Color),
 			nullString(order.Items[i].BikeType),
+			nullString(order.Items[i].CategoryID),
+			order.Items[i].Weight,
 		)
 		if err != nil {
 			return domain.Order{}, errors.New("failed to create order item")
 		}
 	}
 
+	if order.CouponCode != "" {
+		if err = redeemCoupon(ctx, tx, order); err != nil {
+			return domain.Order{}, err
+		}
+	}
+
+	// Record the order's first status
+	err = insertStatusChange(ctx, tx, domain.OrderStatusChange{
+		OrderID:   order.ID,
+		ToStatus:  order.Status,
+		ActorID:   order.UserID,
+		ActorRole: domain.ActorRoleUser,
+		CreatedAt: order.CreatedAt,
+	})
+	if err != nil {
+		return domain.Order{}, err
+	}
+
+	// Enqueue the created event so it is only published if the order is stored
+	createdEvent, err := events.OrderCreated(order)
+	if err != nil {
+		return domain.Order{}, err
+	}
+	if err = insertOutboxMessages(ctx, tx, createdEvent); err != nil {
+		return domain.Order{}, err
+	}
+
 	// Commit transaction
 	if err = tx.Commit(); err != nil {
 		return domain.Order{}, errors.New("failed to commit transaction")
@@ -135,7 +248,7 @@ func (r *PostgresOrderRepository) GetByID(ctx context.Context, id string) (domai
 
 	// Get order
 	orderQuery := `
-		SELECT id, user_id, status, total, created_at, updated_at
+		SELECT ` + orderColumns + `
 		FROM orders
 		WHERE id = $1`
 
@@ -145,12 +258,23 @@ func (r *PostgresOrderRepository) GetByID(ctx context.Context, id string) (domai
 		&order.UserID,
 		&order.Status,
 		&order.Total,
+		&order.CancellationReason,
+		&order.CouponCode,
+		&order.Discount,
+		&order.Subtotal,
+		&order.Tax,
+		&order.Shipping,
+		&order.TaxRegion,
+		&order.Currency,
+		&order.ExchangeRate,
+		&order.ShippingAddress,
+		&order.Pickup,
 		&order.CreatedAt,
 		&order.UpdatedAt,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return domain.Order{}, errors.New("order not found")
+			return domain.Order{}, ErrOrderNotFound
 		}
 		return domain.Order{}, errors.New("failed to get order")
 	}
@@ -162,10 +286,44 @@ func (r *PostgresOrderRepository) GetByID(ctx context.Context, id string) (domai
 	}
 
 	order.Items = items
+	order.SetCurrency(order.Currency)
+
+	shipments, err := r.GetShipments(ctx, id)
+	if err != nil {
+		return domain.Order{}, err
+	}
+
+	order.Shipments = shipments
 	return order, nil
 }
 
-func (r *PostgresOrderRepository) Update(ctx context.Context, order domain.Order) error {
+func (r *PostgresOrderRepository) GetByIdempotencyKey(ctx context.Context, userID, key string) (domain.Order, error) {
+	if userID == "" || key == "" {
+		return domain.Order{}, errors.New("user ID and idempotency key are required")
+	}
+
+	query := `SELECT id, COALESCE(idempotency_fingerprint, '') FROM orders WHERE user_id = $1 AND idempotency_key = $2`
+
+	var id, fingerprint string
+	err := r.db.QueryRowContext(ctx, query, userID, key).Scan(&id, &fingerprint)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.Order{}, ErrOrderNotFound
+		}
+		return domain.Order{}, errors.New("failed to get order")
+	}
+
+	order, err := r.GetByID(ctx, id)
+	if err != nil {
+		return domain.Order{}, err
+	}
+	order.IdempotencyKey = key
+	order.IdempotencyFingerprint = fingerprint
+
+	return order, nil
+}
+
+func (r *PostgresOrderRepository) Update(ctx context.Context, order domain.Order, messages ...domain.OutboxMessage) error {
 	if order.ID == "" {
 		return errors.New("order ID is required")
 	}
@@ -176,16 +334,23 @@ func (r *PostgresOrderRepository) Update(ctx context.Context, order domain.Order
 
 	order.UpdatedAt = time.Now()
 
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.New("failed to start transaction")
+	}
+	defer tx.Rollback()
+
 	query := `
 		UPDATE orders
-		SET status = $1, total = $2, updated_at = $3
-		WHERE id = $4`
+		SET status = $1, total = $2, cancellation_reason = $3, updated_at = $4
+		WHERE id = $5`
 
-	result, err := r.db.ExecContext(
+	result, err := tx.ExecContext(
 		ctx,
 		query,
 		order.Status,
 		order.Total,
+		nullString(order.CancellationReason),
 		order.UpdatedAt,
 		order.ID,
 	)
@@ -199,7 +364,123 @@ func (r *PostgresOrderRepository) Update(ctx context.Context, order domain.Order
 	}
 
 	if rowsAffected == 0 {
-		return errors.New("order not found")
+		return ErrOrderNotFound
+	}
+
+	if err = insertOutboxMessages(ctx, tx, messages...); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.New("failed to commit transaction")
+	}
+
+	return nil
+}
+
+func (r *PostgresOrderRepository) UpdateItems(ctx context.Context, orderID string, edit OrderEditFunc) error {
+	if orderID == "" {
+		return errors.New("order ID is required")
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.New("failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	// Lock the order so it cannot be paid or cancelled while its items change
+	order, err := r.lockOrder(ctx, tx, orderID)
+	if err != nil {
+		return err
+	}
+
+	order, change, messages, err := edit(order)
+	if err != nil {
+		return err
+	}
+
+	if err := r.validateOrder(order); err != nil {
+		return err
+	}
+
+	change.CreatedAt = time.Now()
+
+	query := `
+		UPDATE orders
+		SET total = $1, subtotal = $2, discount = $3, tax = $4, shipping = $5, updated_at = $6
+		WHERE id = $7`
+
+	_, err = tx.ExecContext(
+		ctx,
+		query,
+		order.Total,
+		order.Subtotal,
+		order.Discount,
+		order.Tax,
+		order.Shipping,
+		change.CreatedAt,
+		order.ID,
+	)
+	if err != nil {
+		return errors.New("failed to update order")
+	}
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM order_items WHERE order_id = $1`, order.ID); err != nil {
+		return errors.New("failed to remove order items")
+	}
+
+	for i := range order.Items {
+		order.Items[i].ID = uuid.New().String()
+		order.Items[i].OrderID = order.ID
+
+		itemQuery := `
+			INSERT INTO order_items (id, order_id, product_id, name, price, quantity,
+			                         frame_size, wheel_size, color, bike_type, category_id, weight)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`
+
+		_, err = tx.ExecContext(
+			ctx,
+			itemQuery,
+			order.Items[i].ID,
+			order.Items[i].OrderID,
+			order.Items[i].ProductID,
+			order.Items[i].Name,
+			order.Items[i].Price,
+			order.Items[i].Quantity,
+			nullString(order.Items[i].FrameSize),
+			nullString(order.Items[i].WheelSize),
+			nullString(order.Items[i].Color),
+			nullString(order.Items[i].BikeType),
+			nullString(order.Items[i].CategoryID),
+			order.Items[i].Weight,
+		)
+		if err != nil {
+			return errors.New("failed to create order item")
+		}
+	}
+
+	// Keep the coupon's redemption in line with the discount the order now gets
+	if order.CouponCode != "" {
+		_, err = tx.ExecContext(ctx,
+			`UPDATE coupon_redemptions SET discount = $1 WHERE order_id = $2`,
+			order.Discount, order.ID,
+		)
+		if err != nil {
+			return errors.New("failed to update coupon redemption")
+		}
+	}
+
+	if err = insertStatusChange(ctx, tx, change); err != nil {
+		return err
+	}
+
+	if err = insertOutboxMessages(ctx, tx, messages...); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.New("failed to commit transaction")
 	}
 
 	return nil
@@ -235,7 +516,7 @@ func (r *PostgresOrderRepository) Delete(ctx context.Context, id string) error {
 	}
 
 	if rowsAffected == 0 {
-		return errors.New("order not found")
+		return ErrOrderNotFound
 	}
 
 	// Commit transaction
@@ -246,9 +527,9 @@ func (r *PostgresOrderRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-func (r *PostgresOrderRepository) List(ctx context.Context, filter domain.OrderFilter) ([]domain.Order, int, error) {
+func (r *PostgresOrderRepository) List(ctx context.Context, filter domain.OrderFilter) (domain.OrderPage, error) {
 	baseQuery := `
-		SELECT id, user_id, status, total, created_at, updated_at
+		SELECT ` + orderColumns + `
 		FROM orders`
 
 	countQuery := `SELECT COUNT(*) FROM orders`
@@ -256,9 +537,25 @@ func (r *PostgresOrderRepository) List(ctx context.Context, filter domain.OrderF
 	whereClause, args := r.buildWhereClause(filter)
 
 	if whereClause != "" {
-		baseQuery += " WHERE " + whereClause
 		countQuery += " WHERE " + whereClause
 	}
+	countArgs := args
+
+	// Continue after the cursor rather than skipping rows, so deep pages stay cheap
+	if filter.After != nil {
+		cursorClause := fmt.Sprintf("(created_at, id) < ($%d, $%d)", len(args)+1, len(args)+2)
+		args = append(args, filter.After.CreatedAt, filter.After.ID)
+
+		if whereClause != "" {
+			whereClause += " AND " + cursorClause
+		} else {
+			whereClause = cursorClause
+		}
+	}
+
+	if whereClause != "" {
+		baseQuery += " WHERE " + whereClause
+	}
 
 	// Add pagination
 	limit := 10
@@ -268,17 +565,23 @@ func (r *PostgresOrderRepository) List(ctx context.Context, filter domain.OrderF
 		limit = filter.PageSize
 	}
 
-	if filter.Page > 0 {
+	if filter.Page > 0 && filter.After == nil {
 		offset = (filter.Page - 1) * limit
 	}
 
-	baseQuery += fmt.Sprintf(" ORDER BY created_at DESC LIMIT $%d OFFSET $%d", len(args)+1, len(args)+2)
-	args = append(args, limit, offset)
+	// One order more than the page holds tells whether there is a next page
+	baseQuery += fmt.Sprintf(" ORDER BY created_at DESC, id DESC LIMIT $%d", len(args)+1)
+	args = append(args, limit+1)
+
+	if offset > 0 {
+		baseQuery += fmt.Sprintf(" OFFSET $%d", len(args)+1)
+		args = append(args, offset)
+	}
 
 	// Execute main query
 	rows, err := r.db.QueryContext(ctx, baseQuery, args...)
 	if err != nil {
-		return nil, 0, errors.New("failed to list orders")
+		return domain.OrderPage{}, errors.New("failed to list orders")
 	}
 	defer rows.Close()
 
@@ -292,39 +595,64 @@ func (r *PostgresOrderRepository) List(ctx context.Context, filter domain.OrderF
 			&order.UserID,
 			&order.Status,
 			&order.Total,
+			&order.CancellationReason,
+			&order.CouponCode,
+			&order.Discount,
+			&order.Subtotal,
+			&order.Tax,
+			&order.Shipping,
+			&order.TaxRegion,
+			&order.Currency,
+			&order.ExchangeRate,
+			&order.ShippingAddress,
+			&order.Pickup,
 			&order.CreatedAt,
 			&order.UpdatedAt,
 		)
 		if err != nil {
-			return nil, 0, errors.New("failed to scan order")
+			return domain.OrderPage{}, errors.New("failed to scan order")
 		}
 
 		orders = append(orders, order)
 		orderIDs = append(orderIDs, order.ID)
 	}
 
-	// Get total count
+	var next *domain.OrderCursor
+	if len(orders) > limit {
+		orders, orderIDs = orders[:limit], orderIDs[:limit]
+		last := orders[limit-1]
+		next = &domain.OrderCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+
+	// Get total count, of all pages. Pages continuing from a cursor skip it, the caller
+	// already has it from the first page
 	var total int
-	countArgs := args[:len(args)-2] // Remove limit and offset
-	err = r.db.QueryRowContext(ctx, countQuery, countArgs...).Scan(&total)
-	if err != nil {
-		return nil, 0, errors.New("failed to get order count")
+	if filter.After == nil {
+		err = r.db.QueryRowContext(ctx, countQuery, countArgs...).Scan(&total)
+		if err != nil {
+			return domain.OrderPage{}, errors.New("failed to get order count")
+		}
 	}
 
 	// Load items for all orders
 	if len(orderIDs) > 0 {
 		itemsMap, err := r.getOrderItemsMap(ctx, orderIDs)
 		if err != nil {
-			return nil, 0, err
+			return domain.OrderPage{}, err
 		}
 
 		// Assign items to orders
 		for i := range orders {
 			orders[i].Items = itemsMap[orders[i].ID]
+			orders[i].SetCurrency(orders[i].Currency)
 		}
 	}
 
-	return orders, total, nil
+	return domain.OrderPage{
+		Orders: orders,
+		Total:  total,
+		Next:   next,
+	}, nil
 }
 
 func (r *PostgresOrderRepository) GetUserOrders(ctx context.Context, userID string) ([]domain.Order, error) {
@@ -337,8 +665,8 @@ func (r *PostgresOrderRepository) GetUserOrders(ctx context.Context, userID stri
 		PageSize: 100, // Get up to 100 orders
 	}
 
-	orders, _, err := r.List(ctx, filter)
-	return orders, err
+	page, err := r.List(ctx, filter)
+	return page.Orders, err
 }
 
 func (r *PostgresOrderRepository) ExistsByID(ctx context.Context, id string) (bool, error) {
@@ -357,38 +685,176 @@ func (r *PostgresOrderRepository) ExistsByID(ctx context.Context, id string) (bo
 	return exists, nil
 }
 
-func (r *PostgresOrderRepository) UpdateStatus(ctx context.Context, orderID string, status domain.OrderStatus) error {
-	if orderID == "" {
+func (r *PostgresOrderRepository) UpdateStatus(ctx context.Context, change domain.OrderStatusChange, messages ...domain.OutboxMessage) error {
+	if change.OrderID == "" {
 		return errors.New("order ID is required")
 	}
 
-	query := `UPDATE orders SET status = $1, updated_at = $2 WHERE id = $3`
-
-	result, err := r.db.ExecContext(ctx, query, status, time.Now(), orderID)
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		return errors.New("failed to update order status")
+		return errors.New("failed to start transaction")
 	}
+	defer tx.Rollback()
 
-	rowsAffected, err := result.RowsAffected()
+	updated, err := applyStatusChange(ctx, tx, change, messages...)
 	if err != nil {
-		return errors.New("failed to check update result")
+		return err
 	}
 
-	if rowsAffected == 0 {
-		return errors.New("order not found")
+	if !updated {
+		exists, err := r.ExistsByID(ctx, change.OrderID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return ErrOrderNotFound
+		}
+		return ErrOrderChanged
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.New("failed to commit transaction")
 	}
 
 	return nil
 }
 
+func (r *PostgresOrderRepository) GetStatusHistory(ctx context.Context, orderID string) ([]domain.OrderStatusChange, error) {
+	if orderID == "" {
+		return nil, errors.New("order ID is required")
+	}
+
+	query := `
+		SELECT id, order_id, COALESCE(from_status::text, ''), to_status, COALESCE(actor_id, ''),
+		       actor_role, COALESCE(reason, ''), created_at
+		FROM order_status_history
+		WHERE order_id = $1
+		ORDER BY created_at, id`
+
+	rows, err := r.db.QueryContext(ctx, query, orderID)
+	if err != nil {
+		return nil, errors.New("failed to get order status history")
+	}
+	defer rows.Close()
+
+	var changes []domain.OrderStatusChange
+	for rows.Next() {
+		var change domain.OrderStatusChange
+		err := rows.Scan(
+			&change.ID,
+			&change.OrderID,
+			&change.FromStatus,
+			&change.ToStatus,
+			&change.ActorID,
+			&change.ActorRole,
+			&change.Reason,
+			&change.CreatedAt,
+		)
+		if err != nil {
+			return nil, errors.New("failed to scan order status change")
+		}
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
 func (r *PostgresOrderRepository) GetOrdersByStatus(ctx context.Context, status domain.OrderStatus) ([]domain.Order, error) {
 	filter := domain.OrderFilter{
 		Status:   status,
 		PageSize: 100,
 	}
 
-	orders, _, err := r.List(ctx, filter)
-	return orders, err
+	page, err := r.List(ctx, filter)
+	return page.Orders, err
+}
+
+func (r *PostgresOrderRepository) ExpireOrders(ctx context.Context, status domain.OrderStatus, createdBefore time.Time, limit int, expire ExpireOrderFunc) ([]string, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.New("failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	// SKIP LOCKED lets several replicas expire orders at once without touching the same ones
+	query := `
+		SELECT ` + orderColumns + `
+		FROM orders
+		WHERE status = $1 AND created_at < $2
+		ORDER BY created_at
+		LIMIT $3
+		FOR UPDATE SKIP LOCKED`
+
+	rows, err := tx.QueryContext(ctx, query, status, createdBefore, limit)
+	if err != nil {
+		return nil, errors.New("failed to get expired orders")
+	}
+
+	var orders []domain.Order
+	var orderIDs []string
+	for rows.Next() {
+		var order domain.Order
+		err := rows.Scan(
+			&order.ID,
+			&order.UserID,
+			&order.Status,
+			&order.Total,
+			&order.CancellationReason,
+			&order.CouponCode,
+			&order.Discount,
+			&order.Subtotal,
+			&order.Tax,
+			&order.Shipping,
+			&order.TaxRegion,
+			&order.Currency,
+			&order.ExchangeRate,
+			&order.ShippingAddress,
+			&order.Pickup,
+			&order.CreatedAt,
+			&order.UpdatedAt,
+		)
+		if err != nil {
+			rows.Close()
+			return nil, errors.New("failed to scan order")
+		}
+
+		orders = append(orders, order)
+		orderIDs = append(orderIDs, order.ID)
+	}
+	rows.Close()
+
+	if len(orders) == 0 {
+		return nil, nil
+	}
+
+	itemsMap, err := r.getOrderItemsMap(ctx, orderIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var expired []string
+	for _, order := range orders {
+		order.Items = itemsMap[order.ID]
+		order.SetCurrency(order.Currency)
+
+		change, messages, err := expire(order)
+		if err != nil {
+			return nil, err
+		}
+
+		// The row is locked, so the status cannot have moved on since it was read
+		if _, err := applyStatusChange(ctx, tx, change, messages...); err != nil {
+			return nil, err
+		}
+
+		expired = append(expired, order.ID)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, errors.New("failed to commit transaction")
+	}
+
+	return expired, nil
 }
 
 func (r *PostgresOrderRepository) GetOrdersByDateRange(ctx context.Context, startDate, endDate time.Time) ([]domain.Order, error) {
@@ -398,19 +864,211 @@ func (r *PostgresOrderRepository) GetOrdersByDateRange(ctx context.Context, star
 		PageSize: 1000,
 	}
 
-	orders, _, err := r.List(ctx, filter)
-	return orders, err
+	page, err := r.List(ctx, filter)
+	return page.Orders, err
+}
+
+func (r *PostgresOrderRepository) CreateShipment(ctx context.Context, orderID string, build ShipmentFunc) (domain.Shipment, error) {
+	if orderID == "" {
+		return domain.Shipment{}, errors.New("order ID is required")
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.Shipment{}, errors.New("failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	// Lock the order so concurrent shipments cannot both ship the same items
+	order, err := r.lockOrder(ctx, tx, orderID)
+	if err != nil {
+		return domain.Shipment{}, err
+	}
+
+	shippedQuery := `
+		SELECT si.order_item_id, SUM(si.quantity)
+		FROM shipment_items si
+		JOIN shipments s ON s.id = si.shipment_id
+		WHERE s.order_id = $1
+		GROUP BY si.order_item_id`
+
+	rows, err := tx.QueryContext(ctx, shippedQuery, orderID)
+	if err != nil {
+		return domain.Shipment{}, errors.New("failed to get shipped quantities")
+	}
+
+	shipped := make(map[string]int)
+	for rows.Next() {
+		var orderItemID string
+		var quantity int
+		if err := rows.Scan(&orderItemID, &quantity); err != nil {
+			rows.Close()
+			return domain.Shipment{}, errors.New("failed to scan shipped quantity")
+		}
+		shipped[orderItemID] = quantity
+	}
+	rows.Close()
+
+	shipment, change, err := build(order, shipped)
+	if err != nil {
+		return domain.Shipment{}, err
+	}
+
+	shipment.ID = uuid.New().String()
+	shipment.OrderID = orderID
+	shipment.CreatedAt = time.Now()
+
+	shipmentQuery := `
+		INSERT INTO shipments (id, order_id, carrier, tracking_number, shipped_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`
+
+	_, err = tx.ExecContext(
+		ctx,
+		shipmentQuery,
+		shipment.ID,
+		shipment.OrderID,
+		shipment.Carrier,
+		shipment.TrackingNumber,
+		shipment.ShippedAt,
+		shipment.CreatedAt,
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return domain.Shipment{}, ErrTrackingNumberInUse
+		}
+		return domain.Shipment{}, errors.New("failed to create shipment")
+	}
+
+	itemQuery := `
+		INSERT INTO shipment_items (id, shipment_id, order_item_id, product_id, quantity)
+		VALUES ($1, $2, $3, $4, $5)`
+
+	for _, item := range shipment.Items {
+		_, err := tx.ExecContext(ctx, itemQuery, uuid.New().String(), shipment.ID, item.OrderItemID, item.ProductID, item.Quantity)
+		if err != nil {
+			return domain.Shipment{}, errors.New("failed to create shipment item")
+		}
+	}
+
+	if change != nil {
+		// The row is locked, so the status cannot have moved on since it was read
+		if _, err := applyStatusChange(ctx, tx, *change); err != nil {
+			return domain.Shipment{}, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return domain.Shipment{}, errors.New("failed to commit transaction")
+	}
+
+	return shipment, nil
+}
+
+func (r *PostgresOrderRepository) GetShipments(ctx context.Context, orderID string) ([]domain.Shipment, error) {
+	if orderID == "" {
+		return nil, errors.New("order ID is required")
+	}
+
+	query := `
+		SELECT s.id, s.order_id, s.carrier, s.tracking_number, s.shipped_at, s.created_at,
+		       si.order_item_id, si.product_id, si.quantity
+		FROM shipments s
+		JOIN shipment_items si ON si.shipment_id = s.id
+		WHERE s.order_id = $1
+		ORDER BY s.shipped_at, s.id, si.order_item_id`
+
+	rows, err := r.db.QueryContext(ctx, query, orderID)
+	if err != nil {
+		return nil, errors.New("failed to get shipments")
+	}
+	defer rows.Close()
+
+	var shipments []domain.Shipment
+	for rows.Next() {
+		var shipment domain.Shipment
+		var item domain.ShipmentItem
+		err := rows.Scan(
+			&shipment.ID,
+			&shipment.OrderID,
+			&shipment.Carrier,
+			&shipment.TrackingNumber,
+			&shipment.ShippedAt,
+			&shipment.CreatedAt,
+			&item.OrderItemID,
+			&item.ProductID,
+			&item.Quantity,
+		)
+		if err != nil {
+			return nil, errors.New("failed to scan shipment")
+		}
+
+		// Rows come grouped by shipment, so a new ID starts the next one
+		if n := len(shipments); n > 0 && shipments[n-1].ID == shipment.ID {
+			shipments[n-1].Items = append(shipments[n-1].Items, item)
+			continue
+		}
+
+		shipment.Items = []domain.ShipmentItem{item}
+		shipments = append(shipments, shipment)
+	}
+
+	return shipments, nil
 }
 
 // Helper methods
 
+// lockOrder loads the order with its items, locking its row until tx ends.
+func (r *PostgresOrderRepository) lockOrder(ctx context.Context, tx *sql.Tx, orderID string) (domain.Order, error) {
+	orderQuery := `
+		SELECT ` + orderColumns + `
+		FROM orders
+		WHERE id = $1
+		FOR UPDATE`
+
+	var order domain.Order
+	err := tx.QueryRowContext(ctx, orderQuery, orderID).Scan(
+		&order.ID,
+		&order.UserID,
+		&order.Status,
+		&order.Total,
+		&order.CancellationReason,
+		&order.CouponCode,
+		&order.Discount,
+		&order.Subtotal,
+		&order.Tax,
+		&order.Shipping,
+		&order.TaxRegion,
+		&order.Currency,
+		&order.ExchangeRate,
+		&order.ShippingAddress,
+		&order.Pickup,
+		&order.CreatedAt,
+		&order.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.Order{}, ErrOrderNotFound
+		}
+		return domain.Order{}, errors.New("failed to get order")
+	}
+
+	if order.Items, err = r.getOrderItems(ctx, orderID); err != nil {
+		return domain.Order{}, err
+	}
+	order.SetCurrency(order.Currency)
+
+	return order, nil
+}
+
 func (r *PostgresOrderRepository) getOrderItems(ctx context.Context, orderID string) ([]domain.OrderItem, error) {
 	itemsQuery := `
 		SELECT id, order_id, product_id, name, price, quantity, 
 		       COALESCE(frame_size, '') as frame_size,
 		       COALESCE(wheel_size, '') as wheel_size,
 		       COALESCE(color, '') as color,
-		       COALESCE(bike_type, '') as bike_type
+		       COALESCE(bike_type, '') as bike_type,
+		       COALESCE(category_id::text, '') as category_id,
+		       weight
 		FROM order_items
 		WHERE order_id = $1
 		ORDER BY id`
@@ -435,6 +1093,8 @@ func (r *PostgresOrderRepository) getOrderItems(ctx context.Context, orderID str
 			&item.WheelSize,
 			&item.Color,
 			&item.BikeType,
+			&item.CategoryID,
+			&item.Weight,
 		)
 		if err != nil {
 			return nil, errors.New("failed to scan order item")
@@ -463,7 +1123,9 @@ func (r *PostgresOrderRepository) getOrderItemsMap(ctx context.Context, orderIDs
 		       COALESCE(frame_size, '') as frame_size,
 		       COALESCE(wheel_size, '') as wheel_size,
 		       COALESCE(color, '') as color,
-		       COALESCE(bike_type, '') as bike_type
+		       COALESCE(bike_type, '') as bike_type,
+		       COALESCE(category_id::text, '') as category_id,
+		       weight
 		FROM order_items
 		WHERE order_id IN (%s)
 		ORDER BY order_id, id`, strings.Join(placeholders, ","))
@@ -488,6 +1150,8 @@ func (r *PostgresOrderRepository) getOrderItemsMap(ctx context.Context, orderIDs
 			&item.WheelSize,
 			&item.Color,
 			&item.BikeType,
+			&item.CategoryID,
+			&item.Weight,
 		)
 		if err != nil {
 			return nil, errors.New("failed to scan order item")
@@ -540,7 +1204,7 @@ func (r *PostgresOrderRepository) validateOrder(order domain.Order) error {
 		return errors.New("order must contain at least one item")
 	}
 
-	if order.Total < 0 {
+	if order.Total.IsNegative() {
 		return errors.New("order total cannot be negative")
 	}
 
@@ -552,7 +1216,7 @@ func (r *PostgresOrderRepository) validateOrder(order domain.Order) error {
 		if item.Name == "" {
 			return fmt.Errorf("product name is required for item %d", i+1)
 		}
-		if item.Price < 0 {
+		if item.Price.IsNegative() {
 			return fmt.Errorf("product price cannot be negative for item %d", i+1)
 		}
 		if item.Quantity <= 0 {
@@ -563,17 +1227,100 @@ func (r *PostgresOrderRepository) validateOrder(order domain.Order) error {
 	return nil
 }
 
-func (r *PostgresOrderRepository) calculateTotal(items []domain.OrderItem) float64 {
-	var total float64
+func (r *PostgresOrderRepository) calculateTotal(items []domain.OrderItem) domain.Money {
+	var total domain.Money
 	for _, item := range items {
-		total += item.Price * float64(item.Quantity)
+		total = total.Add(item.Price.Mul(item.Quantity))
 	}
 	return total
 }
 
+// applyStatusChange moves the order to change.ToStatus if it is still in change.FromStatus, and
+// still has change.ExpectedTotal if that is set, and records the change and messages. It
+// reports false if the order did not match.
+func applyStatusChange(ctx context.Context, tx *sql.Tx, change domain.OrderStatusChange, messages ...domain.OutboxMessage) (bool, error) {
+	// Only cancellations carry a reason on the order itself
+	var cancellationReason interface{}
+	if change.ToStatus == domain.OrderStatusCancelled {
+		cancellationReason = nullString(change.Reason)
+	}
+
+	var expectedTotal interface{}
+	if change.ExpectedTotal != nil {
+		expectedTotal = *change.ExpectedTotal
+	}
+
+	change.CreatedAt = time.Now()
+
+	query := `
+		UPDATE orders
+		SET status = $1, cancellation_reason = COALESCE($2, cancellation_reason), updated_at = $3
+		WHERE id = $4 AND status = $5 AND ($6::numeric IS NULL OR total = $6::numeric)`
+
+	result, err := tx.ExecContext(ctx, query, change.ToStatus, cancellationReason, change.CreatedAt, change.OrderID, change.FromStatus, expectedTotal)
+	if err != nil {
+		return false, errors.New("failed to update order status")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.New("failed to check update result")
+	}
+
+	if rowsAffected == 0 {
+		return false, nil
+	}
+
+	// A cancelled order no longer counts against its coupon's limits
+	if change.ToStatus == domain.OrderStatusCancelled {
+		if err = releaseCoupon(ctx, tx, change.OrderID, change.CreatedAt); err != nil {
+			return false, err
+		}
+	}
+
+	if err = insertStatusChange(ctx, tx, change); err != nil {
+		return false, err
+	}
+
+	if err = insertOutboxMessages(ctx, tx, messages...); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func insertStatusChange(ctx context.Context, tx *sql.Tx, change domain.OrderStatusChange) error {
+	query := `
+		INSERT INTO order_status_history (id, order_id, from_status, to_status, actor_id, actor_role, reason, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	_, err := tx.ExecContext(
+		ctx,
+		query,
+		uuid.New().String(),
+		change.OrderID,
+		nullString(string(change.FromStatus)),
+		change.ToStatus,
+		nullString(change.ActorID),
+		change.ActorRole,
+		nullString(change.Reason),
+		change.CreatedAt,
+	)
+	if err != nil {
+		return errors.New("failed to record order status change")
+	}
+
+	return nil
+}
+
 func nullString(s string) interface{} {
 	if s == "" {
 		return nil
 	}
 	return s
 }
+
+func isUniqueViolation(err error) bool {
+	// PostgreSQL specific - adjust for your database
+	return err != nil && strings.Contains(err.Error(), "duplicate key value violates unique constraint")
+}
@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"order-service/internal/domain"
+)
+
+// OutboxHandler delivers a single outbox message; a returned error schedules a retry.
+type OutboxHandler func(msg domain.OutboxMessage) error
+
+// OutboxBackoff returns how long to wait before retrying a message that has failed attempts times.
+type OutboxBackoff func(attempts int) time.Duration
+
+type OutboxRepository interface {
+	// ProcessPending locks up to limit due messages, hands each to handle and records the outcome.
+	// Rows locked by another replica are skipped, so several relays can run side by side.
+	ProcessPending(ctx context.Context, limit int, handle OutboxHandler, backoff OutboxBackoff) (int, error)
+}
+
+type PostgresOutboxRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresOutboxRepository(db *sql.DB) OutboxRepository {
+	return &PostgresOutboxRepository{
+		db: db,
+	}
+}
+
+func (r *PostgresOutboxRepository) ProcessPending(ctx context.Context, limit int, handle OutboxHandler, backoff OutboxBackoff) (int, error) {
+	if limit <= 0 {
+		return 0, errors.New("limit must be positive")
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, errors.New("failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	query := `
+		SELECT id, aggregate_id, subject, payload, attempts, COALESCE(last_error, ''), next_attempt_at, created_at
+		FROM outbox
+		WHERE sent_at IS NULL AND next_attempt_at <= NOW()
+		ORDER BY created_at
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED`
+
+	rows, err := tx.QueryContext(ctx, query, limit)
+	if err != nil {
+		return 0, errors.New("failed to fetch outbox messages")
+	}
+
+	var messages []domain.OutboxMessage
+	for rows.Next() {
+		var msg domain.OutboxMessage
+		err := rows.Scan(
+			&msg.ID,
+			&msg.AggregateID,
+			&msg.Subject,
+			&msg.Payload,
+			&msg.Attempts,
+			&msg.LastError,
+			&msg.NextAttemptAt,
+			&msg.CreatedAt,
+		)
+		if err != nil {
+			rows.Close()
+			return 0, errors.New("failed to scan outbox message")
+		}
+		messages = append(messages, msg)
+	}
+	rows.Close()
+
+	sent := 0
+	for _, msg := range messages {
+		if handleErr := handle(msg); handleErr != nil {
+			attempts := msg.Attempts + 1
+			_, err = tx.ExecContext(
+				ctx,
+				`UPDATE outbox SET attempts = $1, last_error = $2, next_attempt_at = $3 WHERE id = $4`,
+				attempts,
+				handleErr.Error(),
+				time.Now().Add(backoff(attempts)),
+				msg.ID,
+			)
+			if err != nil {
+				return 0, errors.New("failed to record outbox failure")
+			}
+			continue
+		}
+
+		_, err = tx.ExecContext(ctx, `UPDATE outbox SET sent_at = $1, last_error = NULL WHERE id = $2`, time.Now(), msg.ID)
+		if err != nil {
+			return 0, errors.New("failed to mark outbox message as sent")
+		}
+		sent++
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, errors.New("failed to commit transaction")
+	}
+
+	return sent, nil
+}
+
+func insertOutboxMessages(ctx context.Context, tx *sql.Tx, messages ...domain.OutboxMessage) error {
+	query := `
+		INSERT INTO outbox (id, aggregate_id, subject, payload, next_attempt_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $5)`
+
+	for _, msg := range messages {
+		_, err := tx.ExecContext(ctx, query, msg.ID, msg.AggregateID, msg.Subject, msg.Payload, time.Now())
+		if err != nil {
+			return errors.New("failed to enqueue outbox message")
+		}
+	}
+
+	return nil
+}
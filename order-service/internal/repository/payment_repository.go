@@ -0,0 +1,269 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"order-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// ErrPaymentChanged is returned when a payment was changed by someone else, e.g. refunded,
+// after it was read.
+var ErrPaymentChanged = errors.New("payment was changed concurrently")
+
+type PaymentRepository interface {
+	Create(ctx context.Context, payment domain.Payment) (domain.Payment, error)
+	GetByID(ctx context.Context, id string) (domain.Payment, error)
+	GetByOrderID(ctx context.Context, orderID string) ([]domain.Payment, error)
+	Update(ctx context.Context, payment domain.Payment) error
+	// ClaimRefund marks payment refunding if its status and refunded amount are still as read,
+	// so only one caller goes on to refund it. It fails with ErrPaymentChanged otherwise.
+	ClaimRefund(ctx context.Context, payment domain.Payment) error
+	// CompleteRefund marks a refunding payment refunded in full.
+	CompleteRefund(ctx context.Context, id string) error
+	// ReleaseRefund puts a refunding payment whose refund did not go through into status.
+	ReleaseRefund(ctx context.Context, id string, status domain.PaymentStatus) error
+}
+
+type PostgresPaymentRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresPaymentRepository(db *sql.DB) PaymentRepository {
+	return &PostgresPaymentRepository{
+		db: db,
+	}
+}
+
+func (r *PostgresPaymentRepository) Create(ctx context.Context, payment domain.Payment) (domain.Payment, error) {
+	if payment.OrderID == "" {
+		return domain.Payment{}, errors.New("order ID is required")
+	}
+
+	if payment.Method == "" {
+		return domain.Payment{}, errors.New("payment method is required")
+	}
+
+	if payment.Amount.IsNegative() {
+		return domain.Payment{}, errors.New("payment amount cannot be negative")
+	}
+
+	payment.ID = uuid.New().String()
+	payment.CreatedAt = time.Now()
+	payment.UpdatedAt = time.Now()
+
+	if payment.Status == "" {
+		payment.Status = domain.PaymentStatusPending
+	}
+
+	if payment.Amount.Currency == "" {
+		payment.Amount.Currency = domain.DefaultCurrency
+	}
+	payment.RefundedAmount.Currency = payment.Amount.Currency
+
+	query := `
+		INSERT INTO payments (id, order_id, amount, currency, status, method, transaction_id, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+
+	_, err := r.db.ExecContext(
+		ctx,
+		query,
+		payment.ID,
+		payment.OrderID,
+		payment.Amount,
+		payment.Amount.Currency,
+		payment.Status,
+		payment.Method,
+		nullString(payment.TransactionID),
+		payment.CreatedAt,
+		payment.UpdatedAt,
+	)
+	if err != nil {
+		return domain.Payment{}, errors.New("failed to create payment")
+	}
+
+	return payment, nil
+}
+
+func (r *PostgresPaymentRepository) GetByID(ctx context.Context, id string) (domain.Payment, error) {
+	if id == "" {
+		return domain.Payment{}, errors.New("payment ID is required")
+	}
+
+	query := `
+		SELECT id, order_id, amount, refunded_amount, currency, status, method,
+		       COALESCE(transaction_id, '') as transaction_id,
+		       created_at, updated_at
+		FROM payments
+		WHERE id = $1`
+
+	var payment domain.Payment
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&payment.ID,
+		&payment.OrderID,
+		&payment.Amount,
+		&payment.RefundedAmount,
+		&payment.Amount.Currency,
+		&payment.Status,
+		&payment.Method,
+		&payment.TransactionID,
+		&payment.CreatedAt,
+		&payment.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.Payment{}, errors.New("payment not found")
+		}
+		return domain.Payment{}, errors.New("failed to get payment")
+	}
+	payment.RefundedAmount.Currency = payment.Amount.Currency
+
+	return payment, nil
+}
+
+func (r *PostgresPaymentRepository) GetByOrderID(ctx context.Context, orderID string) ([]domain.Payment, error) {
+	if orderID == "" {
+		return nil, errors.New("order ID is required")
+	}
+
+	query := `
+		SELECT id, order_id, amount, refunded_amount, currency, status, method,
+		       COALESCE(transaction_id, '') as transaction_id,
+		       created_at, updated_at
+		FROM payments
+		WHERE order_id = $1
+		ORDER BY created_at`
+
+	rows, err := r.db.QueryContext(ctx, query, orderID)
+	if err != nil {
+		return nil, errors.New("failed to get payments")
+	}
+	defer rows.Close()
+
+	var payments []domain.Payment
+	for rows.Next() {
+		var payment domain.Payment
+		err := rows.Scan(
+			&payment.ID,
+			&payment.OrderID,
+			&payment.Amount,
+			&payment.RefundedAmount,
+			&payment.Amount.Currency,
+			&payment.Status,
+			&payment.Method,
+			&payment.TransactionID,
+			&payment.CreatedAt,
+			&payment.UpdatedAt,
+		)
+		if err != nil {
+			return nil, errors.New("failed to scan payment")
+		}
+		payment.RefundedAmount.Currency = payment.Amount.Currency
+		payments = append(payments, payment)
+	}
+
+	return payments, nil
+}
+
+func (r *PostgresPaymentRepository) Update(ctx context.Context, payment domain.Payment) error {
+	if payment.ID == "" {
+		return errors.New("payment ID is required")
+	}
+
+	payment.UpdatedAt = time.Now()
+
+	query := `
+		UPDATE payments
+		SET status = $1, transaction_id = $2, refunded_amount = $3, updated_at = $4
+		WHERE id = $5`
+
+	result, err := r.db.ExecContext(
+		ctx,
+		query,
+		payment.Status,
+		nullString(payment.TransactionID),
+		payment.RefundedAmount,
+		payment.UpdatedAt,
+		payment.ID,
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return errors.New("order already has a completed payment")
+		}
+		return errors.New("failed to update payment")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.New("failed to check update result")
+	}
+
+	if rowsAffected == 0 {
+		return errors.New("payment not found")
+	}
+
+	return nil
+}
+
+func (r *PostgresPaymentRepository) ClaimRefund(ctx context.Context, payment domain.Payment) error {
+	if payment.ID == "" {
+		return errors.New("payment ID is required")
+	}
+
+	query := `
+		UPDATE payments
+		SET status = $1, updated_at = $2
+		WHERE id = $3 AND status = $4 AND refunded_amount = $5`
+
+	result, err := r.db.ExecContext(ctx, query, domain.PaymentStatusRefunding, time.Now(), payment.ID, payment.Status, payment.RefundedAmount)
+	if err != nil {
+		return errors.New("failed to claim refund")
+	}
+
+	return checkPaymentChanged(result)
+}
+
+func (r *PostgresPaymentRepository) CompleteRefund(ctx context.Context, id string) error {
+	query := `
+		UPDATE payments
+		SET status = $1, refunded_amount = amount, updated_at = $2
+		WHERE id = $3 AND status = $4`
+
+	result, err := r.db.ExecContext(ctx, query, domain.PaymentStatusRefunded, time.Now(), id, domain.PaymentStatusRefunding)
+	if err != nil {
+		return errors.New("failed to complete refund")
+	}
+
+	return checkPaymentChanged(result)
+}
+
+func (r *PostgresPaymentRepository) ReleaseRefund(ctx context.Context, id string, status domain.PaymentStatus) error {
+	query := `
+		UPDATE payments
+		SET status = $1, updated_at = $2
+		WHERE id = $3 AND status = $4`
+
+	result, err := r.db.ExecContext(ctx, query, status, time.Now(), id, domain.PaymentStatusRefunding)
+	if err != nil {
+		return errors.New("failed to release refund")
+	}
+
+	return checkPaymentChanged(result)
+}
+
+func checkPaymentChanged(result sql.Result) error {
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.New("failed to check update result")
+	}
+
+	if rowsAffected == 0 {
+		return ErrPaymentChanged
+	}
+
+	return nil
+}
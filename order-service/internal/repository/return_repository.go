@@ -0,0 +1,489 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"order-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+type ReturnRepository interface {
+	// Create locks the order, lets build decide the return from the order and the quantity of
+	// each order item already being returned, and stores it.
+	Create(ctx context.Context, orderID string, build ReturnFunc) (domain.Return, error)
+	GetByID(ctx context.Context, id string) (domain.Return, error)
+	GetByOrderID(ctx context.Context, orderID string) ([]domain.Return, error)
+	List(ctx context.Context, filter domain.ReturnFilter) ([]domain.Return, int, error)
+	// UpdateStatus stores ret if it is still in from, enqueueing any outbox messages in the same
+	// transaction
+	UpdateStatus(ctx context.Context, ret domain.Return, from domain.ReturnStatus, messages ...domain.OutboxMessage) error
+	// ReserveRefund moves a received return to refunding and adds its refund amount to the
+	// payment in the same transaction, before the provider is asked for the money
+	ReserveRefund(ctx context.Context, ret domain.Return) error
+	// ReleaseRefund puts a refunding return back to received and takes its refund amount off
+	// the payment again, for refunds the provider refused
+	ReleaseRefund(ctx context.Context, ret domain.Return) error
+}
+
+// ReturnFunc decides the return for an order given the quantity of each order item already
+// being returned.
+type ReturnFunc func(order domain.Order, returned map[string]int) (domain.Return, error)
+
+type PostgresReturnRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresReturnRepository(db *sql.DB) ReturnRepository {
+	return &PostgresReturnRepository{
+		db: db,
+	}
+}
+
+const returnColumns = `id, order_id, user_id, status, reason, COALESCE(resolution_note, ''), restocked,
+		       refund_amount, currency, COALESCE(payment_id::text, ''), created_at, updated_at`
+
+func (r *PostgresReturnRepository) Create(ctx context.Context, orderID string, build ReturnFunc) (domain.Return, error) {
+	if orderID == "" {
+		return domain.Return{}, errors.New("order ID is required")
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.Return{}, errors.New("failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	// Lock the order so concurrent requests cannot both return the same items
+	orderQuery := `
+		SELECT ` + orderColumns + `
+		FROM orders
+		WHERE id = $1
+		FOR UPDATE`
+
+	var order domain.Order
+	err = tx.QueryRowContext(ctx, orderQuery, orderID).Scan(
+		&order.ID,
+		&order.UserID,
+		&order.Status,
+		&order.Total,
+		&order.CancellationReason,
+		&order.CouponCode,
+		&order.Discount,
+		&order.Subtotal,
+		&order.Tax,
+		&order.Shipping,
+		&order.TaxRegion,
+		&order.Currency,
+		&order.ExchangeRate,
+		&order.ShippingAddress,
+		&order.Pickup,
+		&order.CreatedAt,
+		&order.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.Return{}, ErrOrderNotFound
+		}
+		return domain.Return{}, errors.New("failed to get order")
+	}
+
+	// Order items never change once placed, so they need not be read under the lock
+	orders := &PostgresOrderRepository{db: r.db}
+	if order.Items, err = orders.getOrderItems(ctx, orderID); err != nil {
+		return domain.Return{}, err
+	}
+	order.SetCurrency(order.Currency)
+
+	// Rejected returns give their items back to the customer
+	returnedQuery := `
+		SELECT ri.order_item_id, SUM(ri.quantity)
+		FROM order_return_items ri
+		JOIN order_returns r ON r.id = ri.return_id
+		WHERE r.order_id = $1 AND r.status <> $2
+		GROUP BY ri.order_item_id`
+
+	rows, err := tx.QueryContext(ctx, returnedQuery, orderID, domain.ReturnStatusRejected)
+	if err != nil {
+		return domain.Return{}, errors.New("failed to get returned quantities")
+	}
+
+	returned := make(map[string]int)
+	for rows.Next() {
+		var orderItemID string
+		var quantity int
+		if err := rows.Scan(&orderItemID, &quantity); err != nil {
+			rows.Close()
+			return domain.Return{}, errors.New("failed to scan returned quantity")
+		}
+		returned[orderItemID] = quantity
+	}
+	rows.Close()
+
+	ret, err := build(order, returned)
+	if err != nil {
+		return domain.Return{}, err
+	}
+
+	ret.ID = uuid.New().String()
+	ret.OrderID = order.ID
+	ret.UserID = order.UserID
+	ret.Status = domain.ReturnStatusRequested
+	ret.CreatedAt = time.Now()
+	ret.UpdatedAt = ret.CreatedAt
+
+	returnQuery := `
+		INSERT INTO order_returns (id, order_id, user_id, status, reason, currency, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	_, err = tx.ExecContext(ctx, returnQuery, ret.ID, ret.OrderID, ret.UserID, ret.Status, ret.Reason, order.Currency, ret.CreatedAt, ret.UpdatedAt)
+	if err != nil {
+		return domain.Return{}, errors.New("failed to create return")
+	}
+
+	itemQuery := `
+		INSERT INTO order_return_items (id, return_id, order_item_id, product_id, quantity, price, discount, tax)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	for _, item := range ret.Items {
+		_, err := tx.ExecContext(ctx, itemQuery, uuid.New().String(), ret.ID, item.OrderItemID, item.ProductID,
+			item.Quantity, item.Price, item.Discount, item.Tax)
+		if err != nil {
+			return domain.Return{}, errors.New("failed to create return item")
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return domain.Return{}, errors.New("failed to commit transaction")
+	}
+
+	return ret, nil
+}
+
+func (r *PostgresReturnRepository) GetByID(ctx context.Context, id string) (domain.Return, error) {
+	if id == "" {
+		return domain.Return{}, errors.New("return ID is required")
+	}
+
+	query := `SELECT ` + returnColumns + ` FROM order_returns WHERE id = $1`
+
+	rows, err := r.db.QueryContext(ctx, query, id)
+	if err != nil {
+		return domain.Return{}, errors.New("failed to get return")
+	}
+
+	returns, err := r.scanReturns(ctx, rows)
+	if err != nil {
+		return domain.Return{}, err
+	}
+
+	if len(returns) == 0 {
+		return domain.Return{}, errors.New("return not found")
+	}
+
+	return returns[0], nil
+}
+
+func (r *PostgresReturnRepository) GetByOrderID(ctx context.Context, orderID string) ([]domain.Return, error) {
+	if orderID == "" {
+		return nil, errors.New("order ID is required")
+	}
+
+	query := `SELECT ` + returnColumns + ` FROM order_returns WHERE order_id = $1 ORDER BY created_at`
+
+	rows, err := r.db.QueryContext(ctx, query, orderID)
+	if err != nil {
+		return nil, errors.New("failed to get returns")
+	}
+
+	return r.scanReturns(ctx, rows)
+}
+
+func (r *PostgresReturnRepository) List(ctx context.Context, filter domain.ReturnFilter) ([]domain.Return, int, error) {
+	whereClause := ""
+	var args []interface{}
+	if filter.Status != "" {
+		whereClause = " WHERE status = $1"
+		args = append(args, filter.Status)
+	}
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM order_returns`+whereClause, args...).Scan(&total); err != nil {
+		return nil, 0, errors.New("failed to get return count")
+	}
+
+	limit := 10
+	offset := 0
+
+	if filter.PageSize > 0 {
+		limit = filter.PageSize
+	}
+
+	if filter.Page > 0 {
+		offset = (filter.Page - 1) * limit
+	}
+
+	// Oldest first, so the queue is worked through in the order customers asked
+	query := `SELECT ` + returnColumns + ` FROM order_returns` + whereClause +
+		fmt.Sprintf(" ORDER BY created_at LIMIT $%d OFFSET $%d", len(args)+1, len(args)+2)
+	args = append(args, limit, offset)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, errors.New("failed to list returns")
+	}
+
+	returns, err := r.scanReturns(ctx, rows)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return returns, total, nil
+}
+
+func (r *PostgresReturnRepository) UpdateStatus(ctx context.Context, ret domain.Return, from domain.ReturnStatus, messages ...domain.OutboxMessage) error {
+	if ret.ID == "" {
+		return errors.New("return ID is required")
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.New("failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	if err := updateReturn(ctx, tx, ret, from); err != nil {
+		return err
+	}
+
+	if err := insertOutboxMessages(ctx, tx, messages...); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.New("failed to commit transaction")
+	}
+
+	return nil
+}
+
+func (r *PostgresReturnRepository) ReserveRefund(ctx context.Context, ret domain.Return) error {
+	if ret.ID == "" {
+		return errors.New("return ID is required")
+	}
+
+	if ret.PaymentID == "" {
+		return errors.New("payment ID is required")
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.New("failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	// The payment is marked refunded once everything paid has been given back
+	paymentQuery := `
+		UPDATE payments
+		SET refunded_amount = refunded_amount + $1,
+		    status = CASE WHEN refunded_amount + $1 >= amount THEN $2::payment_status ELSE status END,
+		    updated_at = $3
+		WHERE id = $4 AND status = $5 AND refunded_amount + $1 <= amount`
+
+	result, err := tx.ExecContext(ctx, paymentQuery, ret.RefundAmount, domain.PaymentStatusRefunded, ret.UpdatedAt, ret.PaymentID, domain.PaymentStatusCompleted)
+	if err != nil {
+		return errors.New("failed to record refund on payment")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.New("failed to check update result")
+	}
+
+	if rowsAffected == 0 {
+		return errors.New("refund exceeds what is left of the payment")
+	}
+
+	if err := updateReturn(ctx, tx, ret, domain.ReturnStatusReceived); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.New("failed to commit transaction")
+	}
+
+	return nil
+}
+
+func (r *PostgresReturnRepository) ReleaseRefund(ctx context.Context, ret domain.Return) error {
+	if ret.ID == "" {
+		return errors.New("return ID is required")
+	}
+
+	if ret.PaymentID == "" {
+		return errors.New("payment ID is required")
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.New("failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	// A payment refunded in full by this return is completed again. One whose own refund is in
+	// flight is left for that refund to finish first.
+	paymentQuery := `
+		UPDATE payments
+		SET refunded_amount = refunded_amount - $1,
+		    status = CASE WHEN status = $2 THEN $3::payment_status ELSE status END,
+		    updated_at = $4
+		WHERE id = $5 AND status <> $6 AND refunded_amount >= $1`
+
+	result, err := tx.ExecContext(ctx, paymentQuery, ret.RefundAmount, domain.PaymentStatusRefunded,
+		domain.PaymentStatusCompleted, ret.UpdatedAt, ret.PaymentID, domain.PaymentStatusRefunding)
+	if err != nil {
+		return errors.New("failed to release refund on payment")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.New("failed to check update result")
+	}
+
+	if rowsAffected == 0 {
+		return ErrPaymentChanged
+	}
+
+	ret.Status = domain.ReturnStatusReceived
+	ret.RefundAmount = domain.Money{}
+	ret.PaymentID = ""
+	if err := updateReturn(ctx, tx, ret, domain.ReturnStatusRefunding); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.New("failed to commit transaction")
+	}
+
+	return nil
+}
+
+// updateReturn stores ret's status and resolution if it is still in from.
+func updateReturn(ctx context.Context, tx *sql.Tx, ret domain.Return, from domain.ReturnStatus) error {
+	query := `
+		UPDATE order_returns
+		SET status = $1, resolution_note = $2, restocked = $3, refund_amount = $4, payment_id = $5, updated_at = $6
+		WHERE id = $7 AND status = $8`
+
+	result, err := tx.ExecContext(
+		ctx,
+		query,
+		ret.Status,
+		nullString(ret.ResolutionNote),
+		ret.Restocked,
+		ret.RefundAmount,
+		nullString(ret.PaymentID),
+		ret.UpdatedAt,
+		ret.ID,
+		from,
+	)
+	if err != nil {
+		return errors.New("failed to update return")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.New("failed to check update result")
+	}
+
+	if rowsAffected == 0 {
+		return errors.New("return status was changed concurrently")
+	}
+
+	return nil
+}
+
+// scanReturns reads returns from rows and loads their items.
+func (r *PostgresReturnRepository) scanReturns(ctx context.Context, rows *sql.Rows) ([]domain.Return, error) {
+	var returns []domain.Return
+	var returnIDs []string
+	currencies := make(map[string]string)
+
+	for rows.Next() {
+		var ret domain.Return
+		var currency string
+		err := rows.Scan(
+			&ret.ID,
+			&ret.OrderID,
+			&ret.UserID,
+			&ret.Status,
+			&ret.Reason,
+			&ret.ResolutionNote,
+			&ret.Restocked,
+			&ret.RefundAmount,
+			&currency,
+			&ret.PaymentID,
+			&ret.CreatedAt,
+			&ret.UpdatedAt,
+		)
+		if err != nil {
+			rows.Close()
+			return nil, errors.New("failed to scan return")
+		}
+
+		// Amounts are stored without a currency; returns are in the currency of their order
+		ret.RefundAmount.Currency = currency
+		currencies[ret.ID] = currency
+
+		returns = append(returns, ret)
+		returnIDs = append(returnIDs, ret.ID)
+	}
+	rows.Close()
+
+	if len(returns) == 0 {
+		return nil, nil
+	}
+
+	placeholders := make([]string, len(returnIDs))
+	args := make([]interface{}, len(returnIDs))
+	for i, id := range returnIDs {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = id
+	}
+
+	itemsQuery := fmt.Sprintf(`
+		SELECT return_id, order_item_id, product_id, quantity, price, discount, tax
+		FROM order_return_items
+		WHERE return_id IN (%s)
+		ORDER BY return_id, order_item_id`, strings.Join(placeholders, ", "))
+
+	itemRows, err := r.db.QueryContext(ctx, itemsQuery, args...)
+	if err != nil {
+		return nil, errors.New("failed to get return items")
+	}
+	defer itemRows.Close()
+
+	itemsMap := make(map[string][]domain.ReturnItem)
+	for itemRows.Next() {
+		var returnID string
+		var item domain.ReturnItem
+		if err := itemRows.Scan(&returnID, &item.OrderItemID, &item.ProductID, &item.Quantity, &item.Price, &item.Discount, &item.Tax); err != nil {
+			return nil, errors.New("failed to scan return item")
+		}
+		item.Price.Currency = currencies[returnID]
+		item.Discount.Currency = currencies[returnID]
+		item.Tax.Currency = currencies[returnID]
+		itemsMap[returnID] = append(itemsMap[returnID], item)
+	}
+
+	for i := range returns {
+		returns[i].Items = itemsMap[returns[i].ID]
+	}
+
+	return returns, nil
+}
@@ -0,0 +1,332 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"order-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrStoreNotFound  = errors.New("store not found")
+	ErrPickupSlotFull = errors.New("pickup slot is fully booked")
+)
+
+type StoreRepository interface {
+	Create(ctx context.Context, store domain.Store) (domain.Store, error)
+	GetByID(ctx context.Context, id string) (domain.Store, error)
+	// List returns the stores by name, only the active ones if activeOnly is set
+	List(ctx context.Context, activeOnly bool) ([]domain.Store, error)
+	Update(ctx context.Context, store domain.Store) (domain.Store, error)
+	// GetPickupSlots returns the store's slots for every day from from to to, both inclusive
+	GetPickupSlots(ctx context.Context, storeID, from, to string) ([]domain.PickupSlot, error)
+	// ListPickups returns the orders not cancelled that are picked up on date, per active
+	// store, or only at storeID if it is set
+	ListPickups(ctx context.Context, date, storeID string) ([]domain.StorePickups, error)
+}
+
+type PostgresStoreRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresStoreRepository(db *sql.DB) StoreRepository {
+	return &PostgresStoreRepository{
+		db: db,
+	}
+}
+
+const storeColumns = `id, name, address, daily_capacity, active, created_at, updated_at`
+
+func (r *PostgresStoreRepository) Create(ctx context.Context, store domain.Store) (domain.Store, error) {
+	store.ID = uuid.New().String()
+	store.CreatedAt = time.Now()
+	store.UpdatedAt = store.CreatedAt
+
+	query := `
+		INSERT INTO stores (id, name, address, daily_capacity, active, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	_, err := r.db.ExecContext(
+		ctx,
+		query,
+		store.ID,
+		store.Name,
+		store.Address,
+		store.DailyCapacity,
+		store.Active,
+		store.CreatedAt,
+		store.UpdatedAt,
+	)
+	if err != nil {
+		return domain.Store{}, errors.New("failed to create store")
+	}
+
+	return store, nil
+}
+
+func (r *PostgresStoreRepository) GetByID(ctx context.Context, id string) (domain.Store, error) {
+	if id == "" {
+		return domain.Store{}, errors.New("store ID is required")
+	}
+
+	query := `SELECT ` + storeColumns + ` FROM stores WHERE id = $1`
+
+	return scanStore(r.db.QueryRowContext(ctx, query, id))
+}
+
+func (r *PostgresStoreRepository) List(ctx context.Context, activeOnly bool) ([]domain.Store, error) {
+	query := `SELECT ` + storeColumns + ` FROM stores`
+	if activeOnly {
+		query += ` WHERE active`
+	}
+	query += ` ORDER BY name`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, errors.New("failed to list stores")
+	}
+	defer rows.Close()
+
+	var stores []domain.Store
+	for rows.Next() {
+		store, err := scanStore(rows)
+		if err != nil {
+			return nil, err
+		}
+		stores = append(stores, store)
+	}
+
+	return stores, nil
+}
+
+func (r *PostgresStoreRepository) Update(ctx context.Context, store domain.Store) (domain.Store, error) {
+	if store.ID == "" {
+		return domain.Store{}, errors.New("store ID is required")
+	}
+
+	store.UpdatedAt = time.Now()
+
+	query := `
+		UPDATE stores
+		SET name = $1, address = $2, daily_capacity = $3, active = $4, updated_at = $5
+		WHERE id = $6
+		RETURNING created_at`
+
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		store.Name,
+		store.Address,
+		store.DailyCapacity,
+		store.Active,
+		store.UpdatedAt,
+		store.ID,
+	).Scan(&store.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.Store{}, ErrStoreNotFound
+		}
+		return domain.Store{}, errors.New("failed to update store")
+	}
+
+	return store, nil
+}
+
+func (r *PostgresStoreRepository) GetPickupSlots(ctx context.Context, storeID, from, to string) ([]domain.PickupSlot, error) {
+	if storeID == "" {
+		return nil, errors.New("store ID is required")
+	}
+
+	query := `
+		SELECT to_char(day, 'YYYY-MM-DD'), s.daily_capacity, COUNT(o.id)
+		FROM stores s
+		CROSS JOIN generate_series($2::date, $3::date, interval '1 day') AS day
+		LEFT JOIN orders o ON o.pickup_store_id = s.id AND o.pickup_date = day::date AND o.status <> 'cancelled'
+		WHERE s.id = $1
+		GROUP BY day, s.daily_capacity
+		ORDER BY day`
+
+	rows, err := r.db.QueryContext(ctx, query, storeID, from, to)
+	if err != nil {
+		return nil, errors.New("failed to get pickup slots")
+	}
+	defer rows.Close()
+
+	var slots []domain.PickupSlot
+	for rows.Next() {
+		slot := domain.PickupSlot{StoreID: storeID}
+		if err := rows.Scan(&slot.Date, &slot.Capacity, &slot.Booked); err != nil {
+			return nil, errors.New("failed to scan pickup slot")
+		}
+		slots = append(slots, slot)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.New("failed to get pickup slots")
+	}
+
+	return slots, nil
+}
+
+func (r *PostgresStoreRepository) ListPickups(ctx context.Context, date, storeID string) ([]domain.StorePickups, error) {
+	var stores []domain.Store
+	if storeID != "" {
+		store, err := r.GetByID(ctx, storeID)
+		if err != nil {
+			return nil, err
+		}
+		stores = []domain.Store{store}
+	} else {
+		var err error
+		if stores, err = r.List(ctx, true); err != nil {
+			return nil, err
+		}
+	}
+
+	query := `
+		SELECT ` + orderColumns + `
+		FROM orders
+		WHERE pickup_date = $1 AND status <> 'cancelled'`
+	args := []interface{}{date}
+	if storeID != "" {
+		query += ` AND pickup_store_id = $2`
+		args = append(args, storeID)
+	}
+	query += ` ORDER BY created_at`
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.New("failed to list pickups")
+	}
+	defer rows.Close()
+
+	var orders []domain.Order
+	var orderIDs []string
+
+	for rows.Next() {
+		var order domain.Order
+		err := rows.Scan(
+			&order.ID,
+			&order.UserID,
+			&order.Status,
+			&order.Total,
+			&order.CancellationReason,
+			&order.CouponCode,
+			&order.Discount,
+			&order.Subtotal,
+			&order.Tax,
+			&order.Shipping,
+			&order.TaxRegion,
+			&order.Currency,
+			&order.ExchangeRate,
+			&order.ShippingAddress,
+			&order.Pickup,
+			&order.CreatedAt,
+			&order.UpdatedAt,
+		)
+		if err != nil {
+			return nil, errors.New("failed to scan order")
+		}
+
+		orders = append(orders, order)
+		orderIDs = append(orderIDs, order.ID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.New("failed to list pickups")
+	}
+
+	if len(orderIDs) > 0 {
+		itemsMap, err := (&PostgresOrderRepository{db: r.db}).getOrderItemsMap(ctx, orderIDs)
+		if err != nil {
+			return nil, err
+		}
+		for i := range orders {
+			orders[i].Items = itemsMap[orders[i].ID]
+			orders[i].SetCurrency(orders[i].Currency)
+		}
+	}
+
+	ordersByStore := make(map[string][]domain.Order)
+	for _, order := range orders {
+		ordersByStore[order.Pickup.StoreID] = append(ordersByStore[order.Pickup.StoreID], order)
+	}
+
+	pickups := make([]domain.StorePickups, 0, len(stores))
+	for _, store := range stores {
+		pickups = append(pickups, domain.StorePickups{Store: store, Date: date, Orders: ordersByStore[store.ID]})
+		delete(ordersByStore, store.ID)
+	}
+
+	// Orders at stores deactivated since they were placed still have to be handed out
+	for id, storeOrders := range ordersByStore {
+		store, err := r.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		pickups = append(pickups, domain.StorePickups{Store: store, Date: date, Orders: storeOrders})
+	}
+
+	return pickups, nil
+}
+
+// bookPickupSlot checks, under a lock on the store, that the store still takes pickups and
+// has room for one more on the pickup's day, so concurrent orders cannot overbook it. The
+// slot is taken by the order stored in the same transaction.
+func bookPickupSlot(ctx context.Context, tx *sql.Tx, pickup domain.Pickup) error {
+	var capacity int
+	err := tx.QueryRowContext(ctx,
+		`SELECT daily_capacity FROM stores WHERE id = $1 AND active FOR UPDATE`,
+		pickup.StoreID,
+	).Scan(&capacity)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrStoreNotFound
+		}
+		return errors.New("failed to get store")
+	}
+
+	var booked int
+	err = tx.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM orders WHERE pickup_store_id = $1 AND pickup_date = $2 AND status <> 'cancelled'`,
+		pickup.StoreID, pickup.Date,
+	).Scan(&booked)
+	if err != nil {
+		return errors.New("failed to count pickups")
+	}
+
+	if booked >= capacity {
+		return fmt.Errorf("%w: %s", ErrPickupSlotFull, pickup.Date)
+	}
+
+	return nil
+}
+
+type storeScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanStore(row storeScanner) (domain.Store, error) {
+	var store domain.Store
+	err := row.Scan(
+		&store.ID,
+		&store.Name,
+		&store.Address,
+		&store.DailyCapacity,
+		&store.Active,
+		&store.CreatedAt,
+		&store.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.Store{}, ErrStoreNotFound
+		}
+		return domain.Store{}, errors.New("failed to scan store")
+	}
+	return store, nil
+}
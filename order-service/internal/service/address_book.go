@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"order-service/internal/domain"
+	userpb "proto/user"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// AddressBook reads the address books the user service keeps.
+type AddressBook interface {
+	// GetAddress returns one of the user's addresses, or their default address if addressID
+	// is empty. It returns ErrAddressNotFound if there is no such address.
+	GetAddress(ctx context.Context, userID, addressID string) (domain.Address, error)
+	Close()
+}
+
+type addressBook struct {
+	conn          *grpc.ClientConn
+	addressClient userpb.AddressServiceClient
+}
+
+func NewAddressBook(userURL string) (AddressBook, error) {
+	conn, err := grpc.Dial(userURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to user service: %v", err)
+	}
+
+	return &addressBook{
+		conn:          conn,
+		addressClient: userpb.NewAddressServiceClient(conn),
+	}, nil
+}
+
+func (b *addressBook) GetAddress(ctx context.Context, userID, addressID string) (domain.Address, error) {
+	// Set timeout for the gRPC call
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	address, err := b.addressClient.GetAddress(ctx, &userpb.AddressIDRequest{
+		UserId: userID,
+		Id:     addressID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return domain.Address{}, fmt.Errorf("%w: %s", ErrAddressNotFound, addressID)
+		}
+		return domain.Address{}, fmt.Errorf("failed to get address %s: %v", addressID, err)
+	}
+
+	return domain.Address{
+		RecipientName: address.RecipientName,
+		Line1:         address.Line1,
+		Line2:         address.Line2,
+		City:          address.City,
+		Region:        address.Region,
+		PostalCode:    address.PostalCode,
+		Country:       address.Country,
+		Phone:         address.Phone,
+	}, nil
+}
+
+func (b *addressBook) Close() {
+	if b.conn != nil {
+		_ = b.conn.Close()
+	}
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"order-service/internal/domain"
+	"order-service/internal/repository"
+)
+
+const (
+	defaultReportDays  = 30
+	defaultTopProducts = 10
+	maxTopProducts     = 100
+)
+
+// ErrInvalidReport is returned for report parameters the caller has to fix.
+var ErrInvalidReport = errors.New("invalid report request")
+
+type AnalyticsService interface {
+	GetRevenue(ctx context.Context, period domain.ReportPeriod, reportRange domain.ReportRange) ([]domain.RevenueBucket, error)
+	GetOrderStatusCounts(ctx context.Context, reportRange domain.ReportRange) ([]domain.OrderStatusCount, error)
+	GetTopProducts(ctx context.Context, reportRange domain.ReportRange, limit int) ([]domain.ProductSales, error)
+	GetSalesByAttribute(ctx context.Context, attribute domain.SalesAttribute, reportRange domain.ReportRange) ([]domain.AttributeSales, error)
+}
+
+// Reports are in the base currency, whatever currencies the orders were placed in.
+type analyticsService struct {
+	analyticsRepo repository.AnalyticsRepository
+	baseCurrency  string
+}
+
+func NewAnalyticsService(analyticsRepo repository.AnalyticsRepository, baseCurrency string) AnalyticsService {
+	return &analyticsService{
+		analyticsRepo: analyticsRepo,
+		baseCurrency:  baseCurrency,
+	}
+}
+
+func (s *analyticsService) GetRevenue(ctx context.Context, period domain.ReportPeriod, reportRange domain.ReportRange) ([]domain.RevenueBucket, error) {
+	switch period {
+	case "":
+		period = domain.ReportPeriodDay
+	case domain.ReportPeriodDay, domain.ReportPeriodWeek, domain.ReportPeriodMonth:
+	default:
+		return nil, fmt.Errorf("%w: period must be day, week or month", ErrInvalidReport)
+	}
+
+	reportRange, err := normalizeReportRange(reportRange)
+	if err != nil {
+		return nil, err
+	}
+
+	buckets, err := s.analyticsRepo.RevenueByPeriod(ctx, period, reportRange)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range buckets {
+		for _, amount := range []*domain.Money{&buckets[i].Revenue, &buckets[i].Subtotal, &buckets[i].Discount, &buckets[i].Tax, &buckets[i].Shipping} {
+			amount.Currency = s.baseCurrency
+		}
+	}
+	return buckets, nil
+}
+
+func (s *analyticsService) GetOrderStatusCounts(ctx context.Context, reportRange domain.ReportRange) ([]domain.OrderStatusCount, error) {
+	reportRange, err := normalizeReportRange(reportRange)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := s.analyticsRepo.CountByStatus(ctx, reportRange)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range counts {
+		counts[i].Total.Currency = s.baseCurrency
+	}
+	return counts, nil
+}
+
+func (s *analyticsService) GetTopProducts(ctx context.Context, reportRange domain.ReportRange, limit int) ([]domain.ProductSales, error) {
+	if limit <= 0 {
+		limit = defaultTopProducts
+	}
+	if limit > maxTopProducts {
+		limit = maxTopProducts
+	}
+
+	reportRange, err := normalizeReportRange(reportRange)
+	if err != nil {
+		return nil, err
+	}
+
+	products, err := s.analyticsRepo.TopProducts(ctx, reportRange, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range products {
+		products[i].Revenue.Currency = s.baseCurrency
+	}
+	return products, nil
+}
+
+func (s *analyticsService) GetSalesByAttribute(ctx context.Context, attribute domain.SalesAttribute, reportRange domain.ReportRange) ([]domain.AttributeSales, error) {
+	switch attribute {
+	case domain.SalesAttributeBikeType, domain.SalesAttributeFrameSize, domain.SalesAttributeColor:
+	default:
+		return nil, fmt.Errorf("%w: attribute must be bike_type, frame_size or color", ErrInvalidReport)
+	}
+
+	reportRange, err := normalizeReportRange(reportRange)
+	if err != nil {
+		return nil, err
+	}
+
+	sales, err := s.analyticsRepo.SalesByAttribute(ctx, attribute, reportRange)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range sales {
+		sales[i].Revenue.Currency = s.baseCurrency
+	}
+	return sales, nil
+}
+
+// normalizeReportRange fills in a missing end with now and a missing start with the
+// defaultReportDays before the end.
+func normalizeReportRange(reportRange domain.ReportRange) (domain.ReportRange, error) {
+	if reportRange.To.IsZero() {
+		reportRange.To = time.Now()
+	}
+	if reportRange.From.IsZero() {
+		reportRange.From = reportRange.To.AddDate(0, 0, -defaultReportDays)
+	}
+
+	if !reportRange.From.Before(reportRange.To) {
+		return domain.ReportRange{}, fmt.Errorf("%w: from must be before to", ErrInvalidReport)
+	}
+
+	return reportRange, nil
+}
@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"order-service/internal/domain"
+	"order-service/internal/repository"
+)
+
+var (
+	ErrInvalidCartRequest = errors.New("invalid cart request")
+	ErrCartItemNotFound   = errors.New("product is not in the cart")
+)
+
+// CartOwner identifies a cart: the user's when UserID is set, otherwise the guest session's.
+type CartOwner struct {
+	UserID    string
+	SessionID string
+}
+
+type CartService interface {
+	GetCart(ctx context.Context, owner CartOwner) (domain.Cart, error)
+	// AddItem adds quantity of productID to the cart
+	AddItem(ctx context.Context, owner CartOwner, productID string, quantity int) (domain.Cart, error)
+	// UpdateItem sets the quantity of a product in the cart; zero removes it
+	UpdateItem(ctx context.Context, owner CartOwner, productID string, quantity int) (domain.Cart, error)
+	RemoveItem(ctx context.Context, owner CartOwner, productID string) (domain.Cart, error)
+	ClearCart(ctx context.Context, owner CartOwner) (domain.Cart, error)
+	// MergeCarts moves the guest cart of sessionID into the cart of userID
+	MergeCarts(ctx context.Context, sessionID, userID string) (domain.Cart, error)
+	// PriceCart fills in the names and current catalogue prices of the cart's products in
+	// currency, or in the base currency if currency is empty
+	PriceCart(ctx context.Context, cart domain.Cart, currency string) (domain.Cart, error)
+}
+
+// User carts and guest carts are kept in separate stores.
+type cartService struct {
+	userCarts        repository.CartRepository
+	guestCarts       repository.CartRepository
+	inventoryService InventoryService
+	baseCurrency     string
+}
+
+func NewCartService(userCarts, guestCarts repository.CartRepository, inventoryService InventoryService, baseCurrency string) CartService {
+	return &cartService{
+		userCarts:        userCarts,
+		guestCarts:       guestCarts,
+		inventoryService: inventoryService,
+		baseCurrency:     baseCurrency,
+	}
+}
+
+func (s *cartService) GetCart(ctx context.Context, owner CartOwner) (domain.Cart, error) {
+	carts, ownerID, err := s.store(owner)
+	if err != nil {
+		return domain.Cart{}, err
+	}
+	return carts.Get(ctx, ownerID)
+}
+
+func (s *cartService) AddItem(ctx context.Context, owner CartOwner, productID string, quantity int) (domain.Cart, error) {
+	if productID == "" || quantity <= 0 {
+		return domain.Cart{}, fmt.Errorf("%w: a product and a positive quantity are required", ErrInvalidCartRequest)
+	}
+
+	// Only products from the catalogue go into carts
+	if _, err := s.inventoryService.GetProduct(ctx, productID, ""); err != nil {
+		return domain.Cart{}, err
+	}
+
+	return s.update(ctx, owner, func(cart *domain.Cart) error {
+		cart.SetQuantity(productID, cart.Quantity(productID)+quantity, time.Now())
+		return nil
+	})
+}
+
+func (s *cartService) UpdateItem(ctx context.Context, owner CartOwner, productID string, quantity int) (domain.Cart, error) {
+	if productID == "" || quantity < 0 {
+		return domain.Cart{}, fmt.Errorf("%w: a product and a quantity of zero or more are required", ErrInvalidCartRequest)
+	}
+
+	return s.update(ctx, owner, func(cart *domain.Cart) error {
+		if cart.Quantity(productID) == 0 {
+			return fmt.Errorf("%w: %s", ErrCartItemNotFound, productID)
+		}
+		cart.SetQuantity(productID, quantity, time.Now())
+		return nil
+	})
+}
+
+func (s *cartService) RemoveItem(ctx context.Context, owner CartOwner, productID string) (domain.Cart, error) {
+	return s.update(ctx, owner, func(cart *domain.Cart) error {
+		cart.SetQuantity(productID, 0, time.Now())
+		return nil
+	})
+}
+
+func (s *cartService) ClearCart(ctx context.Context, owner CartOwner) (domain.Cart, error) {
+	carts, ownerID, err := s.store(owner)
+	if err != nil {
+		return domain.Cart{}, err
+	}
+
+	if err := carts.Delete(ctx, ownerID); err != nil {
+		return domain.Cart{}, err
+	}
+	return carts.Get(ctx, ownerID)
+}
+
+func (s *cartService) MergeCarts(ctx context.Context, sessionID, userID string) (domain.Cart, error) {
+	if sessionID == "" || userID == "" {
+		return domain.Cart{}, errors.New("session ID and user ID are required")
+	}
+
+	guestCart, err := s.guestCarts.Get(ctx, sessionID)
+	if err != nil {
+		return domain.Cart{}, err
+	}
+
+	userCart, err := s.userCarts.Get(ctx, userID)
+	if err != nil {
+		return domain.Cart{}, err
+	}
+
+	if len(guestCart.Items) == 0 {
+		return userCart, nil
+	}
+
+	userCart.Merge(guestCart, time.Now())
+	if err := s.userCarts.Save(ctx, userID, userCart); err != nil {
+		return domain.Cart{}, err
+	}
+
+	if err := s.guestCarts.Delete(ctx, sessionID); err != nil {
+		// The guest cart expires on its own; merging it again would only add its items twice
+		log.Printf("Failed to delete guest cart %s after merging it: %v", sessionID, err)
+	}
+
+	return userCart, nil
+}
+
+func (s *cartService) PriceCart(ctx context.Context, cart domain.Cart, currency string) (domain.Cart, error) {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+
+	cart.Subtotal = domain.NewMoney(0, currency)
+	if currency == "" {
+		cart.Subtotal.Currency = s.baseCurrency
+	}
+
+	for i, item := range cart.Items {
+		product, err := s.inventoryService.GetProduct(ctx, item.ProductID, currency)
+		if errors.Is(err, ErrProductNotFound) {
+			// Removed from the catalogue since it was added; the customer can take it out
+			cart.Items[i].Available = false
+			continue
+		}
+		if err != nil {
+			return domain.Cart{}, err
+		}
+
+		cart.Items[i].Name = product.Name
+		cart.Items[i].Price = productPrice(product)
+		cart.Items[i].Available = int(product.Available) >= item.Quantity
+
+		if cart.Items[i].Available {
+			cart.Subtotal = cart.Subtotal.Add(cart.Items[i].Price.Mul(item.Quantity))
+		}
+	}
+
+	return cart, nil
+}
+
+// update applies change to the owner's cart and stores the result.
+func (s *cartService) update(ctx context.Context, owner CartOwner, change func(cart *domain.Cart) error) (domain.Cart, error) {
+	carts, ownerID, err := s.store(owner)
+	if err != nil {
+		return domain.Cart{}, err
+	}
+
+	cart, err := carts.Get(ctx, ownerID)
+	if err != nil {
+		return domain.Cart{}, err
+	}
+
+	if err := change(&cart); err != nil {
+		return domain.Cart{}, err
+	}
+
+	if err := carts.Save(ctx, ownerID, cart); err != nil {
+		return domain.Cart{}, err
+	}
+	return cart, nil
+}
+
+// store picks the repository holding the owner's cart and the ID the cart is kept under.
+func (s *cartService) store(owner CartOwner) (repository.CartRepository, string, error) {
+	switch {
+	case owner.UserID != "":
+		return s.userCarts, owner.UserID, nil
+	case owner.SessionID != "":
+		return s.guestCarts, owner.SessionID, nil
+	}
+	return nil, "", fmt.Errorf("%w: a user or session ID is required", ErrInvalidCartRequest)
+}
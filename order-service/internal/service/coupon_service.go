@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"order-service/internal/domain"
+	"order-service/internal/repository"
+)
+
+var (
+	// ErrInvalidCoupon is returned for coupon definitions an admin has to fix.
+	ErrInvalidCoupon = errors.New("invalid coupon")
+	// ErrCouponNotApplicable is returned when a code cannot be used on an order.
+	ErrCouponNotApplicable = errors.New("coupon cannot be applied")
+)
+
+type CouponService interface {
+	CreateCoupon(ctx context.Context, coupon domain.Coupon) (domain.Coupon, error)
+	GetCoupon(ctx context.Context, id string) (domain.Coupon, error)
+	ListCoupons(ctx context.Context) ([]domain.Coupon, error)
+	UpdateCoupon(ctx context.Context, coupon domain.Coupon) (domain.Coupon, error)
+	DeleteCoupon(ctx context.Context, id string) error
+	// ApplyCoupon checks that userID may use code on an order of items and returns the
+	// coupon with the discount it gives. Coupon amounts are in the base currency and are
+	// converted into the currency of the items at exchangeRate. Usage limits are enforced
+	// again when the order is stored. A stored order, named by orderID, redeemed the coupon
+	// when it was placed and is only repriced, so the coupon's validity period and limits are
+	// not checked again; orderID is empty for new orders.
+	ApplyCoupon(ctx context.Context, code, userID, orderID string, items []domain.OrderItem, exchangeRate float64) (domain.Coupon, domain.Money, error)
+}
+
+// Coupon amounts are defined in the base currency.
+type couponService struct {
+	couponRepo   repository.CouponRepository
+	baseCurrency string
+}
+
+func NewCouponService(couponRepo repository.CouponRepository, baseCurrency string) CouponService {
+	return &couponService{
+		couponRepo:   couponRepo,
+		baseCurrency: baseCurrency,
+	}
+}
+
+func (s *couponService) CreateCoupon(ctx context.Context, coupon domain.Coupon) (domain.Coupon, error) {
+	coupon, err := s.normalizeCoupon(coupon)
+	if err != nil {
+		return domain.Coupon{}, err
+	}
+
+	created, err := s.couponRepo.Create(ctx, coupon)
+	if err != nil {
+		return domain.Coupon{}, err
+	}
+	return s.inBaseCurrency(created), nil
+}
+
+func (s *couponService) GetCoupon(ctx context.Context, id string) (domain.Coupon, error) {
+	coupon, err := s.couponRepo.GetByID(ctx, id)
+	if err != nil {
+		return domain.Coupon{}, err
+	}
+	return s.inBaseCurrency(coupon), nil
+}
+
+func (s *couponService) ListCoupons(ctx context.Context) ([]domain.Coupon, error) {
+	coupons, err := s.couponRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range coupons {
+		coupons[i] = s.inBaseCurrency(coupons[i])
+	}
+	return coupons, nil
+}
+
+func (s *couponService) UpdateCoupon(ctx context.Context, coupon domain.Coupon) (domain.Coupon, error) {
+	coupon, err := s.normalizeCoupon(coupon)
+	if err != nil {
+		return domain.Coupon{}, err
+	}
+
+	updated, err := s.couponRepo.Update(ctx, coupon)
+	if err != nil {
+		return domain.Coupon{}, err
+	}
+	return s.inBaseCurrency(updated), nil
+}
+
+func (s *couponService) DeleteCoupon(ctx context.Context, id string) error {
+	return s.couponRepo.Delete(ctx, id)
+}
+
+func (s *couponService) ApplyCoupon(ctx context.Context, code, userID, orderID string, items []domain.OrderItem, exchangeRate float64) (domain.Coupon, domain.Money, error) {
+	coupon, err := s.couponRepo.GetByCode(ctx, normalizeCouponCode(code))
+	if err != nil {
+		if errors.Is(err, repository.ErrCouponNotFound) {
+			return domain.Coupon{}, domain.Money{}, fmt.Errorf("%w: unknown code %q", ErrCouponNotApplicable, code)
+		}
+		return domain.Coupon{}, domain.Money{}, err
+	}
+
+	if orderID == "" && !coupon.ValidAt(time.Now()) {
+		return domain.Coupon{}, domain.Money{}, fmt.Errorf("%w: %s is not valid at this time", ErrCouponNotApplicable, coupon.Code)
+	}
+
+	if orderID == "" && coupon.MaxUses > 0 && coupon.TimesUsed >= coupon.MaxUses {
+		return domain.Coupon{}, domain.Money{}, fmt.Errorf("%w: %s has been used up", ErrCouponNotApplicable, coupon.Code)
+	}
+
+	if orderID == "" && coupon.MaxUsesPerUser > 0 {
+		used, err := s.couponRepo.CountUserRedemptions(ctx, coupon.ID, userID)
+		if err != nil {
+			return domain.Coupon{}, domain.Money{}, err
+		}
+		if used >= coupon.MaxUsesPerUser {
+			return domain.Coupon{}, domain.Money{}, fmt.Errorf("%w: %s can only be used %d time(s) per customer",
+				ErrCouponNotApplicable, coupon.Code, coupon.MaxUsesPerUser)
+		}
+	}
+
+	var subtotal, eligible domain.Money
+	for _, item := range items {
+		value := item.Price.Mul(item.Quantity)
+		subtotal = subtotal.Add(value)
+		if coupon.AppliesTo(item) {
+			eligible = eligible.Add(value)
+		}
+	}
+
+	minOrderValue := coupon.MinOrderValue.Convert(exchangeRate, subtotal.Currency)
+	if subtotal.LessThan(minOrderValue) {
+		return domain.Coupon{}, domain.Money{}, fmt.Errorf("%w: %s needs an order of at least %s %s",
+			ErrCouponNotApplicable, coupon.Code, minOrderValue, minOrderValue.Currency)
+	}
+
+	if eligible.IsZero() {
+		return domain.Coupon{}, domain.Money{}, fmt.Errorf("%w: %s does not apply to any item in the order", ErrCouponNotApplicable, coupon.Code)
+	}
+
+	return s.inBaseCurrency(coupon), couponDiscount(coupon, eligible, exchangeRate), nil
+}
+
+// couponDiscount works out what coupon takes off the eligible amount, never more than it.
+// Fixed amounts are converted from the base currency at exchangeRate.
+func couponDiscount(coupon domain.Coupon, eligible domain.Money, exchangeRate float64) domain.Money {
+	var discount domain.Money
+	switch coupon.DiscountType {
+	case domain.DiscountTypePercentage:
+		discount = eligible.MulRate(coupon.DiscountPercent / 100)
+	case domain.DiscountTypeFixed:
+		discount = coupon.DiscountAmount.Convert(exchangeRate, eligible.Currency)
+	}
+
+	return discount.Min(eligible)
+}
+
+func (s *couponService) normalizeCoupon(coupon domain.Coupon) (domain.Coupon, error) {
+	coupon.Code = normalizeCouponCode(coupon.Code)
+	if coupon.Code == "" {
+		return domain.Coupon{}, fmt.Errorf("%w: code is required", ErrInvalidCoupon)
+	}
+
+	for _, amount := range []domain.Money{coupon.DiscountAmount, coupon.MinOrderValue} {
+		if amount.Currency != "" && amount.Currency != s.baseCurrency {
+			return domain.Coupon{}, fmt.Errorf("%w: amounts must be in the base currency %s", ErrInvalidCoupon, s.baseCurrency)
+		}
+	}
+
+	switch coupon.DiscountType {
+	case domain.DiscountTypePercentage:
+		if coupon.DiscountPercent <= 0 || coupon.DiscountPercent > 100 {
+			return domain.Coupon{}, fmt.Errorf("%w: percentage must be between 0 and 100", ErrInvalidCoupon)
+		}
+	case domain.DiscountTypeFixed:
+		if coupon.DiscountAmount.Amount <= 0 {
+			return domain.Coupon{}, fmt.Errorf("%w: amount must be greater than zero", ErrInvalidCoupon)
+		}
+	default:
+		return domain.Coupon{}, fmt.Errorf("%w: discount type must be percentage or fixed", ErrInvalidCoupon)
+	}
+
+	if coupon.MinOrderValue.IsNegative() || coupon.MaxUses < 0 || coupon.MaxUsesPerUser < 0 {
+		return domain.Coupon{}, fmt.Errorf("%w: minimum order value and usage limits cannot be negative", ErrInvalidCoupon)
+	}
+
+	if coupon.StartsAt != nil && coupon.EndsAt != nil && !coupon.EndsAt.After(*coupon.StartsAt) {
+		return domain.Coupon{}, fmt.Errorf("%w: coupon must end after it starts", ErrInvalidCoupon)
+	}
+
+	return coupon, nil
+}
+
+// inBaseCurrency labels the coupon's amounts, which are stored without a currency.
+func (s *couponService) inBaseCurrency(coupon domain.Coupon) domain.Coupon {
+	coupon.DiscountAmount.Currency = s.baseCurrency
+	coupon.MinOrderValue.Currency = s.baseCurrency
+	return coupon
+}
+
+// Codes are matched case-insensitively, so they are stored upper case
+func normalizeCouponCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"order-service/internal/domain"
+	inventorypb "proto/inventory"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+type InventoryService interface {
+	// GetProduct looks a product up with its price in currency, or in the base currency if
+	// currency is empty.
+	GetProduct(ctx context.Context, productID, currency string) (*inventorypb.ProductResponse, error)
+	// HoldStock sets the items aside for the order until it is paid, cancelled or the hold expires
+	HoldStock(ctx context.Context, orderID string, items []domain.OrderItem) error
+	// AdjustStock changes what is held for the order to items after they were edited. An order
+	// with nothing held yet has the items held from scratch.
+	AdjustStock(ctx context.Context, orderID string, items []domain.OrderItem) error
+	ReleaseStock(ctx context.Context, orderID string) error
+	Close()
+}
+
+type inventoryService struct {
+	conn          *grpc.ClientConn
+	productClient inventorypb.ProductServiceClient
+}
+
+func NewInventoryService(inventoryURL string) (InventoryService, error) {
+	conn, err := grpc.Dial(inventoryURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to inventory service: %v", err)
+	}
+
+	return &inventoryService{
+		conn:          conn,
+		productClient: inventorypb.NewProductServiceClient(conn),
+	}, nil
+}
+
+func (s *inventoryService) GetProduct(ctx context.Context, productID, currency string) (*inventorypb.ProductResponse, error) {
+	// Set timeout for the gRPC call
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	product, err := s.productClient.GetProduct(ctx, &inventorypb.ProductIDRequest{
+		Id:       productID,
+		Currency: currency,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound:
+			return nil, fmt.Errorf("%w: %s", ErrProductNotFound, productID)
+		case codes.InvalidArgument:
+			return nil, fmt.Errorf("%w: %s", ErrUnsupportedCurrency, currency)
+		}
+		return nil, fmt.Errorf("failed to get product %s: %v", productID, err)
+	}
+
+	return product, nil
+}
+
+// productPrice is the exact price of product, falling back to the decimal price for an
+// inventory service that does not send one yet.
+func productPrice(product *inventorypb.ProductResponse) domain.Money {
+	if product.PriceMoney != nil {
+		return domain.NewMoney(product.PriceMoney.Amount, product.PriceMoney.Currency)
+	}
+	return domain.MoneyFromFloat(product.Price, domain.DefaultCurrency)
+}
+
+// productExchangeRate is the rate product's price was converted from the base currency at,
+// which is 1 for an inventory service that does not convert prices.
+func productExchangeRate(product *inventorypb.ProductResponse) float64 {
+	if product.ExchangeRate <= 0 {
+		return 1
+	}
+	return product.ExchangeRate
+}
+
+func (s *inventoryService) HoldStock(ctx context.Context, orderID string, items []domain.OrderItem) error {
+	// Set timeout for the gRPC call
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := s.productClient.HoldStock(ctx, &inventorypb.HoldStockRequest{
+		OrderId: orderID,
+		Items:   mapStockQuantities(items),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to hold stock for order %s: %v", orderID, err)
+	}
+
+	return reservationShortage(resp)
+}
+
+func (s *inventoryService) AdjustStock(ctx context.Context, orderID string, items []domain.OrderItem) error {
+	// Set timeout for the gRPC call
+	callCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := s.productClient.AdjustReservation(callCtx, &inventorypb.HoldStockRequest{
+		OrderId: orderID,
+		Items:   mapStockQuantities(items),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return s.HoldStock(ctx, orderID, items)
+		}
+		return fmt.Errorf("failed to adjust stock for order %s: %v", orderID, err)
+	}
+
+	return reservationShortage(resp)
+}
+
+func (s *inventoryService) ReleaseStock(ctx context.Context, orderID string) error {
+	// Set timeout for the gRPC call
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if _, err := s.productClient.ReleaseReservation(ctx, &inventorypb.ReservationRequest{OrderId: orderID}); err != nil {
+		return fmt.Errorf("failed to release stock for order %s: %v", orderID, err)
+	}
+
+	return nil
+}
+
+func (s *inventoryService) Close() {
+	if s.conn != nil {
+		_ = s.conn.Close()
+	}
+}
+
+func mapStockQuantities(items []domain.OrderItem) []*inventorypb.ProductQuantity {
+	quantities := make([]*inventorypb.ProductQuantity, 0, len(items))
+	for _, item := range items {
+		quantities = append(quantities, &inventorypb.ProductQuantity{
+			ProductId: item.ProductID,
+			Quantity:  int32(item.Quantity),
+		})
+	}
+	return quantities
+}
+
+// reservationShortage reports the products a hold failed on as ErrInsufficientStock.
+func reservationShortage(resp *inventorypb.ReservationResponse) error {
+	if resp.Success {
+		return nil
+	}
+
+	unavailable := make([]string, 0, len(resp.UnavailableItems))
+	for _, item := range resp.UnavailableItems {
+		unavailable = append(unavailable, item.ProductId)
+	}
+	return fmt.Errorf("%w: %s", ErrInsufficientStock, strings.Join(unavailable, ", "))
+}
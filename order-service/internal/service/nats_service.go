@@ -1,97 +1,200 @@
 package service
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"sync"
 	"time"
 
-	"order-service/internal/domain"
+	"order-service/internal/events"
 
 	"github.com/nats-io/nats.go"
 )
 
+const (
+	orderSubjects = "bicycle.order.*"
+	fetchBatch    = 10
+	fetchWait     = 5 * time.Second
+)
+
+// OrderEventHandler reacts to order events published by other services.
+type OrderEventHandler interface {
+	HandleOrderStockFailed(ctx context.Context, event events.OrderStockFailedEvent) error
+}
+
 type NatsService interface {
-	PublishOrderCreated(order domain.Order) error
+	// Publish stores data on the JetStream stream bound to subject; msgID lets the stream drop duplicates
+	Publish(subject, msgID string, data []byte) error
+	StartConsuming(ctx context.Context, handler OrderEventHandler) error
 	Close()
 }
 
+// ConsumerConfig describes the JetStream stream and durable consumer order-service reads from.
+type ConsumerConfig struct {
+	Stream     string
+	Durable    string
+	MaxDeliver int
+	AckWait    time.Duration
+	NakDelay   time.Duration
+}
+
 type natsService struct {
-	conn *nats.Conn
+	conn          *nats.Conn
+	js            nats.JetStreamContext
+	cfg           ConsumerConfig
+	subscriptions []*nats.Subscription
+	wg            sync.WaitGroup
 }
 
-func NewNatsService(natsURL string) (NatsService, error) {
+func NewNatsService(natsURL string, cfg ConsumerConfig) (NatsService, error) {
 	conn, err := nats.Connect(natsURL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to NATS: %v", err)
 	}
 
+	js, err := conn.JetStream()
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to create JetStream context: %v", err)
+	}
+
 	return &natsService{
 		conn: conn,
+		js:   js,
+		cfg:  cfg,
 	}, nil
 }
 
 func (s *natsService) Close() {
+	for _, sub := range s.subscriptions {
+		_ = sub.Unsubscribe()
+	}
+	s.wg.Wait()
 	if s.conn != nil {
 		s.conn.Close()
 	}
 }
 
-type OrderCreatedEvent struct {
-	OrderID   string           `json:"order_id"`
-	UserID    string           `json:"user_id"`
-	Total     float64          `json:"total"`
-	Status    string           `json:"status"`
-	Items     []OrderItemEvent `json:"items"`
-	CreatedAt time.Time        `json:"created_at"`
-}
+func (s *natsService) Publish(subject, msgID string, data []byte) error {
+	// Wait for the stream to acknowledge so the caller knows the message is stored
+	if _, err := s.js.Publish(subject, data, nats.MsgId(msgID)); err != nil {
+		return fmt.Errorf("failed to publish message: %v", err)
+	}
 
-type OrderItemEvent struct {
-	ProductID string `json:"product_id"`
-	Quantity  int    `json:"quantity"`
-	FrameSize string `json:"frame_size"`
-	WheelSize string `json:"wheel_size"`
-	Color     string `json:"color"`
-	BikeType  string `json:"bike_type"`
+	return nil
 }
 
-func (s *natsService) PublishOrderCreated(order domain.Order) error {
-	msg := OrderCreatedEvent{
-		OrderID:   order.ID,
-		UserID:    order.UserID,
-		Total:     order.Total,
-		Status:    string(order.Status),
-		Items:     make([]OrderItemEvent, len(order.Items)),
-		CreatedAt: order.CreatedAt,
-	}
-
-	for i, item := range order.Items {
-		msg.Items[i] = OrderItemEvent{
-			ProductID: item.ProductID,
-			Quantity:  item.Quantity,
-			FrameSize: item.FrameSize,
-			WheelSize: item.WheelSize,
-			Color:     item.Color,
-			BikeType:  item.BikeType,
-		}
+func (s *natsService) StartConsuming(ctx context.Context, handler OrderEventHandler) error {
+	if err := s.ensureStream(); err != nil {
+		return err
+	}
+
+	consumerCfg := &nats.ConsumerConfig{
+		Durable:       s.cfg.Durable,
+		FilterSubject: events.SubjectOrderStockFailed,
+		DeliverPolicy: nats.DeliverAllPolicy,
+		AckPolicy:     nats.AckExplicitPolicy,
+		AckWait:       s.cfg.AckWait,
+		MaxDeliver:    s.cfg.MaxDeliver,
 	}
 
-	data, err := json.Marshal(msg)
+	_, err := s.js.ConsumerInfo(s.cfg.Stream, s.cfg.Durable)
+	switch {
+	case err == nil:
+		_, err = s.js.UpdateConsumer(s.cfg.Stream, consumerCfg)
+	case errors.Is(err, nats.ErrConsumerNotFound):
+		_, err = s.js.AddConsumer(s.cfg.Stream, consumerCfg)
+	}
 	if err != nil {
-		return fmt.Errorf("failed to marshal order event: %v", err)
+		return fmt.Errorf("failed to set up consumer %s: %v", s.cfg.Durable, err)
 	}
 
-	log.Printf("[NATS-PRODUCER] Publishing order created event for order %s at %s", order.ID, time.Now().Format(time.RFC3339))
+	// Bind to the consumer created above so closing the subscription never deletes it
+	sub, err := s.js.PullSubscribe(events.SubjectOrderStockFailed, s.cfg.Durable, nats.Bind(s.cfg.Stream, s.cfg.Durable))
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to %s: %v", events.SubjectOrderStockFailed, err)
+	}
+	s.subscriptions = append(s.subscriptions, sub)
 
-	if err := s.conn.Publish("bicycle.order.created", data); err != nil {
-		return fmt.Errorf("failed to publish message: %v", err)
+	s.wg.Add(1)
+	go s.consume(ctx, sub, handler)
+
+	log.Printf("[NATS-CONSUMER] Consuming %s from stream %s as %s", events.SubjectOrderStockFailed, s.cfg.Stream, s.cfg.Durable)
+	return nil
+}
+
+// ensureStream creates the order event stream if no service has done so yet.
+func (s *natsService) ensureStream() error {
+	_, err := s.js.StreamInfo(s.cfg.Stream)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, nats.ErrStreamNotFound) {
+		return fmt.Errorf("failed to look up stream %s: %v", s.cfg.Stream, err)
 	}
 
-	// Flush to ensure the message is sent
-	if err := s.conn.Flush(); err != nil {
-		return fmt.Errorf("failed to flush message: %v", err)
+	_, err = s.js.AddStream(&nats.StreamConfig{
+		Name:     s.cfg.Stream,
+		Subjects: []string{orderSubjects},
+		Storage:  nats.FileStorage,
+	})
+	if err != nil && !errors.Is(err, nats.ErrStreamNameAlreadyInUse) {
+		return fmt.Errorf("failed to create stream %s: %v", s.cfg.Stream, err)
 	}
 
-	log.Printf("[NATS-PRODUCER] Order created event published successfully for order %s", order.ID)
 	return nil
 }
+
+func (s *natsService) consume(ctx context.Context, sub *nats.Subscription, handler OrderEventHandler) {
+	defer s.wg.Done()
+
+	for ctx.Err() == nil && sub.IsValid() {
+		msgs, err := sub.Fetch(fetchBatch, nats.MaxWait(fetchWait))
+		if err != nil {
+			if errors.Is(err, nats.ErrTimeout) || errors.Is(err, context.DeadlineExceeded) {
+				continue
+			}
+			if errors.Is(err, nats.ErrBadSubscription) || errors.Is(err, nats.ErrConnectionClosed) {
+				return
+			}
+			log.Printf("[NATS-CONSUMER] Failed to fetch order events: %v", err)
+			time.Sleep(time.Second)
+			continue
+		}
+
+		for _, msg := range msgs {
+			s.handleMessage(ctx, msg, handler)
+		}
+	}
+}
+
+func (s *natsService) handleMessage(ctx context.Context, msg *nats.Msg, handler OrderEventHandler) {
+	var event events.OrderStockFailedEvent
+	if err := json.Unmarshal(msg.Data, &event); err != nil {
+		log.Printf("[NATS-CONSUMER] Dropping malformed %s event: %v", msg.Subject, err)
+		_ = msg.Term()
+		return
+	}
+
+	log.Printf("[NATS-CONSUMER] Received stock failure for order %s: %s", event.OrderID, event.Reason)
+
+	if err := handler.HandleOrderStockFailed(ctx, event); err != nil {
+		delivered := uint64(1)
+		if meta, metaErr := msg.Metadata(); metaErr == nil {
+			delivered = meta.NumDelivered
+		}
+
+		delay := s.cfg.NakDelay * time.Duration(delivered)
+		log.Printf("[NATS-CONSUMER] Failed to handle stock failure for order %s (delivery %d), retrying in %s: %v",
+			event.OrderID, delivered, delay, err)
+		_ = msg.NakWithDelay(delay)
+		return
+	}
+
+	if err := msg.Ack(); err != nil {
+		log.Printf("[NATS-CONSUMER] Failed to ack %s event: %v", msg.Subject, err)
+	}
+}
@@ -5,32 +5,85 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"order-service/internal/cache"
 	"order-service/internal/domain"
+	"order-service/internal/events"
 	"order-service/internal/repository"
+
+	"github.com/google/uuid"
 )
 
 type OrderService interface {
 	CreateOrder(ctx context.Context, order domain.Order) (domain.Order, error)
 	GetOrderByID(ctx context.Context, id string) (domain.Order, error)
-	UpdateOrderStatus(ctx context.Context, id string, status domain.OrderStatus) error
-	ListOrders(ctx context.Context, filter domain.OrderFilter) ([]domain.Order, int, error)
-	GetUserOrders(ctx context.Context, userID string) ([]domain.Order, error)
+	UpdateOrderStatus(ctx context.Context, id string, status domain.OrderStatus, actor domain.Actor, reason string) error
+	// UpdateOrderItems replaces the items of a pending order, repricing it at the exchange rate
+	// it was placed at and adjusting the stock held for it. The change is recorded in the
+	// order's history.
+	UpdateOrderItems(ctx context.Context, id string, items []domain.OrderItem, actor domain.Actor) error
+	// MarkOrderPaid marks a pending order paid by a payment of amount. It fails with
+	// repository.ErrOrderChanged if the order's total no longer is what was charged.
+	MarkOrderPaid(ctx context.Context, id string, amount domain.Money, actor domain.Actor) error
+	// CancelRefundedOrder cancels a paid order whose payment has been refunded, handing its
+	// stock back to inventory. Other paid orders cannot be cancelled.
+	CancelRefundedOrder(ctx context.Context, id string, actor domain.Actor, reason string) error
+	// CancelUnfulfillableOrder cancels an order whose stock could not be reserved.
+	// Nothing is handed back to inventory since nothing was taken. A paid order is cancelled
+	// too; its payment is then refunded through PaymentService.RefundCancelledOrder.
+	CancelUnfulfillableOrder(ctx context.Context, id, reason string) error
+	// CancelStaleOrders cancels up to limit orders left unpaid for longer than maxAge and
+	// returns how many were cancelled.
+	CancelStaleOrders(ctx context.Context, maxAge time.Duration, limit int) (int, error)
+	ListOrders(ctx context.Context, filter domain.OrderFilter) (domain.OrderPage, error)
+	// GetUserOrders lists userID's orders a page at a time; the filter's user is ignored.
+	GetUserOrders(ctx context.Context, userID string, filter domain.OrderFilter) (domain.OrderPage, error)
+	GetOrderHistory(ctx context.Context, id string) ([]domain.OrderStatusChange, error)
+	// CreateShipment ships some or all of a paid order's remaining items; without items it ships
+	// everything left. The order becomes shipped once all of its items have been shipped.
+	CreateShipment(ctx context.Context, shipment domain.Shipment, actor domain.Actor) (domain.Shipment, error)
+	GetShipments(ctx context.Context, orderID string) ([]domain.Shipment, error)
 }
 
+const (
+	defaultOrderPageSize = 10
+	maxOrderPageSize     = 100
+
+	maxIdempotencyKeyLength = 255
+)
+
+var (
+	ErrProductNotFound       = errors.New("product not found")
+	ErrPriceMismatch         = errors.New("price does not match the catalogue")
+	ErrUnsupportedCurrency   = errors.New("unsupported currency")
+	ErrInvalidIdempotencyKey = errors.New("invalid idempotency key")
+	ErrIdempotencyKeyReused  = errors.New("idempotency key was already used for a different order")
+	ErrInvalidAddress        = errors.New("invalid shipping address")
+	ErrAddressNotFound       = errors.New("address not found")
+	ErrInsufficientStock     = errors.New("insufficient stock")
+	ErrInvalidOrderItems     = errors.New("invalid order items")
+	ErrInvalidShipment       = errors.New("invalid shipment")
+	ErrOrderNotShippable     = errors.New("order cannot be shipped")
+	ErrOrderPaid             = errors.New("order has been paid")
+)
+
 type orderService struct {
-	orderRepo   repository.OrderRepository
-	natsService NatsService
-	cache       cache.Cache
+	orderRepo        repository.OrderRepository
+	inventoryService InventoryService
+	addressBook      AddressBook
+	pricing          *PricingPipeline
+	cache            cache.Cache
 }
 
-func NewOrderService(orderRepo repository.OrderRepository, natsService NatsService, cache cache.Cache) OrderService {
+func NewOrderService(orderRepo repository.OrderRepository, inventoryService InventoryService, addressBook AddressBook, pricing *PricingPipeline, cache cache.Cache) OrderService {
 	return &orderService{
-		orderRepo:   orderRepo,
-		natsService: natsService,
-		cache:       cache,
+		orderRepo:        orderRepo,
+		inventoryService: inventoryService,
+		addressBook:      addressBook,
+		pricing:          pricing,
+		cache:            cache,
 	}
 }
 
@@ -43,29 +96,132 @@ func (s *orderService) CreateOrder(ctx context.Context, order domain.Order) (dom
 		return domain.Order{}, errors.New("order must contain at least one item")
 	}
 
-	var total float64
-	for _, item := range order.Items {
+	// A retry of a request that already created an order gets that order back
+	if order.IdempotencyKey != "" {
+		if len(order.IdempotencyKey) > maxIdempotencyKeyLength {
+			return domain.Order{}, fmt.Errorf("%w: longer than %d characters", ErrInvalidIdempotencyKey, maxIdempotencyKeyLength)
+		}
+		order.IdempotencyFingerprint = order.RequestFingerprint()
+
+		existing, err := s.idempotentOrder(ctx, order)
+		if !errors.Is(err, repository.ErrOrderNotFound) {
+			return existing, err
+		}
+	}
+
+	if order.Pickup != nil {
+		pickup, err := normalizePickup(*order.Pickup, time.Now())
+		if err != nil {
+			return domain.Order{}, err
+		}
+		order.Pickup = &pickup
+	}
+
+	address, err := s.shippingAddress(ctx, order)
+	if err != nil {
+		return domain.Order{}, err
+	}
+	order.ShippingAddress = address
+
+	order.Currency = strings.ToUpper(strings.TrimSpace(order.Currency))
+	order.ExchangeRate = 0
+
+	for i, item := range order.Items {
 		if item.Quantity <= 0 {
 			return domain.Order{}, errors.New("item quantity must be greater than zero")
 		}
-		total += item.Price * float64(item.Quantity)
+
+		priced, err := s.priceItem(ctx, &order, item)
+		if err != nil {
+			return domain.Order{}, err
+		}
+		order.Items[i] = priced
+	}
+
+	if err := s.pricing.Price(ctx, &order); err != nil {
+		return domain.Order{}, err
 	}
 
-	order.Total = total
+	order.ID = uuid.New().String()
 	order.Status = domain.OrderStatusPending
 
-	createdOrder, err := s.orderRepo.Create(ctx, order)
+	// Hold the stock before storing the order so two customers cannot both get the last bike
+	if err := s.inventoryService.HoldStock(ctx, order.ID, order.Items); err != nil {
+		return domain.Order{}, err
+	}
+
+	// The repository enqueues the order created event in the same transaction
+	created, err := s.orderRepo.Create(ctx, order)
+	if errors.Is(err, repository.ErrCouponUsedUp) || errors.Is(err, repository.ErrCouponNotFound) {
+		// The coupon was used up or deleted since it was checked
+		err = fmt.Errorf("%w: %v", ErrCouponNotApplicable, err)
+	}
+	if errors.Is(err, repository.ErrStoreNotFound) {
+		// The store does not exist or has stopped taking pickups
+		err = fmt.Errorf("%w: %v", ErrInvalidPickup, err)
+	}
 	if err != nil {
+		if releaseErr := s.inventoryService.ReleaseStock(ctx, order.ID); releaseErr != nil {
+			// The hold expires on its own, this only frees the stock sooner
+			log.Printf("Failed to release stock held for unsaved order %s: %v", order.ID, releaseErr)
+		}
+		if errors.Is(err, repository.ErrIdempotencyKeyUsed) {
+			// A concurrent attempt with the same key stored its order first
+			return s.idempotentOrder(ctx, order)
+		}
 		return domain.Order{}, err
 	}
 
-	// Publish order created event to NATS
-	if err := s.natsService.PublishOrderCreated(createdOrder); err != nil {
-		log.Printf("Failed to publish order created event: %v", err)
-		// Don't fail the order creation if NATS publish fails
+	return created, nil
+}
+
+// idempotentOrder finds the order already created with order's idempotency key. The key
+// must have come with the same request; orders stored before requests were fingerprinted
+// are taken on trust.
+func (s *orderService) idempotentOrder(ctx context.Context, order domain.Order) (domain.Order, error) {
+	existing, err := s.orderRepo.GetByIdempotencyKey(ctx, order.UserID, order.IdempotencyKey)
+	if err != nil {
+		return domain.Order{}, err
 	}
 
-	return createdOrder, nil
+	if existing.IdempotencyFingerprint != "" && existing.IdempotencyFingerprint != order.IdempotencyFingerprint {
+		return domain.Order{}, ErrIdempotencyKeyReused
+	}
+	return existing, nil
+}
+
+// shippingAddress picks the address the order ships to: the address given with the order,
+// the address it names from the user's address book, or else the user's default address.
+// Orders can still be placed without an address by users who have none, and pickup orders
+// have none.
+func (s *orderService) shippingAddress(ctx context.Context, order domain.Order) (*domain.Address, error) {
+	if order.Pickup != nil {
+		if order.ShippingAddress != nil || order.AddressID != "" {
+			return nil, fmt.Errorf("%w: pickup orders are not shipped", ErrInvalidAddress)
+		}
+		return nil, nil
+	}
+
+	if order.ShippingAddress != nil {
+		if order.AddressID != "" {
+			return nil, fmt.Errorf("%w: give an address ID or an address, not both", ErrInvalidAddress)
+		}
+
+		address := order.ShippingAddress.Normalize()
+		if err := address.Validate(); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidAddress, err)
+		}
+		return &address, nil
+	}
+
+	address, err := s.addressBook.GetAddress(ctx, order.UserID, order.AddressID)
+	if errors.Is(err, ErrAddressNotFound) && order.AddressID == "" {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &address, nil
 }
 
 func (s *orderService) GetOrderByID(ctx context.Context, id string) (domain.Order, error) {
@@ -97,18 +253,241 @@ func (s *orderService) GetOrderByID(ctx context.Context, id string) (domain.Orde
 	return order, nil
 }
 
-func (s *orderService) UpdateOrderStatus(ctx context.Context, id string, status domain.OrderStatus) error {
+func (s *orderService) UpdateOrderStatus(ctx context.Context, id string, status domain.OrderStatus, actor domain.Actor, reason string) error {
+	// Orders only become paid through a completed payment
+	if status == domain.OrderStatusPaid {
+		return errors.New("orders are marked paid by completing a payment")
+	}
+
+	// ...and only become shipped once a shipment covers all of their items
+	if status == domain.OrderStatusShipped {
+		return errors.New("orders are marked shipped by creating a shipment")
+	}
+
+	return s.transitionStatus(ctx, id, transition{status: status, actor: actor, reason: reason, restock: true})
+}
+
+func (s *orderService) UpdateOrderItems(ctx context.Context, id string, items []domain.OrderItem, actor domain.Actor) error {
+	if len(items) == 0 {
+		return fmt.Errorf("%w: order must contain at least one item", ErrInvalidOrderItems)
+	}
+
+	var previousItems []domain.OrderItem
+	var adjusted bool
+
+	// The order stays locked while it is edited, so it cannot be paid or cancelled between
+	// adjusting its stock and storing its new items
+	err := s.orderRepo.UpdateItems(ctx, id, func(order domain.Order) (domain.Order, domain.OrderStatusChange, []domain.OutboxMessage, error) {
+		// Once paid the stock has been sold, so the order can no longer change
+		if order.Status != domain.OrderStatusPending {
+			return domain.Order{}, domain.OrderStatusChange{}, nil, fmt.Errorf("%w: order is %s", repository.ErrOrderNotPending, order.Status)
+		}
+
+		// The order keeps its currency, exchange rate, coupon, tax region and pickup; items
+		// are repriced at today's catalogue prices, converted at the order's exchange rate
+		previousItems = order.Items
+		order.Items = make([]domain.OrderItem, len(items))
+		for i, item := range items {
+			if item.Quantity <= 0 {
+				return domain.Order{}, domain.OrderStatusChange{}, nil, fmt.Errorf("%w: item quantity must be greater than zero", ErrInvalidOrderItems)
+			}
+
+			priced, err := s.priceItem(ctx, &order, item)
+			if err != nil {
+				return domain.Order{}, domain.OrderStatusChange{}, nil, err
+			}
+			order.Items[i] = priced
+		}
+
+		if err := s.pricing.Price(ctx, &order); err != nil {
+			return domain.Order{}, domain.OrderStatusChange{}, nil, err
+		}
+
+		// Hold the stock for the new quantities first, as when the order was placed
+		if err := s.inventoryService.AdjustStock(ctx, order.ID, order.Items); err != nil {
+			return domain.Order{}, domain.OrderStatusChange{}, nil, err
+		}
+		adjusted = true
+
+		order.UpdatedAt = time.Now()
+		msg, err := events.OrderUpdated(order)
+		if err != nil {
+			return domain.Order{}, domain.OrderStatusChange{}, nil, err
+		}
+
+		change := domain.OrderStatusChange{
+			OrderID:    order.ID,
+			FromStatus: order.Status,
+			ToStatus:   order.Status,
+			ActorID:    actor.ID,
+			ActorRole:  actor.Role,
+			Reason:     "items changed",
+		}
+		return order, change, []domain.OutboxMessage{msg}, nil
+	})
+	if err != nil {
+		if !adjusted {
+			return err
+		}
+
+		// The order.updated event was not stored, so nothing else puts the old hold back
+		if adjustErr := s.inventoryService.AdjustStock(ctx, id, previousItems); adjustErr != nil {
+			log.Printf("Stock held for order %s no longer matches its items: failed to restore it: %v", id, adjustErr)
+			return fmt.Errorf("%v; the stock held for the order could not be restored: %v", err, adjustErr)
+		}
+		return err
+	}
+
+	// Invalidate cache
+	cacheKey := fmt.Sprintf("order:%s", id)
+	if err := s.cache.Delete(ctx, cacheKey); err != nil {
+		log.Printf("Failed to invalidate cache for order ID %s: %v", id, err)
+	}
+
+	return nil
+}
+
+func (s *orderService) MarkOrderPaid(ctx context.Context, id string, amount domain.Money, actor domain.Actor) error {
+	return s.transitionStatus(ctx, id, transition{status: domain.OrderStatusPaid, actor: actor, expectedTotal: &amount})
+}
+
+func (s *orderService) CancelRefundedOrder(ctx context.Context, id string, actor domain.Actor, reason string) error {
+	return s.transitionStatus(ctx, id, transition{
+		status:   domain.OrderStatusCancelled,
+		actor:    actor,
+		reason:   reason,
+		restock:  true,
+		refunded: true,
+	})
+}
+
+func (s *orderService) CancelUnfulfillableOrder(ctx context.Context, id, reason string) error {
+	order, err := s.orderRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Redelivered events find the order already cancelled
+	if order.Status == domain.OrderStatusCancelled {
+		return nil
+	}
+
+	return s.transitionStatus(ctx, id, transition{
+		status:   domain.OrderStatusCancelled,
+		actor:    domain.SystemActor,
+		reason:   reason,
+		refunded: true,
+	})
+}
+
+func (s *orderService) CancelStaleOrders(ctx context.Context, maxAge time.Duration, limit int) (int, error) {
+	reason := fmt.Sprintf("not paid within %s", maxAge)
+
+	cancelled, err := s.orderRepo.ExpireOrders(ctx, domain.OrderStatusPending, time.Now().Add(-maxAge), limit,
+		func(order domain.Order) (domain.OrderStatusChange, []domain.OutboxMessage, error) {
+			if !isValidStatusTransition(order.Status, domain.OrderStatusCancelled) {
+				return domain.OrderStatusChange{}, nil, errors.New("invalid status transition")
+			}
+
+			// Hand the held stock back, same as a cancellation by the customer
+			msg, err := events.OrderCancelled(order, order.Status)
+			if err != nil {
+				return domain.OrderStatusChange{}, nil, err
+			}
+
+			change := domain.OrderStatusChange{
+				OrderID:    order.ID,
+				FromStatus: order.Status,
+				ToStatus:   domain.OrderStatusCancelled,
+				ActorID:    domain.SystemActor.ID,
+				ActorRole:  domain.SystemActor.Role,
+				Reason:     reason,
+			}
+			return change, []domain.OutboxMessage{msg}, nil
+		})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, id := range cancelled {
+		cacheKey := fmt.Sprintf("order:%s", id)
+		if err := s.cache.Delete(ctx, cacheKey); err != nil {
+			log.Printf("Failed to invalidate cache for order ID %s: %v", id, err)
+		}
+	}
+
+	return len(cancelled), nil
+}
+
+// transition is a status change of an order made on behalf of actor.
+type transition struct {
+	status domain.OrderStatus
+	actor  domain.Actor
+	reason string
+	// restock hands the stock held or taken for a cancelled order back to inventory
+	restock bool
+	// refunded lets a paid order be cancelled; it has been, or is being, refunded
+	refunded bool
+	// expectedTotal, when set, only lets the change through while the order costs that much
+	expectedTotal *domain.Money
+}
+
+// transitionStatus moves an order to t.status and records it in the order's history.
+func (s *orderService) transitionStatus(ctx context.Context, id string, t transition) error {
 	order, err := s.orderRepo.GetByID(ctx, id)
 	if err != nil {
 		return err
 	}
 
+	status := t.status
 	if !isValidStatusTransition(order.Status, status) {
 		return errors.New("invalid status transition")
 	}
 
-	order.Status = status
-	if err := s.orderRepo.Update(ctx, order); err != nil {
+	// Cancelling a paid order keeps the customer's money unless it goes through a refund
+	if status == domain.OrderStatusCancelled && isPaidStatus(order.Status) && !t.refunded {
+		return fmt.Errorf("%w: refund its payment to cancel it", ErrOrderPaid)
+	}
+
+	if t.expectedTotal != nil && order.Total.Amount != t.expectedTotal.Amount {
+		return fmt.Errorf("%w: order total is %s, expected %s", repository.ErrOrderChanged, order.Total, *t.expectedTotal)
+	}
+
+	// Only orders collected in store wait there
+	if status == domain.OrderStatusReadyForPickup && order.Pickup == nil {
+		return errors.New("only pickup orders can be ready for pickup")
+	}
+
+	var messages []domain.OutboxMessage
+
+	// Turn the stock held on order creation into a sale
+	if status == domain.OrderStatusPaid {
+		msg, err := events.OrderPaid(order)
+		if err != nil {
+			return err
+		}
+		messages = append(messages, msg)
+	}
+
+	// Give the stock held or taken for the order back to inventory
+	if status == domain.OrderStatusCancelled && t.restock {
+		msg, err := events.OrderCancelled(order, order.Status)
+		if err != nil {
+			return err
+		}
+		messages = append(messages, msg)
+	}
+
+	change := domain.OrderStatusChange{
+		OrderID:       order.ID,
+		FromStatus:    order.Status,
+		ToStatus:      status,
+		ActorID:       t.actor.ID,
+		ActorRole:     t.actor.Role,
+		Reason:        t.reason,
+		ExpectedTotal: t.expectedTotal,
+	}
+	if err := s.orderRepo.UpdateStatus(ctx, change, messages...); err != nil {
 		return err
 	}
 
@@ -121,14 +500,205 @@ func (s *orderService) UpdateOrderStatus(ctx context.Context, id string, status
 	return nil
 }
 
-func (s *orderService) ListOrders(ctx context.Context, filter domain.OrderFilter) ([]domain.Order, int, error) {
+func (s *orderService) ListOrders(ctx context.Context, filter domain.OrderFilter) (domain.OrderPage, error) {
 	// Not caching list operations due to complexity of cache invalidation
-	return s.orderRepo.List(ctx, filter)
+	return s.orderRepo.List(ctx, normalizeOrderFilter(filter))
 }
 
-func (s *orderService) GetUserOrders(ctx context.Context, userID string) ([]domain.Order, error) {
+func (s *orderService) GetUserOrders(ctx context.Context, userID string, filter domain.OrderFilter) (domain.OrderPage, error) {
+	if userID == "" {
+		return domain.OrderPage{}, errors.New("user ID is required")
+	}
+
+	filter.UserID = userID
+
 	// Not caching list operations due to complexity of cache invalidation
-	return s.orderRepo.GetUserOrders(ctx, userID)
+	return s.orderRepo.List(ctx, normalizeOrderFilter(filter))
+}
+
+func (s *orderService) GetOrderHistory(ctx context.Context, id string) ([]domain.OrderStatusChange, error) {
+	// Make sure a missing order is reported as such rather than as an empty history
+	if _, err := s.GetOrderByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return s.orderRepo.GetStatusHistory(ctx, id)
+}
+
+func (s *orderService) CreateShipment(ctx context.Context, shipment domain.Shipment, actor domain.Actor) (domain.Shipment, error) {
+	if shipment.Carrier == "" || shipment.TrackingNumber == "" {
+		return domain.Shipment{}, fmt.Errorf("%w: carrier and tracking number are required", ErrInvalidShipment)
+	}
+
+	if shipment.ShippedAt.IsZero() {
+		shipment.ShippedAt = time.Now()
+	}
+
+	created, err := s.orderRepo.CreateShipment(ctx, shipment.OrderID,
+		func(order domain.Order, shipped map[string]int) (domain.Shipment, *domain.OrderStatusChange, error) {
+			if order.Pickup != nil {
+				return domain.Shipment{}, nil, fmt.Errorf("%w: order is collected in store", ErrOrderNotShippable)
+			}
+			if order.Status != domain.OrderStatusPaid {
+				return domain.Shipment{}, nil, fmt.Errorf("%w: order is %s", ErrOrderNotShippable, order.Status)
+			}
+
+			items, complete, err := shipmentItems(order, shipped, shipment.Items)
+			if err != nil {
+				return domain.Shipment{}, nil, err
+			}
+			shipment.Items = items
+
+			if !complete {
+				return shipment, nil, nil
+			}
+
+			change := &domain.OrderStatusChange{
+				OrderID:    order.ID,
+				FromStatus: order.Status,
+				ToStatus:   domain.OrderStatusShipped,
+				ActorID:    actor.ID,
+				ActorRole:  actor.Role,
+				Reason:     fmt.Sprintf("shipped with %s, tracking number %s", shipment.Carrier, shipment.TrackingNumber),
+			}
+			return shipment, change, nil
+		})
+	if err != nil {
+		return domain.Shipment{}, err
+	}
+
+	// Invalidate cache
+	cacheKey := fmt.Sprintf("order:%s", shipment.OrderID)
+	if err := s.cache.Delete(ctx, cacheKey); err != nil {
+		log.Printf("Failed to invalidate cache for order ID %s: %v", shipment.OrderID, err)
+	}
+
+	return created, nil
+}
+
+func (s *orderService) GetShipments(ctx context.Context, orderID string) ([]domain.Shipment, error) {
+	order, err := s.GetOrderByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return order.Shipments, nil
+}
+
+// shipmentItems checks the requested items against what is left to ship of the order, or takes
+// everything left when none are requested. It reports whether the order is then fully shipped.
+func shipmentItems(order domain.Order, shipped map[string]int, requested []domain.ShipmentItem) ([]domain.ShipmentItem, bool, error) {
+	remaining := make(map[string]int, len(order.Items))
+	productIDs := make(map[string]string, len(order.Items))
+	for _, item := range order.Items {
+		remaining[item.ID] = item.Quantity - shipped[item.ID]
+		productIDs[item.ID] = item.ProductID
+	}
+
+	if len(requested) == 0 {
+		for _, item := range order.Items {
+			if remaining[item.ID] > 0 {
+				requested = append(requested, domain.ShipmentItem{OrderItemID: item.ID, Quantity: remaining[item.ID]})
+			}
+		}
+	}
+
+	var items []domain.ShipmentItem
+	for _, item := range requested {
+		left, ok := remaining[item.OrderItemID]
+		if !ok {
+			return nil, false, fmt.Errorf("%w: order item %s is not part of the order", ErrInvalidShipment, item.OrderItemID)
+		}
+		if item.Quantity <= 0 {
+			return nil, false, fmt.Errorf("%w: item quantity must be greater than zero", ErrInvalidShipment)
+		}
+		if item.Quantity > left {
+			return nil, false, fmt.Errorf("%w: only %d of order item %s left to ship, got %d",
+				ErrInvalidShipment, left, item.OrderItemID, item.Quantity)
+		}
+
+		remaining[item.OrderItemID] = left - item.Quantity
+		items = append(items, domain.ShipmentItem{
+			OrderItemID: item.OrderItemID,
+			ProductID:   productIDs[item.OrderItemID],
+			Quantity:    item.Quantity,
+		})
+	}
+
+	if len(items) == 0 {
+		return nil, false, fmt.Errorf("%w: nothing left to ship", ErrInvalidShipment)
+	}
+
+	for _, left := range remaining {
+		if left > 0 {
+			return items, false, nil
+		}
+	}
+
+	return items, true, nil
+}
+
+// priceItem snapshots the catalogue name, price, weight and bike attributes onto an order item.
+// Prices are looked up in the order's currency; the first item settles the currency, when the
+// order did not ask for one, and the exchange rate the order is placed at. Once the order has
+// a rate, base currency prices are converted at it, so edits keep the rate of the checkout.
+// A client-supplied price is only used to detect a stale cart and never charged.
+func (s *orderService) priceItem(ctx context.Context, order *domain.Order, item domain.OrderItem) (domain.OrderItem, error) {
+	currency := order.Currency
+	if order.ExchangeRate != 0 {
+		currency = ""
+	}
+
+	product, err := s.inventoryService.GetProduct(ctx, item.ProductID, currency)
+	if err != nil {
+		return domain.OrderItem{}, err
+	}
+
+	price := productPrice(product)
+	if order.ExchangeRate == 0 {
+		order.Currency = price.Currency
+		order.ExchangeRate = productExchangeRate(product)
+	} else {
+		price = price.Convert(order.ExchangeRate, order.Currency)
+	}
+	if !item.Price.IsZero() && item.Price.Amount != price.Amount {
+		return domain.OrderItem{}, fmt.Errorf("%w: product %s costs %s, got %s",
+			ErrPriceMismatch, product.Id, price, item.Price)
+	}
+
+	return domain.OrderItem{
+		ProductID:  product.Id,
+		Name:       product.Name,
+		Price:      price,
+		Quantity:   item.Quantity,
+		FrameSize:  product.FrameSize,
+		WheelSize:  product.WheelSize,
+		Color:      product.Color,
+		BikeType:   product.BikeType,
+		CategoryID: product.CategoryId,
+		Weight:     product.Weight,
+	}, nil
+}
+
+// normalizeOrderFilter keeps page sizes between 1 and maxOrderPageSize.
+func normalizeOrderFilter(filter domain.OrderFilter) domain.OrderFilter {
+	if filter.PageSize <= 0 {
+		filter.PageSize = defaultOrderPageSize
+	}
+	if filter.PageSize > maxOrderPageSize {
+		filter.PageSize = maxOrderPageSize
+	}
+	return filter
+}
+
+// isPaidStatus reports whether an order in status has been paid for.
+func isPaidStatus(status domain.OrderStatus) bool {
+	switch status {
+	case domain.OrderStatusPaid, domain.OrderStatusReadyForPickup, domain.OrderStatusShipped, domain.OrderStatusDelivered:
+		return true
+	default:
+		return false
+	}
 }
 
 func isValidStatusTransition(current, next domain.OrderStatus) bool {
@@ -136,8 +706,8 @@ func isValidStatusTransition(current, next domain.OrderStatus) bool {
 	case domain.OrderStatusPending:
 		return next == domain.OrderStatusPaid || next == domain.OrderStatusCancelled
 	case domain.OrderStatusPaid:
-		return next == domain.OrderStatusShipped || next == domain.OrderStatusCancelled
-	case domain.OrderStatusShipped:
+		return next == domain.OrderStatusShipped || next == domain.OrderStatusReadyForPickup || next == domain.OrderStatusCancelled
+	case domain.OrderStatusShipped, domain.OrderStatusReadyForPickup:
 		return next == domain.OrderStatusDelivered || next == domain.OrderStatusCancelled
 	case domain.OrderStatusDelivered, domain.OrderStatusCancelled:
 		return false
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"order-service/internal/domain"
+	"order-service/internal/repository"
+)
+
+// OutboxRelay publishes events stored in the outbox table to NATS.
+// Messages are marked sent only after NATS accepts them, so delivery is at-least-once.
+type OutboxRelay struct {
+	outboxRepo   repository.OutboxRepository
+	natsService  NatsService
+	pollInterval time.Duration
+	batchSize    int
+	maxBackoff   time.Duration
+}
+
+func NewOutboxRelay(outboxRepo repository.OutboxRepository, natsService NatsService, pollInterval time.Duration, batchSize int, maxBackoff time.Duration) *OutboxRelay {
+	return &OutboxRelay{
+		outboxRepo:   outboxRepo,
+		natsService:  natsService,
+		pollInterval: pollInterval,
+		batchSize:    batchSize,
+		maxBackoff:   maxBackoff,
+	}
+}
+
+// Run polls the outbox until ctx is cancelled.
+func (r *OutboxRelay) Run(ctx context.Context) {
+	log.Printf("[OUTBOX-RELAY] Starting, polling every %s", r.pollInterval)
+
+	ticker := time.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		// Keep draining while full batches come back
+		for {
+			sent, err := r.outboxRepo.ProcessPending(ctx, r.batchSize, r.publish, r.backoff)
+			if err != nil {
+				log.Printf("[OUTBOX-RELAY] Failed to process outbox: %v", err)
+				break
+			}
+			if sent < r.batchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Printf("[OUTBOX-RELAY] Stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *OutboxRelay) publish(msg domain.OutboxMessage) error {
+	if err := r.natsService.Publish(msg.Subject, msg.ID, msg.Payload); err != nil {
+		log.Printf("[OUTBOX-RELAY] Failed to publish %s for %s (attempt %d): %v", msg.Subject, msg.AggregateID, msg.Attempts+1, err)
+		return err
+	}
+
+	log.Printf("[NATS-PRODUCER] Published %s for %s", msg.Subject, msg.AggregateID)
+	return nil
+}
+
+// backoff doubles the retry delay per failed attempt, capped at maxBackoff.
+func (r *OutboxRelay) backoff(attempts int) time.Duration {
+	delay := r.pollInterval
+	for i := 1; i < attempts && delay < r.maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > r.maxBackoff {
+		delay = r.maxBackoff
+	}
+	return delay
+}
@@ -0,0 +1,220 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"order-service/internal/domain"
+	"order-service/internal/payment"
+	"order-service/internal/repository"
+)
+
+// ErrPaymentNotRefundable is returned for payments with nothing to refund.
+var ErrPaymentNotRefundable = errors.New("payment cannot be refunded")
+
+// errProviderRefused is returned by refund when the provider did not give the money back
+var errProviderRefused = errors.New("failed to refund payment")
+
+type PaymentService interface {
+	CreatePayment(ctx context.Context, orderID, method string, actor domain.Actor) (domain.Payment, error)
+	GetPayment(ctx context.Context, id string) (domain.Payment, error)
+	RefundPayment(ctx context.Context, id string, actor domain.Actor, reason string) (domain.Payment, error)
+	// RefundCancelledOrder refunds the completed payments of a cancelled order. A payment the
+	// provider will not refund is flagged refund_failed for someone to refund by hand.
+	RefundCancelledOrder(ctx context.Context, orderID string) error
+}
+
+type paymentService struct {
+	paymentRepo  repository.PaymentRepository
+	orderService OrderService
+	provider     payment.Provider
+}
+
+func NewPaymentService(paymentRepo repository.PaymentRepository, orderService OrderService, provider payment.Provider) PaymentService {
+	return &paymentService{
+		paymentRepo:  paymentRepo,
+		orderService: orderService,
+		provider:     provider,
+	}
+}
+
+func (s *paymentService) CreatePayment(ctx context.Context, orderID, method string, actor domain.Actor) (domain.Payment, error) {
+	if method == "" {
+		return domain.Payment{}, errors.New("payment method is required")
+	}
+
+	order, err := s.orderService.GetOrderByID(ctx, orderID)
+	if err != nil {
+		return domain.Payment{}, err
+	}
+
+	if order.Status != domain.OrderStatusPending {
+		return domain.Payment{}, fmt.Errorf("order is %s, only pending orders can be paid", order.Status)
+	}
+
+	p, err := s.paymentRepo.Create(ctx, domain.Payment{
+		OrderID: order.ID,
+		Amount:  order.Total,
+		Status:  domain.PaymentStatusPending,
+		Method:  method,
+	})
+	if err != nil {
+		return domain.Payment{}, err
+	}
+
+	result, err := s.provider.Charge(ctx, payment.ChargeRequest{
+		PaymentID: p.ID,
+		OrderID:   p.OrderID,
+		Amount:    p.Amount,
+		Method:    p.Method,
+	})
+	if err != nil || !result.Approved {
+		if err == nil {
+			err = fmt.Errorf("payment declined: %s", result.Reason)
+		}
+		log.Printf("Payment %s for order %s failed via %s: %v", p.ID, p.OrderID, s.provider.Name(), err)
+
+		p.Status = domain.PaymentStatusFailed
+		if updateErr := s.paymentRepo.Update(ctx, p); updateErr != nil {
+			log.Printf("Failed to mark payment %s as failed: %v", p.ID, updateErr)
+		}
+		return p, err
+	}
+
+	p.Status = domain.PaymentStatusCompleted
+	p.TransactionID = result.TransactionID
+	if err := s.paymentRepo.Update(ctx, p); err != nil {
+		// The provider has taken the money but we could not record it; give it back
+		if refundErr := s.provider.Refund(ctx, result.TransactionID, p.Amount); refundErr != nil {
+			log.Printf("Failed to refund unrecorded transaction %s: %v", result.TransactionID, refundErr)
+		}
+		return domain.Payment{}, err
+	}
+
+	if err := s.orderService.MarkOrderPaid(ctx, p.OrderID, p.Amount, actor); err != nil {
+		log.Printf("Payment %s completed but failed to mark order %s as paid: %v", p.ID, p.OrderID, err)
+		return s.refundUnpaidOrder(ctx, p), err
+	}
+
+	log.Printf("Payment %s completed for order %s (transaction %s)", p.ID, p.OrderID, p.TransactionID)
+	return p, nil
+}
+
+// refundUnpaidOrder gives back a completed payment whose order could not be marked paid, e.g.
+// because it was cancelled while the payment went through. If the refund fails the payment is
+// flagged refund_failed so it is refunded by hand.
+func (s *paymentService) refundUnpaidOrder(ctx context.Context, p domain.Payment) domain.Payment {
+	refunded, err := s.refund(ctx, p, domain.PaymentStatusRefundFailed)
+	if err != nil {
+		log.Printf("Failed to refund payment %s (transaction %s) for unpaid order %s: %v", p.ID, p.TransactionID, p.OrderID, err)
+		return p
+	}
+
+	log.Printf("Refunded payment %s for order %s that could not be marked paid", p.ID, p.OrderID)
+	return refunded
+}
+
+// refund gives back what is left of p. The refund is claimed first, so of concurrent refunds of
+// the same payment only one reaches the provider; if the provider refuses, p is left in
+// failedStatus.
+func (s *paymentService) refund(ctx context.Context, p domain.Payment, failedStatus domain.PaymentStatus) (domain.Payment, error) {
+	if err := s.paymentRepo.ClaimRefund(ctx, p); err != nil {
+		return domain.Payment{}, err
+	}
+
+	// Returns may already have given part of it back
+	if err := s.provider.Refund(ctx, p.TransactionID, p.Amount.Sub(p.RefundedAmount)); err != nil {
+		if releaseErr := s.paymentRepo.ReleaseRefund(ctx, p.ID, failedStatus); releaseErr != nil {
+			log.Printf("Failed to release the refund of payment %s, it stays refunding: %v", p.ID, releaseErr)
+		}
+		return domain.Payment{}, fmt.Errorf("%w: %v", errProviderRefused, err)
+	}
+
+	if err := s.paymentRepo.CompleteRefund(ctx, p.ID); err != nil {
+		// The money has gone back, so the payment stays refunding until this is fixed by hand
+		log.Printf("Refunded payment %s via %s but failed to record it: %v", p.ID, s.provider.Name(), err)
+		return domain.Payment{}, err
+	}
+
+	p.Status = domain.PaymentStatusRefunded
+	p.RefundedAmount = p.Amount
+	return p, nil
+}
+
+func (s *paymentService) GetPayment(ctx context.Context, id string) (domain.Payment, error) {
+	return s.paymentRepo.GetByID(ctx, id)
+}
+
+func (s *paymentService) RefundPayment(ctx context.Context, id string, actor domain.Actor, reason string) (domain.Payment, error) {
+	p, err := s.paymentRepo.GetByID(ctx, id)
+	if err != nil {
+		return domain.Payment{}, err
+	}
+
+	if p.Status != domain.PaymentStatusCompleted && p.Status != domain.PaymentStatusRefundFailed {
+		return domain.Payment{}, fmt.Errorf("%w: payment is %s, only completed payments can be refunded", ErrPaymentNotRefundable, p.Status)
+	}
+
+	p, err = s.refund(ctx, p, p.Status)
+	if err != nil {
+		return domain.Payment{}, err
+	}
+
+	// A refunded order that has not left the warehouse is no longer going anywhere
+	order, err := s.orderService.GetOrderByID(ctx, p.OrderID)
+	if err != nil {
+		log.Printf("Failed to load order %s after refund: %v", p.OrderID, err)
+		return p, nil
+	}
+
+	if order.Status == domain.OrderStatusPaid {
+		if reason == "" {
+			reason = "payment refunded"
+		}
+		if err := s.orderService.CancelRefundedOrder(ctx, order.ID, actor, reason); err != nil {
+			log.Printf("Failed to cancel order %s after refund: %v", order.ID, err)
+		}
+	}
+
+	return p, nil
+}
+
+func (s *paymentService) RefundCancelledOrder(ctx context.Context, orderID string) error {
+	order, err := s.orderService.GetOrderByID(ctx, orderID)
+	if err != nil {
+		return err
+	}
+
+	if order.Status != domain.OrderStatusCancelled {
+		return fmt.Errorf("order is %s, only cancelled orders are refunded", order.Status)
+	}
+
+	payments, err := s.paymentRepo.GetByOrderID(ctx, orderID)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range payments {
+		// Payments already refunded or flagged, e.g. on a redelivered event, are left alone
+		if p.Status != domain.PaymentStatusCompleted {
+			continue
+		}
+
+		if _, err := s.refund(ctx, p, domain.PaymentStatusRefundFailed); err != nil {
+			if errors.Is(err, repository.ErrPaymentChanged) {
+				continue
+			}
+			if errors.Is(err, errProviderRefused) {
+				log.Printf("Payment %s of cancelled order %s could not be refunded and is flagged for a manual refund: %v", p.ID, orderID, err)
+				continue
+			}
+			return err
+		}
+
+		log.Printf("Refunded payment %s of cancelled order %s", p.ID, orderID)
+	}
+
+	return nil
+}
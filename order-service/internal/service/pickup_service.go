@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"order-service/internal/domain"
+	"order-service/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrInvalidStore is returned for store definitions an admin has to fix.
+	ErrInvalidStore = errors.New("invalid store")
+	// ErrInvalidPickup is returned when an order cannot be picked up where or when it asks to.
+	ErrInvalidPickup = errors.New("invalid pickup")
+)
+
+const (
+	// maxPickupDays is how many days ahead a pickup can be booked
+	maxPickupDays = 30
+	// defaultPickupSlotDays is how many days of slots are listed when none are asked for
+	defaultPickupSlotDays = 14
+)
+
+type PickupService interface {
+	CreateStore(ctx context.Context, store domain.Store) (domain.Store, error)
+	GetStore(ctx context.Context, id string) (domain.Store, error)
+	// ListStores lists the stores taking pickups, or all of them if includeInactive is set
+	ListStores(ctx context.Context, includeInactive bool) ([]domain.Store, error)
+	UpdateStore(ctx context.Context, store domain.Store) (domain.Store, error)
+	// GetPickupSlots returns the store's slots for up to days days starting at from, limited
+	// to the days a pickup can be booked on. An empty from starts at the first such day.
+	GetPickupSlots(ctx context.Context, storeID, from string, days int) ([]domain.PickupSlot, error)
+	// GetDailyPickups returns the orders to be collected on date, per store, or only at
+	// storeID if it is set
+	GetDailyPickups(ctx context.Context, date, storeID string) ([]domain.StorePickups, error)
+}
+
+type pickupService struct {
+	storeRepo repository.StoreRepository
+}
+
+func NewPickupService(storeRepo repository.StoreRepository) PickupService {
+	return &pickupService{
+		storeRepo: storeRepo,
+	}
+}
+
+func (s *pickupService) CreateStore(ctx context.Context, store domain.Store) (domain.Store, error) {
+	store, err := normalizeStore(store)
+	if err != nil {
+		return domain.Store{}, err
+	}
+	return s.storeRepo.Create(ctx, store)
+}
+
+func (s *pickupService) GetStore(ctx context.Context, id string) (domain.Store, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return domain.Store{}, repository.ErrStoreNotFound
+	}
+	return s.storeRepo.GetByID(ctx, id)
+}
+
+func (s *pickupService) ListStores(ctx context.Context, includeInactive bool) ([]domain.Store, error) {
+	return s.storeRepo.List(ctx, !includeInactive)
+}
+
+func (s *pickupService) UpdateStore(ctx context.Context, store domain.Store) (domain.Store, error) {
+	if _, err := uuid.Parse(store.ID); err != nil {
+		return domain.Store{}, repository.ErrStoreNotFound
+	}
+
+	store, err := normalizeStore(store)
+	if err != nil {
+		return domain.Store{}, err
+	}
+	return s.storeRepo.Update(ctx, store)
+}
+
+func (s *pickupService) GetPickupSlots(ctx context.Context, storeID, from string, days int) ([]domain.PickupSlot, error) {
+	store, err := s.GetStore(ctx, storeID)
+	if err != nil {
+		return nil, err
+	}
+	if !store.Active {
+		return nil, nil
+	}
+
+	first, last := pickupWindow(time.Now())
+	if from != "" {
+		start, err := time.ParseInLocation(domain.PickupDateLayout, from, time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("%w: date must be in YYYY-MM-DD format", ErrInvalidPickup)
+		}
+		if start.After(first) {
+			first = start
+		}
+	}
+
+	if days <= 0 {
+		days = defaultPickupSlotDays
+	}
+	if end := first.AddDate(0, 0, days-1); end.Before(last) {
+		last = end
+	}
+	if first.After(last) {
+		return nil, nil
+	}
+
+	return s.storeRepo.GetPickupSlots(ctx, store.ID,
+		first.Format(domain.PickupDateLayout), last.Format(domain.PickupDateLayout))
+}
+
+func (s *pickupService) GetDailyPickups(ctx context.Context, date, storeID string) ([]domain.StorePickups, error) {
+	if _, err := time.Parse(domain.PickupDateLayout, date); err != nil {
+		return nil, fmt.Errorf("%w: date must be in YYYY-MM-DD format", ErrInvalidPickup)
+	}
+	if storeID != "" {
+		if _, err := uuid.Parse(storeID); err != nil {
+			return nil, repository.ErrStoreNotFound
+		}
+	}
+
+	return s.storeRepo.ListPickups(ctx, date, storeID)
+}
+
+func normalizeStore(store domain.Store) (domain.Store, error) {
+	store.Name = strings.TrimSpace(store.Name)
+	store.Address = strings.TrimSpace(store.Address)
+
+	switch {
+	case store.Name == "":
+		return domain.Store{}, fmt.Errorf("%w: name is required", ErrInvalidStore)
+	case store.Address == "":
+		return domain.Store{}, fmt.Errorf("%w: address is required", ErrInvalidStore)
+	case store.DailyCapacity <= 0:
+		return domain.Store{}, fmt.Errorf("%w: daily capacity must be greater than zero", ErrInvalidStore)
+	}
+
+	return store, nil
+}
+
+// normalizePickup checks that an order can be picked up on the day it asks for. Whether the
+// store takes pickups and has room left that day is checked when the order is stored.
+func normalizePickup(pickup domain.Pickup, now time.Time) (domain.Pickup, error) {
+	pickup.StoreID = strings.TrimSpace(pickup.StoreID)
+	pickup.Date = strings.TrimSpace(pickup.Date)
+
+	if _, err := uuid.Parse(pickup.StoreID); err != nil {
+		return domain.Pickup{}, fmt.Errorf("%w: %v", ErrInvalidPickup, repository.ErrStoreNotFound)
+	}
+
+	date, err := time.ParseInLocation(domain.PickupDateLayout, pickup.Date, time.Local)
+	if err != nil {
+		return domain.Pickup{}, fmt.Errorf("%w: date must be in YYYY-MM-DD format", ErrInvalidPickup)
+	}
+
+	first, last := pickupWindow(now)
+	if date.Before(first) || date.After(last) {
+		return domain.Pickup{}, fmt.Errorf("%w: pickups can be booked from %s to %s", ErrInvalidPickup,
+			first.Format(domain.PickupDateLayout), last.Format(domain.PickupDateLayout))
+	}
+
+	return pickup, nil
+}
+
+// pickupWindow returns the first and last days a pickup can be booked on at now: orders need a
+// day to be made ready, so pickups start tomorrow, and run up to maxPickupDays ahead.
+func pickupWindow(now time.Time) (time.Time, time.Time) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	return today.AddDate(0, 0, 1), today.AddDate(0, 0, maxPickupDays)
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"order-service/internal/domain"
+)
+
+// PricingStep works out one part of an order's totals. Steps run in order and each sees the
+// totals set by the ones before it.
+type PricingStep interface {
+	Apply(ctx context.Context, order *domain.Order) error
+}
+
+// PricingStepFunc lets a plain function be used as a PricingStep.
+type PricingStepFunc func(ctx context.Context, order *domain.Order) error
+
+func (f PricingStepFunc) Apply(ctx context.Context, order *domain.Order) error {
+	return f(ctx, order)
+}
+
+// PricingPipeline works out an order's subtotal, discount, tax, shipping and grand total from
+// its priced items.
+type PricingPipeline struct {
+	steps []PricingStep
+}
+
+func NewPricingPipeline(steps ...PricingStep) *PricingPipeline {
+	return &PricingPipeline{
+		steps: steps,
+	}
+}
+
+// Price clears the order's totals, runs every step and sets the grand total.
+func (p *PricingPipeline) Price(ctx context.Context, order *domain.Order) error {
+	order.Subtotal = domain.Money{}
+	order.Discount = domain.Money{}
+	order.Tax = domain.Money{}
+	order.Shipping = domain.Money{}
+
+	for _, step := range p.steps {
+		if err := step.Apply(ctx, order); err != nil {
+			return err
+		}
+	}
+
+	order.Total = order.Subtotal.Sub(order.Discount).Add(order.Tax).Add(order.Shipping)
+	return nil
+}
+
+// SubtotalStep adds up the items at their catalogue prices.
+func SubtotalStep() PricingStep {
+	return PricingStepFunc(func(ctx context.Context, order *domain.Order) error {
+		var subtotal domain.Money
+		for _, item := range order.Items {
+			subtotal = subtotal.Add(item.Price.Mul(item.Quantity))
+		}
+
+		order.Subtotal = subtotal
+		return nil
+	})
+}
+
+// CouponStep takes the discount of the order's coupon code, if it has one, off the subtotal.
+func CouponStep(couponService CouponService) PricingStep {
+	return PricingStepFunc(func(ctx context.Context, order *domain.Order) error {
+		if order.CouponCode == "" {
+			return nil
+		}
+
+		coupon, discount, err := couponService.ApplyCoupon(ctx, order.CouponCode, order.UserID, order.ID, order.Items, order.ExchangeRate)
+		if err != nil {
+			return err
+		}
+
+		order.CouponCode = coupon.Code
+		order.Discount = discount
+		return nil
+	})
+}
+
+// TaxRates are sales tax rates by region, as fractions (0.19 for 19%). Regions are country
+// codes optionally followed by a subdivision, e.g. "DE" or "US-CA".
+type TaxRates struct {
+	Default float64
+	Regions map[string]float64
+}
+
+// Rate returns the rate for region, falling back to the rate of its country and then to the
+// default rate.
+func (r TaxRates) Rate(region string) float64 {
+	if rate, ok := r.Regions[region]; ok {
+		return rate
+	}
+
+	if country, _, found := strings.Cut(region, "-"); found {
+		if rate, ok := r.Regions[country]; ok {
+			return rate
+		}
+	}
+
+	return r.Default
+}
+
+// TaxStep charges the tax rate of the order's region on the discounted subtotal.
+func TaxStep(rates TaxRates) PricingStep {
+	return PricingStepFunc(func(ctx context.Context, order *domain.Order) error {
+		order.TaxRegion = normalizeTaxRegion(order.TaxRegion)
+
+		taxable := order.Subtotal.Sub(order.Discount)
+		if taxable.Amount <= 0 {
+			return nil
+		}
+
+		order.Tax = taxable.MulRate(rates.Rate(order.TaxRegion))
+		return nil
+	})
+}
+
+// ShippingRates price shipping from the weight of an order. Orders whose discounted subtotal
+// reaches FreeOver ship for free; a zero FreeOver means shipping is always charged. Rates are
+// in the base currency.
+type ShippingRates struct {
+	Base     domain.Money
+	PerKg    domain.Money
+	FreeOver domain.Money
+}
+
+// ShippingStep charges shipping for the total weight of the order's items, converting the
+// rates into the order's currency. Orders collected in store ship for free.
+func ShippingStep(rates ShippingRates) PricingStep {
+	return PricingStepFunc(func(ctx context.Context, order *domain.Order) error {
+		if order.Pickup != nil {
+			return nil
+		}
+
+		freeOver := rates.FreeOver.Convert(order.ExchangeRate, order.Currency)
+		if !freeOver.IsZero() && !order.Subtotal.Sub(order.Discount).LessThan(freeOver) {
+			return nil
+		}
+
+		var weight float64
+		for _, item := range order.Items {
+			weight += item.Weight * float64(item.Quantity)
+		}
+
+		order.Shipping = rates.Base.Add(rates.PerKg.MulRate(weight)).Convert(order.ExchangeRate, order.Currency)
+		return nil
+	})
+}
+
+func normalizeTaxRegion(region string) string {
+	return strings.ToUpper(strings.TrimSpace(region))
+}
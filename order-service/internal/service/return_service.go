@@ -0,0 +1,271 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"order-service/internal/domain"
+	"order-service/internal/events"
+	"order-service/internal/payment"
+	"order-service/internal/repository"
+)
+
+var (
+	ErrInvalidReturn    = errors.New("invalid return")
+	ErrReturnNotAllowed = errors.New("return not allowed")
+)
+
+// ReturnService runs returns of delivered orders: requested by the customer, then approved
+// or rejected, received back in the warehouse and finally refunded.
+type ReturnService interface {
+	RequestReturn(ctx context.Context, orderID string, items []domain.ReturnItem, reason string) (domain.Return, error)
+	GetReturn(ctx context.Context, id string) (domain.Return, error)
+	GetOrderReturns(ctx context.Context, orderID string) ([]domain.Return, error)
+	ListReturns(ctx context.Context, filter domain.ReturnFilter) ([]domain.Return, int, error)
+	ApproveReturn(ctx context.Context, id, note string) (domain.Return, error)
+	RejectReturn(ctx context.Context, id, note string) (domain.Return, error)
+	// ReceiveReturn records that the items are back; with restock they go back into inventory.
+	ReceiveReturn(ctx context.Context, id string, restock bool) (domain.Return, error)
+	// RefundReturn gives amount back against the order's payment, or the full value of the
+	// returned items when amount is zero.
+	RefundReturn(ctx context.Context, id string, amount domain.Money) (domain.Return, error)
+}
+
+type returnService struct {
+	returnRepo   repository.ReturnRepository
+	paymentRepo  repository.PaymentRepository
+	orderService OrderService
+	provider     payment.Provider
+}
+
+func NewReturnService(returnRepo repository.ReturnRepository, paymentRepo repository.PaymentRepository, orderService OrderService, provider payment.Provider) ReturnService {
+	return &returnService{
+		returnRepo:   returnRepo,
+		paymentRepo:  paymentRepo,
+		orderService: orderService,
+		provider:     provider,
+	}
+}
+
+func (s *returnService) RequestReturn(ctx context.Context, orderID string, items []domain.ReturnItem, reason string) (domain.Return, error) {
+	if reason == "" {
+		return domain.Return{}, fmt.Errorf("%w: a reason is required", ErrInvalidReturn)
+	}
+
+	if len(items) == 0 {
+		return domain.Return{}, fmt.Errorf("%w: at least one item is required", ErrInvalidReturn)
+	}
+
+	return s.returnRepo.Create(ctx, orderID, func(order domain.Order, returned map[string]int) (domain.Return, error) {
+		if order.Status != domain.OrderStatusDelivered {
+			return domain.Return{}, fmt.Errorf("%w: order is %s, only delivered orders can be returned", ErrReturnNotAllowed, order.Status)
+		}
+
+		returnItems, err := returnItems(order, returned, items)
+		if err != nil {
+			return domain.Return{}, err
+		}
+
+		return domain.Return{
+			Reason: reason,
+			Items:  returnItems,
+		}, nil
+	})
+}
+
+func (s *returnService) GetReturn(ctx context.Context, id string) (domain.Return, error) {
+	return s.returnRepo.GetByID(ctx, id)
+}
+
+func (s *returnService) GetOrderReturns(ctx context.Context, orderID string) ([]domain.Return, error) {
+	// Make sure a missing order is reported as such rather than as having no returns
+	if _, err := s.orderService.GetOrderByID(ctx, orderID); err != nil {
+		return nil, err
+	}
+
+	return s.returnRepo.GetByOrderID(ctx, orderID)
+}
+
+func (s *returnService) ListReturns(ctx context.Context, filter domain.ReturnFilter) ([]domain.Return, int, error) {
+	return s.returnRepo.List(ctx, filter)
+}
+
+func (s *returnService) ApproveReturn(ctx context.Context, id, note string) (domain.Return, error) {
+	return s.resolve(ctx, id, domain.ReturnStatusApproved, note)
+}
+
+func (s *returnService) RejectReturn(ctx context.Context, id, note string) (domain.Return, error) {
+	if note == "" {
+		return domain.Return{}, fmt.Errorf("%w: a note explaining the rejection is required", ErrInvalidReturn)
+	}
+
+	return s.resolve(ctx, id, domain.ReturnStatusRejected, note)
+}
+
+// resolve answers a requested return with status.
+func (s *returnService) resolve(ctx context.Context, id string, status domain.ReturnStatus, note string) (domain.Return, error) {
+	ret, err := s.returnRepo.GetByID(ctx, id)
+	if err != nil {
+		return domain.Return{}, err
+	}
+
+	if ret.Status != domain.ReturnStatusRequested {
+		return domain.Return{}, fmt.Errorf("%w: return is already %s", ErrReturnNotAllowed, ret.Status)
+	}
+
+	ret.Status = status
+	ret.ResolutionNote = note
+	ret.UpdatedAt = time.Now()
+
+	if err := s.returnRepo.UpdateStatus(ctx, ret, domain.ReturnStatusRequested); err != nil {
+		return domain.Return{}, err
+	}
+
+	return ret, nil
+}
+
+func (s *returnService) ReceiveReturn(ctx context.Context, id string, restock bool) (domain.Return, error) {
+	ret, err := s.returnRepo.GetByID(ctx, id)
+	if err != nil {
+		return domain.Return{}, err
+	}
+
+	if ret.Status != domain.ReturnStatusApproved {
+		return domain.Return{}, fmt.Errorf("%w: return is %s, only approved returns can be received", ErrReturnNotAllowed, ret.Status)
+	}
+
+	ret.Status = domain.ReturnStatusReceived
+	ret.Restocked = restock
+	ret.UpdatedAt = time.Now()
+
+	// Damaged items are kept out of stock
+	var messages []domain.OutboxMessage
+	if restock {
+		msg, err := events.OrderReturned(ret)
+		if err != nil {
+			return domain.Return{}, err
+		}
+		messages = append(messages, msg)
+	}
+
+	if err := s.returnRepo.UpdateStatus(ctx, ret, domain.ReturnStatusApproved, messages...); err != nil {
+		return domain.Return{}, err
+	}
+
+	return ret, nil
+}
+
+func (s *returnService) RefundReturn(ctx context.Context, id string, amount domain.Money) (domain.Return, error) {
+	ret, err := s.returnRepo.GetByID(ctx, id)
+	if err != nil {
+		return domain.Return{}, err
+	}
+
+	if ret.Status != domain.ReturnStatusReceived {
+		return domain.Return{}, fmt.Errorf("%w: return is %s, only received returns can be refunded", ErrReturnNotAllowed, ret.Status)
+	}
+
+	value := ret.Value()
+	if amount.IsZero() {
+		amount = value
+	}
+	if amount.Currency != "" && amount.Currency != value.Currency {
+		return domain.Return{}, fmt.Errorf("%w: refund must be in %s, the currency of the order", ErrInvalidReturn, value.Currency)
+	}
+	amount.Currency = value.Currency
+	if amount.IsNegative() || value.LessThan(amount) {
+		return domain.Return{}, fmt.Errorf("%w: refund must be between 0 and %s", ErrInvalidReturn, value)
+	}
+
+	p, err := s.completedPayment(ctx, ret.OrderID)
+	if err != nil {
+		return domain.Return{}, err
+	}
+
+	left := p.Amount.Sub(p.RefundedAmount)
+	if left.LessThan(amount) {
+		return domain.Return{}, fmt.Errorf("%w: only %s of payment %s is left to refund", ErrReturnNotAllowed, left, p.ID)
+	}
+
+	// Reserve the refund on the payment first, so concurrent refunds cannot give back more than
+	// was paid between them
+	ret.Status = domain.ReturnStatusRefunding
+	ret.RefundAmount = amount
+	ret.PaymentID = p.ID
+	ret.UpdatedAt = time.Now()
+
+	if err := s.returnRepo.ReserveRefund(ctx, ret); err != nil {
+		return domain.Return{}, err
+	}
+
+	if err := s.provider.Refund(ctx, p.TransactionID, amount); err != nil {
+		if releaseErr := s.returnRepo.ReleaseRefund(ctx, ret); releaseErr != nil {
+			log.Printf("Failed to release the refund of return %s, it stays refunding: %v", ret.ID, releaseErr)
+		}
+		return domain.Return{}, fmt.Errorf("failed to refund payment: %v", err)
+	}
+
+	ret.Status = domain.ReturnStatusRefunded
+	ret.UpdatedAt = time.Now()
+
+	if err := s.returnRepo.UpdateStatus(ctx, ret, domain.ReturnStatusRefunding); err != nil {
+		// The money has gone back and is on the payment, so the return stays refunding until
+		// this is fixed by hand
+		log.Printf("Refunded %s for return %s via %s but failed to record it: %v", amount, ret.ID, s.provider.Name(), err)
+		return domain.Return{}, err
+	}
+
+	log.Printf("Refunded %s for return %s of order %s against payment %s", amount, ret.ID, ret.OrderID, p.ID)
+	return ret, nil
+}
+
+// completedPayment finds the payment that paid for the order.
+func (s *returnService) completedPayment(ctx context.Context, orderID string) (domain.Payment, error) {
+	payments, err := s.paymentRepo.GetByOrderID(ctx, orderID)
+	if err != nil {
+		return domain.Payment{}, err
+	}
+
+	for _, p := range payments {
+		if p.Status == domain.PaymentStatusCompleted {
+			return p, nil
+		}
+	}
+
+	return domain.Payment{}, fmt.Errorf("%w: order %s has no payment left to refund", ErrReturnNotAllowed, orderID)
+}
+
+// returnItems checks the requested items against what is left to return of the order and
+// prices them as they were sold.
+func returnItems(order domain.Order, returned map[string]int, requested []domain.ReturnItem) ([]domain.ReturnItem, error) {
+	orderItems := make(map[string]domain.OrderItem, len(order.Items))
+	for _, item := range order.Items {
+		orderItems[item.ID] = item
+	}
+
+	var items []domain.ReturnItem
+	for _, item := range requested {
+		orderItem, ok := orderItems[item.OrderItemID]
+		if !ok {
+			return nil, fmt.Errorf("%w: order item %s is not part of the order", ErrInvalidReturn, item.OrderItemID)
+		}
+		if item.Quantity <= 0 {
+			return nil, fmt.Errorf("%w: item quantity must be greater than zero", ErrInvalidReturn)
+		}
+
+		left := orderItem.Quantity - returned[item.OrderItemID]
+		if item.Quantity > left {
+			return nil, fmt.Errorf("%w: only %d of order item %s left to return, got %d",
+				ErrInvalidReturn, left, item.OrderItemID, item.Quantity)
+		}
+
+		// Listing the same item twice must not return more than was bought
+		returned[item.OrderItemID] += item.Quantity
+		items = append(items, order.NewReturnItem(orderItem, item.Quantity))
+	}
+
+	return items, nil
+}
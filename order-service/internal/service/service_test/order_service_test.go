@@ -0,0 +1,78 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"order-service/internal/domain"
+	"order-service/internal/repository"
+	"order-service/internal/service"
+)
+
+// fakeOrderRepository serves the orders created with an idempotency key
+type fakeOrderRepository struct {
+	repository.OrderRepository
+	byKey map[string]domain.Order
+}
+
+func (r *fakeOrderRepository) GetByIdempotencyKey(ctx context.Context, userID, key string) (domain.Order, error) {
+	order, ok := r.byKey[userID+":"+key]
+	if !ok {
+		return domain.Order{}, repository.ErrOrderNotFound
+	}
+	return order, nil
+}
+
+func orderRequest(quantity int) domain.Order {
+	return domain.Order{
+		UserID:         "user-1",
+		IdempotencyKey: "key-1",
+		Currency:       "USD",
+		Items: []domain.OrderItem{
+			{ProductID: "bike-1", Quantity: quantity, Price: domain.NewMoney(50000, "USD")},
+		},
+	}
+}
+
+func TestCreateOrder_IdempotencyKey(t *testing.T) {
+	existing := orderRequest(1)
+	existing.ID = "order-1"
+	existing.IdempotencyFingerprint = existing.RequestFingerprint()
+
+	legacy := orderRequest(1)
+	legacy.ID = "order-legacy"
+
+	tests := []struct {
+		name     string
+		existing domain.Order
+		request  domain.Order
+		wantID   string
+		wantErr  error
+	}{
+		{name: "retry gets the order back", existing: existing, request: orderRequest(1), wantID: "order-1"},
+		{name: "different order is refused", existing: existing, request: orderRequest(2), wantErr: service.ErrIdempotencyKeyReused},
+		{name: "order stored without a fingerprint is taken on trust", existing: legacy, request: orderRequest(2), wantID: "order-legacy"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orders := &fakeOrderRepository{byKey: map[string]domain.Order{"user-1:key-1": tt.existing}}
+			orderService := service.NewOrderService(orders, nil, nil, nil, nil)
+
+			order, err := orderService.CreateOrder(context.Background(), tt.request)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if order.ID != tt.wantID {
+				t.Errorf("Expected order %s, got %s", tt.wantID, order.ID)
+			}
+		})
+	}
+}
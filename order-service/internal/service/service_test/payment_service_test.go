@@ -0,0 +1,287 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"order-service/internal/domain"
+	"order-service/internal/payment"
+	"order-service/internal/repository"
+	"order-service/internal/service"
+)
+
+// fakePaymentRepository keeps payments in memory with the same conditional updates as the
+// database
+type fakePaymentRepository struct {
+	repository.PaymentRepository
+	mu       sync.Mutex
+	payments map[string]domain.Payment
+}
+
+func (r *fakePaymentRepository) GetByID(ctx context.Context, id string) (domain.Payment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p, ok := r.payments[id]
+	if !ok {
+		return domain.Payment{}, errors.New("payment not found")
+	}
+	return p, nil
+}
+
+func (r *fakePaymentRepository) GetByOrderID(ctx context.Context, orderID string) ([]domain.Payment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var payments []domain.Payment
+	for _, p := range r.payments {
+		if p.OrderID == orderID {
+			payments = append(payments, p)
+		}
+	}
+	return payments, nil
+}
+
+func (r *fakePaymentRepository) ClaimRefund(ctx context.Context, payment domain.Payment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p := r.payments[payment.ID]
+	if p.Status != payment.Status || p.RefundedAmount.Amount != payment.RefundedAmount.Amount {
+		return repository.ErrPaymentChanged
+	}
+	p.Status = domain.PaymentStatusRefunding
+	r.payments[p.ID] = p
+	return nil
+}
+
+func (r *fakePaymentRepository) CompleteRefund(ctx context.Context, id string) error {
+	return r.moveFromRefunding(id, domain.PaymentStatusRefunded, true)
+}
+
+func (r *fakePaymentRepository) ReleaseRefund(ctx context.Context, id string, status domain.PaymentStatus) error {
+	return r.moveFromRefunding(id, status, false)
+}
+
+func (r *fakePaymentRepository) moveFromRefunding(id string, status domain.PaymentStatus, refunded bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p := r.payments[id]
+	if p.Status != domain.PaymentStatusRefunding {
+		return repository.ErrPaymentChanged
+	}
+	p.Status = status
+	if refunded {
+		p.RefundedAmount = p.Amount
+	}
+	r.payments[id] = p
+	return nil
+}
+
+// fakeProvider records refunds; during, when set, runs while a refund is with the provider
+type fakeProvider struct {
+	payment.Provider
+	refunds []domain.Money
+	fail    bool
+	during  func()
+}
+
+func (p *fakeProvider) Name() string { return "fake" }
+
+func (p *fakeProvider) Refund(ctx context.Context, transactionID string, amount domain.Money) error {
+	if p.during != nil {
+		during := p.during
+		p.during = nil
+		during()
+	}
+	if p.fail {
+		return errors.New("provider unavailable")
+	}
+	p.refunds = append(p.refunds, amount)
+	return nil
+}
+
+// fakeOrders serves one paid order and records its cancellation
+type fakeOrders struct {
+	service.OrderService
+	order     domain.Order
+	cancelled int
+}
+
+func (o *fakeOrders) GetOrderByID(ctx context.Context, id string) (domain.Order, error) {
+	return o.order, nil
+}
+
+func (o *fakeOrders) CancelRefundedOrder(ctx context.Context, id string, actor domain.Actor, reason string) error {
+	o.cancelled++
+	o.order.Status = domain.OrderStatusCancelled
+	return nil
+}
+
+func newRefundFixture(refunded int64) (*fakePaymentRepository, *fakeProvider, *fakeOrders, service.PaymentService) {
+	payments := &fakePaymentRepository{payments: map[string]domain.Payment{
+		"payment-1": {
+			ID:             "payment-1",
+			OrderID:        "order-1",
+			Amount:         domain.NewMoney(5000, "USD"),
+			RefundedAmount: domain.NewMoney(refunded, "USD"),
+			Status:         domain.PaymentStatusCompleted,
+			TransactionID:  "txn-1",
+		},
+	}}
+	provider := &fakeProvider{}
+	orders := &fakeOrders{order: domain.Order{ID: "order-1", Status: domain.OrderStatusPaid}}
+	return payments, provider, orders, service.NewPaymentService(payments, orders, provider)
+}
+
+func TestRefundPayment_RefundsWhatIsLeft(t *testing.T) {
+	payments, provider, orders, paymentService := newRefundFixture(1200)
+
+	refunded, err := paymentService.RefundPayment(context.Background(), "payment-1", domain.SystemActor, "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(provider.refunds) != 1 || provider.refunds[0].Amount != 3800 {
+		t.Errorf("Expected one refund of 3800, got %v", provider.refunds)
+	}
+	if refunded.Status != domain.PaymentStatusRefunded || refunded.RefundedAmount.Amount != 5000 {
+		t.Errorf("Expected the payment refunded in full, got %s with %d", refunded.Status, refunded.RefundedAmount.Amount)
+	}
+	if stored := payments.payments["payment-1"]; stored.Status != domain.PaymentStatusRefunded {
+		t.Errorf("Expected the stored payment refunded, got %s", stored.Status)
+	}
+	if orders.cancelled != 1 {
+		t.Errorf("Expected the paid order to be cancelled once, got %d", orders.cancelled)
+	}
+}
+
+func TestRefundPayment_ConcurrentRefundDoesNotPayOutTwice(t *testing.T) {
+	_, provider, _, payments := newRefundFixture(0)
+
+	var concurrentErr error
+	provider.during = func() {
+		_, concurrentErr = payments.RefundPayment(context.Background(), "payment-1", domain.SystemActor, "")
+	}
+
+	if _, err := payments.RefundPayment(context.Background(), "payment-1", domain.SystemActor, ""); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !errors.Is(concurrentErr, service.ErrPaymentNotRefundable) {
+		t.Errorf("Expected the concurrent refund to be refused, got %v", concurrentErr)
+	}
+	if len(provider.refunds) != 1 {
+		t.Errorf("Expected one refund at the provider, got %d", len(provider.refunds))
+	}
+}
+
+func TestRefundPayment_ReturnRefundedAfterReadWins(t *testing.T) {
+	payments, provider, _, paymentService := newRefundFixture(0)
+
+	// A return is refunded between the payment being read and the refund being claimed
+	stale := payments.payments["payment-1"]
+	p := stale
+	p.RefundedAmount = domain.NewMoney(1000, "USD")
+	payments.payments["payment-1"] = p
+
+	err := payments.ClaimRefund(context.Background(), stale)
+	if !errors.Is(err, repository.ErrPaymentChanged) {
+		t.Fatalf("Expected ErrPaymentChanged for a stale claim, got %v", err)
+	}
+
+	// Read afresh, only what is left is refunded
+	if _, err := paymentService.RefundPayment(context.Background(), "payment-1", domain.SystemActor, ""); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(provider.refunds) != 1 || provider.refunds[0].Amount != 4000 {
+		t.Errorf("Expected one refund of 4000, got %v", provider.refunds)
+	}
+}
+
+func TestRefundPayment_ProviderFailureReleasesClaim(t *testing.T) {
+	payments, provider, orders, paymentService := newRefundFixture(0)
+	provider.fail = true
+
+	if _, err := paymentService.RefundPayment(context.Background(), "payment-1", domain.SystemActor, ""); err == nil {
+		t.Fatal("Expected the refund to fail")
+	}
+	if stored := payments.payments["payment-1"]; stored.Status != domain.PaymentStatusCompleted || !stored.RefundedAmount.IsZero() {
+		t.Errorf("Expected the payment back to completed, got %s with %d refunded", stored.Status, stored.RefundedAmount.Amount)
+	}
+	if orders.cancelled != 0 {
+		t.Errorf("Expected the order to stay paid, got %d cancellations", orders.cancelled)
+	}
+
+	// Once the provider is back the refund can be retried
+	provider.fail = false
+	if _, err := paymentService.RefundPayment(context.Background(), "payment-1", domain.SystemActor, ""); err != nil {
+		t.Fatalf("Unexpected error on retry: %v", err)
+	}
+	if len(provider.refunds) != 1 {
+		t.Errorf("Expected one refund, got %d", len(provider.refunds))
+	}
+}
+
+func TestRefundCancelledOrder_RefundsPaidOrder(t *testing.T) {
+	payments, provider, orders, paymentService := newRefundFixture(0)
+	orders.order.Status = domain.OrderStatusCancelled
+
+	if err := paymentService.RefundCancelledOrder(context.Background(), "order-1"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(provider.refunds) != 1 || provider.refunds[0].Amount != 5000 {
+		t.Errorf("Expected one refund of 5000, got %v", provider.refunds)
+	}
+	if stored := payments.payments["payment-1"]; stored.Status != domain.PaymentStatusRefunded {
+		t.Errorf("Expected the payment refunded, got %s", stored.Status)
+	}
+
+	// A redelivered event finds nothing left to refund
+	if err := paymentService.RefundCancelledOrder(context.Background(), "order-1"); err != nil {
+		t.Fatalf("Unexpected error on redelivery: %v", err)
+	}
+	if len(provider.refunds) != 1 {
+		t.Errorf("Expected still one refund, got %d", len(provider.refunds))
+	}
+}
+
+func TestRefundCancelledOrder_FlagsRefusedRefund(t *testing.T) {
+	payments, provider, orders, paymentService := newRefundFixture(0)
+	orders.order.Status = domain.OrderStatusCancelled
+	provider.fail = true
+
+	if err := paymentService.RefundCancelledOrder(context.Background(), "order-1"); err != nil {
+		t.Fatalf("Expected a refused refund to be flagged, got %v", err)
+	}
+	if stored := payments.payments["payment-1"]; stored.Status != domain.PaymentStatusRefundFailed {
+		t.Fatalf("Expected the payment flagged refund_failed, got %s", stored.Status)
+	}
+
+	// The flagged payment is refunded by hand
+	provider.fail = false
+	refunded, err := paymentService.RefundPayment(context.Background(), "payment-1", domain.SystemActor, "")
+	if err != nil {
+		t.Fatalf("Unexpected error refunding by hand: %v", err)
+	}
+	if refunded.Status != domain.PaymentStatusRefunded {
+		t.Errorf("Expected the payment refunded, got %s", refunded.Status)
+	}
+	if orders.cancelled != 0 {
+		t.Errorf("Expected the cancelled order to be left alone, got %d cancellations", orders.cancelled)
+	}
+}
+
+func TestRefundCancelledOrder_RejectsOrderNotCancelled(t *testing.T) {
+	_, provider, _, paymentService := newRefundFixture(0)
+
+	if err := paymentService.RefundCancelledOrder(context.Background(), "order-1"); err == nil {
+		t.Fatal("Expected an error for a paid order")
+	}
+	if len(provider.refunds) != 0 {
+		t.Errorf("Expected no refunds, got %d", len(provider.refunds))
+	}
+}
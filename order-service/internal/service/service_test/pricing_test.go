@@ -0,0 +1,244 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"order-service/internal/domain"
+	"order-service/internal/repository"
+	"order-service/internal/service"
+)
+
+// fakeCouponRepository serves coupons by code; only the lookups pricing needs are used
+type fakeCouponRepository struct {
+	repository.CouponRepository
+	coupons map[string]domain.Coupon
+}
+
+func (r *fakeCouponRepository) GetByCode(ctx context.Context, code string) (domain.Coupon, error) {
+	coupon, ok := r.coupons[code]
+	if !ok {
+		return domain.Coupon{}, repository.ErrCouponNotFound
+	}
+	return coupon, nil
+}
+
+func newTestPipeline() *service.PricingPipeline {
+	coupons := service.NewCouponService(&fakeCouponRepository{coupons: map[string]domain.Coupon{
+		"TENOFF": {
+			Code:            "TENOFF",
+			DiscountType:    domain.DiscountTypePercentage,
+			DiscountPercent: 10,
+			Active:          true,
+		},
+		"FIFTY": {
+			Code:           "FIFTY",
+			DiscountType:   domain.DiscountTypeFixed,
+			DiscountAmount: domain.NewMoney(5000, "USD"),
+			Active:         true,
+		},
+		"TEN": {
+			Code:           "TEN",
+			DiscountType:   domain.DiscountTypeFixed,
+			DiscountAmount: domain.NewMoney(1000, "USD"),
+			Active:         true,
+		},
+	}}, "USD")
+
+	return service.NewPricingPipeline(
+		service.SubtotalStep(),
+		service.CouponStep(coupons),
+		service.TaxStep(service.TaxRates{
+			Default: 0.2,
+			Regions: map[string]float64{"DE": 0.19, "US": 0, "US-CA": 0.0725},
+		}),
+		service.ShippingStep(service.ShippingRates{
+			Base:     domain.NewMoney(500, "USD"),
+			PerKg:    domain.NewMoney(150, "USD"),
+			FreeOver: domain.NewMoney(10000, "USD"),
+		}),
+	)
+}
+
+func item(price int64, currency string, quantity int, weight float64) domain.OrderItem {
+	return domain.OrderItem{
+		ProductID: "product-1",
+		Price:     domain.NewMoney(price, currency),
+		Quantity:  quantity,
+		Weight:    weight,
+	}
+}
+
+func TestTaxRatesRate(t *testing.T) {
+	rates := service.TaxRates{
+		Default: 0.2,
+		Regions: map[string]float64{"DE": 0.19, "US": 0, "US-CA": 0.0725},
+	}
+
+	tests := []struct {
+		region string
+		want   float64
+	}{
+		{region: "DE", want: 0.19},
+		{region: "US-CA", want: 0.0725},
+		{region: "DE-BY", want: 0.19},
+		{region: "US-NY", want: 0},
+		{region: "FR", want: 0.2},
+		{region: "FR-IDF", want: 0.2},
+		{region: "", want: 0.2},
+	}
+
+	for _, tt := range tests {
+		if got := rates.Rate(tt.region); got != tt.want {
+			t.Errorf("Rate(%q) = %v, want %v", tt.region, got, tt.want)
+		}
+	}
+}
+
+func TestPricingPipelinePrice(t *testing.T) {
+	tests := []struct {
+		name  string
+		order domain.Order
+		want  [5]int64 // subtotal, discount, tax, shipping, total
+	}{
+		{
+			name: "taxed and shipped by weight",
+			order: domain.Order{
+				Currency: "USD", ExchangeRate: 1, TaxRegion: "DE",
+				Items: []domain.OrderItem{item(1999, "USD", 2, 1.5)},
+			},
+			want: [5]int64{3998, 0, 760, 950, 5708},
+		},
+		{
+			name: "fixed discount is capped at the eligible amount",
+			order: domain.Order{
+				Currency: "USD", ExchangeRate: 1, TaxRegion: "DE", CouponCode: "fifty",
+				Items: []domain.OrderItem{item(1999, "USD", 2, 0)},
+			},
+			want: [5]int64{3998, 3998, 0, 500, 500},
+		},
+		{
+			name: "percentage discount for a pickup order",
+			order: domain.Order{
+				Currency: "USD", ExchangeRate: 1, TaxRegion: "DE", CouponCode: "TENOFF",
+				Pickup: &domain.Pickup{StoreID: "store-1", Date: "2026-10-20"},
+				Items:  []domain.OrderItem{item(1999, "USD", 2, 4)},
+			},
+			want: [5]int64{3998, 400, 684, 0, 4282},
+		},
+		{
+			name: "unknown region pays the default rate",
+			order: domain.Order{
+				Currency: "USD", ExchangeRate: 1, TaxRegion: "FR",
+				Items: []domain.OrderItem{item(999, "USD", 1, 0)},
+			},
+			want: [5]int64{999, 0, 200, 500, 1699},
+		},
+		{
+			name: "zero weight pays the base rate",
+			order: domain.Order{
+				Currency: "USD", ExchangeRate: 1, TaxRegion: "US",
+				Items: []domain.OrderItem{item(2500, "USD", 3, 0)},
+			},
+			want: [5]int64{7500, 0, 0, 500, 8000},
+		},
+		{
+			name: "ships free over the threshold",
+			order: domain.Order{
+				Currency: "USD", ExchangeRate: 1, TaxRegion: "US-CA",
+				Items: []domain.OrderItem{item(6000, "USD", 2, 10)},
+			},
+			want: [5]int64{12000, 0, 870, 0, 12870},
+		},
+		{
+			name: "discount can bring an order under the free shipping threshold",
+			order: domain.Order{
+				Currency: "USD", ExchangeRate: 1, TaxRegion: "US", CouponCode: "TENOFF",
+				Items: []domain.OrderItem{item(10000, "USD", 1, 2)},
+			},
+			want: [5]int64{10000, 1000, 0, 800, 9800},
+		},
+		{
+			name: "rates and fixed discounts are converted into the order's currency",
+			order: domain.Order{
+				Currency: "EUR", ExchangeRate: 0.92, TaxRegion: "DE", CouponCode: "TEN",
+				Items: []domain.OrderItem{item(5000, "EUR", 1, 0)},
+			},
+			want: [5]int64{5000, 920, 775, 460, 5315},
+		},
+		{
+			name: "grand total adds up the rounded parts",
+			order: domain.Order{
+				Currency: "EUR", ExchangeRate: 0.9235, TaxRegion: " de-by ",
+				Items: []domain.OrderItem{item(1001, "EUR", 1, 2.5)},
+			},
+			want: [5]int64{1001, 0, 190, 808, 1999},
+		},
+	}
+
+	pipeline := newTestPipeline()
+	for _, tt := range tests {
+		order := tt.order
+		order.UserID = "user-1"
+		if err := pipeline.Price(context.Background(), &order); err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.name, err)
+			continue
+		}
+
+		got := [5]int64{order.Subtotal.Amount, order.Discount.Amount, order.Tax.Amount, order.Shipping.Amount, order.Total.Amount}
+		if got != tt.want {
+			t.Errorf("%s: got subtotal, discount, tax, shipping, total %v, want %v", tt.name, got, tt.want)
+		}
+		if order.Total.Currency != order.Currency {
+			t.Errorf("%s: expected the total in %s, got %s", tt.name, order.Currency, order.Total.Currency)
+		}
+	}
+}
+
+func TestPricingPipelineNormalizesTaxRegion(t *testing.T) {
+	order := domain.Order{
+		Currency: "USD", ExchangeRate: 1, TaxRegion: " us-ca ",
+		Items: []domain.OrderItem{item(1000, "USD", 1, 0)},
+	}
+
+	if err := newTestPipeline().Price(context.Background(), &order); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if order.TaxRegion != "US-CA" {
+		t.Errorf("Expected tax region US-CA, got %q", order.TaxRegion)
+	}
+}
+
+func TestPricingPipelineRepricesFromScratch(t *testing.T) {
+	order := domain.Order{
+		Currency: "USD", ExchangeRate: 1, TaxRegion: "US",
+		Pickup:   &domain.Pickup{StoreID: "store-1", Date: "2026-10-20"},
+		Discount: domain.NewMoney(300, "USD"),
+		Shipping: domain.NewMoney(700, "USD"),
+		Items:    []domain.OrderItem{item(1000, "USD", 1, 0)},
+	}
+
+	if err := newTestPipeline().Price(context.Background(), &order); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !order.Discount.IsZero() || !order.Shipping.IsZero() || order.Total.Amount != 1000 {
+		t.Errorf("Expected stale totals to be cleared, got discount %d, shipping %d, total %d",
+			order.Discount.Amount, order.Shipping.Amount, order.Total.Amount)
+	}
+}
+
+func TestPricingPipelineStopsAtFailingStep(t *testing.T) {
+	order := domain.Order{
+		Currency: "USD", ExchangeRate: 1, CouponCode: "NOPE",
+		Items: []domain.OrderItem{item(1000, "USD", 1, 0)},
+	}
+
+	err := newTestPipeline().Price(context.Background(), &order)
+	if !errors.Is(err, service.ErrCouponNotApplicable) {
+		t.Fatalf("Expected ErrCouponNotApplicable, got %v", err)
+	}
+	if !order.Total.IsZero() {
+		t.Errorf("Expected no total for a failed pricing, got %d", order.Total.Amount)
+	}
+}
@@ -0,0 +1,138 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"order-service/internal/domain"
+	"order-service/internal/repository"
+	"order-service/internal/service"
+)
+
+// fakeReturnRepository keeps one return and reserves its refunds on a fakePaymentRepository
+type fakeReturnRepository struct {
+	repository.ReturnRepository
+	mu       sync.Mutex
+	ret      domain.Return
+	payments *fakePaymentRepository
+}
+
+func (r *fakeReturnRepository) GetByID(ctx context.Context, id string) (domain.Return, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.ret, nil
+}
+
+func (r *fakeReturnRepository) UpdateStatus(ctx context.Context, ret domain.Return, from domain.ReturnStatus, messages ...domain.OutboxMessage) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ret.Status != from {
+		return errors.New("return status was changed concurrently")
+	}
+	r.ret = ret
+	return nil
+}
+
+func (r *fakeReturnRepository) ReserveRefund(ctx context.Context, ret domain.Return) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ret.Status != domain.ReturnStatusReceived {
+		return errors.New("return status was changed concurrently")
+	}
+
+	r.payments.mu.Lock()
+	defer r.payments.mu.Unlock()
+
+	p := r.payments.payments[ret.PaymentID]
+	p.RefundedAmount = p.RefundedAmount.Add(ret.RefundAmount)
+	if p.Amount.LessThan(p.RefundedAmount) {
+		return errors.New("refund exceeds what is left of the payment")
+	}
+	r.payments.payments[p.ID] = p
+	r.ret = ret
+	return nil
+}
+
+func (r *fakeReturnRepository) ReleaseRefund(ctx context.Context, ret domain.Return) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.payments.mu.Lock()
+	defer r.payments.mu.Unlock()
+
+	p := r.payments.payments[ret.PaymentID]
+	p.RefundedAmount = p.RefundedAmount.Sub(ret.RefundAmount)
+	r.payments.payments[p.ID] = p
+
+	ret.Status = domain.ReturnStatusReceived
+	ret.RefundAmount = domain.Money{}
+	ret.PaymentID = ""
+	r.ret = ret
+	return nil
+}
+
+func newReturnFixture() (*fakeReturnRepository, *fakePaymentRepository, *fakeProvider, service.ReturnService) {
+	payments, provider, orders, _ := newRefundFixture(0)
+	returns := &fakeReturnRepository{
+		payments: payments,
+		ret: domain.Return{
+			ID:      "return-1",
+			OrderID: "order-1",
+			Status:  domain.ReturnStatusReceived,
+			Items: []domain.ReturnItem{
+				{OrderItemID: "item-1", ProductID: "product-1", Quantity: 2, Price: domain.NewMoney(1500, "USD")},
+			},
+		},
+	}
+	return returns, payments, provider, service.NewReturnService(returns, payments, orders, provider)
+}
+
+func TestRefundReturn_ReservesBeforeRefunding(t *testing.T) {
+	returns, payments, provider, returnService := newReturnFixture()
+
+	var concurrentErr error
+	provider.during = func() {
+		if status := returns.ret.Status; status != domain.ReturnStatusRefunding {
+			t.Errorf("Expected the return refunding while the provider is asked, got %s", status)
+		}
+		_, concurrentErr = returnService.RefundReturn(context.Background(), "return-1", domain.Money{})
+	}
+
+	ret, err := returnService.RefundReturn(context.Background(), "return-1", domain.Money{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !errors.Is(concurrentErr, service.ErrReturnNotAllowed) {
+		t.Errorf("Expected the concurrent refund to be refused, got %v", concurrentErr)
+	}
+	if len(provider.refunds) != 1 || provider.refunds[0].Amount != 3000 {
+		t.Errorf("Expected one refund of 3000, got %v", provider.refunds)
+	}
+	if ret.Status != domain.ReturnStatusRefunded || returns.ret.Status != domain.ReturnStatusRefunded {
+		t.Errorf("Expected the return refunded, got %s", returns.ret.Status)
+	}
+	if refunded := payments.payments["payment-1"].RefundedAmount; refunded.Amount != 3000 {
+		t.Errorf("Expected 3000 refunded on the payment, got %d", refunded.Amount)
+	}
+}
+
+func TestRefundReturn_ProviderFailureReleasesReservation(t *testing.T) {
+	returns, payments, provider, returnService := newReturnFixture()
+	provider.fail = true
+
+	if _, err := returnService.RefundReturn(context.Background(), "return-1", domain.Money{}); err == nil {
+		t.Fatal("Expected the refund to fail")
+	}
+
+	if returns.ret.Status != domain.ReturnStatusReceived {
+		t.Errorf("Expected the return back to received, got %s", returns.ret.Status)
+	}
+	if refunded := payments.payments["payment-1"].RefundedAmount; !refunded.IsZero() {
+		t.Errorf("Expected nothing refunded on the payment, got %d", refunded.Amount)
+	}
+}
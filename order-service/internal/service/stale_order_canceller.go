@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// StaleOrderCanceller cancels orders that stay pending for longer than maxAge.
+// It is safe to run on every replica since each order is claimed by one run.
+type StaleOrderCanceller struct {
+	orderService OrderService
+	maxAge       time.Duration
+	interval     time.Duration
+	batchSize    int
+}
+
+func NewStaleOrderCanceller(orderService OrderService, maxAge, interval time.Duration, batchSize int) *StaleOrderCanceller {
+	return &StaleOrderCanceller{
+		orderService: orderService,
+		maxAge:       maxAge,
+		interval:     interval,
+		batchSize:    batchSize,
+	}
+}
+
+// Run cancels stale orders until ctx is cancelled.
+func (c *StaleOrderCanceller) Run(ctx context.Context) {
+	log.Printf("[STALE-ORDERS] Starting, cancelling orders pending for over %s every %s", c.maxAge, c.interval)
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		// Keep cancelling while full batches come back
+		for {
+			cancelled, err := c.orderService.CancelStaleOrders(ctx, c.maxAge, c.batchSize)
+			if err != nil {
+				log.Printf("[STALE-ORDERS] Failed to cancel stale orders: %v", err)
+				break
+			}
+			if cancelled > 0 {
+				log.Printf("[STALE-ORDERS] Cancelled %d unpaid orders", cancelled)
+			}
+			if cancelled < c.batchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Printf("[STALE-ORDERS] Stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	money "proto/money"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -23,8 +24,10 @@ const (
 )
 
 type ProductIDRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Currency to show prices in for GetProduct; empty for the base currency
+	Currency      string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -66,18 +69,27 @@ func (x *ProductIDRequest) GetId() string {
 	return ""
 }
 
+func (x *ProductIDRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type CreateProductRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
-	Stock         int32                  `protobuf:"varint,4,opt,name=stock,proto3" json:"stock,omitempty"`
-	CategoryId    string                 `protobuf:"bytes,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	FrameSize     string                 `protobuf:"bytes,6,opt,name=frame_size,json=frameSize,proto3" json:"frame_size,omitempty"`
-	WheelSize     string                 `protobuf:"bytes,7,opt,name=wheel_size,json=wheelSize,proto3" json:"wheel_size,omitempty"`
-	Color         string                 `protobuf:"bytes,8,opt,name=color,proto3" json:"color,omitempty"`
-	Weight        float64                `protobuf:"fixed64,9,opt,name=weight,proto3" json:"weight,omitempty"`
-	BikeType      string                 `protobuf:"bytes,10,opt,name=bike_type,json=bikeType,proto3" json:"bike_type,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Price       float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	Stock       int32                  `protobuf:"varint,4,opt,name=stock,proto3" json:"stock,omitempty"`
+	CategoryId  string                 `protobuf:"bytes,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	FrameSize   string                 `protobuf:"bytes,6,opt,name=frame_size,json=frameSize,proto3" json:"frame_size,omitempty"`
+	WheelSize   string                 `protobuf:"bytes,7,opt,name=wheel_size,json=wheelSize,proto3" json:"wheel_size,omitempty"`
+	Color       string                 `protobuf:"bytes,8,opt,name=color,proto3" json:"color,omitempty"`
+	Weight      float64                `protobuf:"fixed64,9,opt,name=weight,proto3" json:"weight,omitempty"`
+	BikeType    string                 `protobuf:"bytes,10,opt,name=bike_type,json=bikeType,proto3" json:"bike_type,omitempty"`
+	// Exact price; when unset the price field is used
+	PriceMoney    *money.Money `protobuf:"bytes,11,opt,name=price_money,json=priceMoney,proto3" json:"price_money,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -182,19 +194,29 @@ func (x *CreateProductRequest) GetBikeType() string {
 	return ""
 }
 
+func (x *CreateProductRequest) GetPriceMoney() *money.Money {
+	if x != nil {
+		return x.PriceMoney
+	}
+	return nil
+}
+
 type UpdateProductRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	Stock         int32                  `protobuf:"varint,5,opt,name=stock,proto3" json:"stock,omitempty"`
-	CategoryId    string                 `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	FrameSize     string                 `protobuf:"bytes,7,opt,name=frame_size,json=frameSize,proto3" json:"frame_size,omitempty"`
-	WheelSize     string                 `protobuf:"bytes,8,opt,name=wheel_size,json=wheelSize,proto3" json:"wheel_size,omitempty"`
-	Color         string                 `protobuf:"bytes,9,opt,name=color,proto3" json:"color,omitempty"`
-	Weight        float64                `protobuf:"fixed64,10,opt,name=weight,proto3" json:"weight,omitempty"`
-	BikeType      string                 `protobuf:"bytes,11,opt,name=bike_type,json=bikeType,proto3" json:"bike_type,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Price       float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	// Stock on hand, including units reserved for unpaid orders.
+	Stock      int32   `protobuf:"varint,5,opt,name=stock,proto3" json:"stock,omitempty"`
+	CategoryId string  `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	FrameSize  string  `protobuf:"bytes,7,opt,name=frame_size,json=frameSize,proto3" json:"frame_size,omitempty"`
+	WheelSize  string  `protobuf:"bytes,8,opt,name=wheel_size,json=wheelSize,proto3" json:"wheel_size,omitempty"`
+	Color      string  `protobuf:"bytes,9,opt,name=color,proto3" json:"color,omitempty"`
+	Weight     float64 `protobuf:"fixed64,10,opt,name=weight,proto3" json:"weight,omitempty"`
+	BikeType   string  `protobuf:"bytes,11,opt,name=bike_type,json=bikeType,proto3" json:"bike_type,omitempty"`
+	// Exact price; when unset the price field is used
+	PriceMoney    *money.Money `protobuf:"bytes,12,opt,name=price_money,json=priceMoney,proto3" json:"price_money,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -306,21 +328,36 @@ func (x *UpdateProductRequest) GetBikeType() string {
 	return ""
 }
 
+func (x *UpdateProductRequest) GetPriceMoney() *money.Money {
+	if x != nil {
+		return x.PriceMoney
+	}
+	return nil
+}
+
 type ProductResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	Stock         int32                  `protobuf:"varint,5,opt,name=stock,proto3" json:"stock,omitempty"`
-	CategoryId    string                 `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	FrameSize     string                 `protobuf:"bytes,7,opt,name=frame_size,json=frameSize,proto3" json:"frame_size,omitempty"`
-	WheelSize     string                 `protobuf:"bytes,8,opt,name=wheel_size,json=wheelSize,proto3" json:"wheel_size,omitempty"`
-	Color         string                 `protobuf:"bytes,9,opt,name=color,proto3" json:"color,omitempty"`
-	Weight        float64                `protobuf:"fixed64,10,opt,name=weight,proto3" json:"weight,omitempty"`
-	BikeType      string                 `protobuf:"bytes,11,opt,name=bike_type,json=bikeType,proto3" json:"bike_type,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Price       float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	// Stock on hand, including units reserved for unpaid orders, as set by UpdateProductRequest.
+	Stock      int32                  `protobuf:"varint,5,opt,name=stock,proto3" json:"stock,omitempty"`
+	CategoryId string                 `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	FrameSize  string                 `protobuf:"bytes,7,opt,name=frame_size,json=frameSize,proto3" json:"frame_size,omitempty"`
+	WheelSize  string                 `protobuf:"bytes,8,opt,name=wheel_size,json=wheelSize,proto3" json:"wheel_size,omitempty"`
+	Color      string                 `protobuf:"bytes,9,opt,name=color,proto3" json:"color,omitempty"`
+	Weight     float64                `protobuf:"fixed64,10,opt,name=weight,proto3" json:"weight,omitempty"`
+	BikeType   string                 `protobuf:"bytes,11,opt,name=bike_type,json=bikeType,proto3" json:"bike_type,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Reserved   int32                  `protobuf:"varint,14,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	// Exact price; price carries the same amount as a decimal for older clients
+	PriceMoney *money.Money `protobuf:"bytes,15,opt,name=price_money,json=priceMoney,proto3" json:"price_money,omitempty"`
+	// Rate the base currency price was converted at; 1 for the base currency
+	ExchangeRate float64 `protobuf:"fixed64,16,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	// Stock that can still be ordered: stock less reserved.
+	Available     int32 `protobuf:"varint,17,opt,name=available,proto3" json:"available,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -446,19 +483,50 @@ func (x *ProductResponse) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *ProductResponse) GetReserved() int32 {
+	if x != nil {
+		return x.Reserved
+	}
+	return 0
+}
+
+func (x *ProductResponse) GetPriceMoney() *money.Money {
+	if x != nil {
+		return x.PriceMoney
+	}
+	return nil
+}
+
+func (x *ProductResponse) GetExchangeRate() float64 {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return 0
+}
+
+func (x *ProductResponse) GetAvailable() int32 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
 type ProductFilter struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CategoryId    string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	MinPrice      float64                `protobuf:"fixed64,2,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
-	MaxPrice      float64                `protobuf:"fixed64,3,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
-	InStock       bool                   `protobuf:"varint,4,opt,name=in_stock,json=inStock,proto3" json:"in_stock,omitempty"`
-	BikeType      string                 `protobuf:"bytes,5,opt,name=bike_type,json=bikeType,proto3" json:"bike_type,omitempty"`
-	FrameSize     string                 `protobuf:"bytes,6,opt,name=frame_size,json=frameSize,proto3" json:"frame_size,omitempty"`
-	WheelSize     string                 `protobuf:"bytes,7,opt,name=wheel_size,json=wheelSize,proto3" json:"wheel_size,omitempty"`
-	Color         string                 `protobuf:"bytes,8,opt,name=color,proto3" json:"color,omitempty"`
-	MaxWeight     float64                `protobuf:"fixed64,9,opt,name=max_weight,json=maxWeight,proto3" json:"max_weight,omitempty"`
-	Page          int32                  `protobuf:"varint,10,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,11,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	MinPrice   float64                `protobuf:"fixed64,2,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	MaxPrice   float64                `protobuf:"fixed64,3,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	InStock    bool                   `protobuf:"varint,4,opt,name=in_stock,json=inStock,proto3" json:"in_stock,omitempty"`
+	BikeType   string                 `protobuf:"bytes,5,opt,name=bike_type,json=bikeType,proto3" json:"bike_type,omitempty"`
+	FrameSize  string                 `protobuf:"bytes,6,opt,name=frame_size,json=frameSize,proto3" json:"frame_size,omitempty"`
+	WheelSize  string                 `protobuf:"bytes,7,opt,name=wheel_size,json=wheelSize,proto3" json:"wheel_size,omitempty"`
+	Color      string                 `protobuf:"bytes,8,opt,name=color,proto3" json:"color,omitempty"`
+	MaxWeight  float64                `protobuf:"fixed64,9,opt,name=max_weight,json=maxWeight,proto3" json:"max_weight,omitempty"`
+	Page       int32                  `protobuf:"varint,10,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize   int32                  `protobuf:"varint,11,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Exact price bounds; when unset min_price and max_price are used
+	MinPriceMoney *money.Money `protobuf:"bytes,12,opt,name=min_price_money,json=minPriceMoney,proto3" json:"min_price_money,omitempty"`
+	MaxPriceMoney *money.Money `protobuf:"bytes,13,opt,name=max_price_money,json=maxPriceMoney,proto3" json:"max_price_money,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -570,6 +638,20 @@ func (x *ProductFilter) GetPageSize() int32 {
 	return 0
 }
 
+func (x *ProductFilter) GetMinPriceMoney() *money.Money {
+	if x != nil {
+		return x.MinPriceMoney
+	}
+	return nil
+}
+
+func (x *ProductFilter) GetMaxPriceMoney() *money.Money {
+	if x != nil {
+		return x.MaxPriceMoney
+	}
+	return nil
+}
+
 type DeleteResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -623,8 +705,11 @@ func (x *DeleteResponse) GetMessage() string {
 }
 
 type ListProductsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Filter        *ProductFilter         `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *ProductFilter         `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Currency to show prices in; empty for the base currency. Price filters are in this
+	// currency too.
+	Currency      string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -666,6 +751,13 @@ func (x *ListProductsRequest) GetFilter() *ProductFilter {
 	return nil
 }
 
+func (x *ListProductsRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type ListProductsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Products      []*ProductResponse     `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
@@ -882,13 +974,363 @@ func (x *CheckStockResponse) GetUnavailableItems() []*ProductQuantity {
 	return nil
 }
 
+// Stock adjustments are all-or-nothing: if any item cannot be adjusted,
+// nothing is changed and the offending items are listed in unavailable_items.
+type StockAdjustmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ProductQuantity     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Reference     string                 `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockAdjustmentRequest) Reset() {
+	*x = StockAdjustmentRequest{}
+	mi := &file_proto_inventory_product_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockAdjustmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockAdjustmentRequest) ProtoMessage() {}
+
+func (x *StockAdjustmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_product_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockAdjustmentRequest.ProtoReflect.Descriptor instead.
+func (*StockAdjustmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_product_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StockAdjustmentRequest) GetItems() []*ProductQuantity {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *StockAdjustmentRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+type StockAdjustmentResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Success          bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	UnavailableItems []*ProductQuantity     `protobuf:"bytes,2,rep,name=unavailable_items,json=unavailableItems,proto3" json:"unavailable_items,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StockAdjustmentResponse) Reset() {
+	*x = StockAdjustmentResponse{}
+	mi := &file_proto_inventory_product_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockAdjustmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockAdjustmentResponse) ProtoMessage() {}
+
+func (x *StockAdjustmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_product_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockAdjustmentResponse.ProtoReflect.Descriptor instead.
+func (*StockAdjustmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_product_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *StockAdjustmentResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *StockAdjustmentResponse) GetUnavailableItems() []*ProductQuantity {
+	if x != nil {
+		return x.UnavailableItems
+	}
+	return nil
+}
+
+// Reservations set stock aside for an order until it is paid, cancelled or the
+// reservation expires. Holding is all-or-nothing, like stock adjustments.
+// AdjustReservation replaces the items held for an order with the requested ones.
+type HoldStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Items         []*ProductQuantity     `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HoldStockRequest) Reset() {
+	*x = HoldStockRequest{}
+	mi := &file_proto_inventory_product_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoldStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldStockRequest) ProtoMessage() {}
+
+func (x *HoldStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_product_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldStockRequest.ProtoReflect.Descriptor instead.
+func (*HoldStockRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_product_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *HoldStockRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *HoldStockRequest) GetItems() []*ProductQuantity {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ReservationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReservationRequest) Reset() {
+	*x = ReservationRequest{}
+	mi := &file_proto_inventory_product_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservationRequest) ProtoMessage() {}
+
+func (x *ReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_product_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservationRequest.ProtoReflect.Descriptor instead.
+func (*ReservationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_product_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ReservationRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type ReservationResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Success          bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	UnavailableItems []*ProductQuantity     `protobuf:"bytes,2,rep,name=unavailable_items,json=unavailableItems,proto3" json:"unavailable_items,omitempty"`
+	Reservations     []*Reservation         `protobuf:"bytes,3,rep,name=reservations,proto3" json:"reservations,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ReservationResponse) Reset() {
+	*x = ReservationResponse{}
+	mi := &file_proto_inventory_product_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservationResponse) ProtoMessage() {}
+
+func (x *ReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_product_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservationResponse.ProtoReflect.Descriptor instead.
+func (*ReservationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_product_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReservationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReservationResponse) GetUnavailableItems() []*ProductQuantity {
+	if x != nil {
+		return x.UnavailableItems
+	}
+	return nil
+}
+
+func (x *ReservationResponse) GetReservations() []*Reservation {
+	if x != nil {
+		return x.Reservations
+	}
+	return nil
+}
+
+type Reservation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reservation) Reset() {
+	*x = Reservation{}
+	mi := &file_proto_inventory_product_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reservation) ProtoMessage() {}
+
+func (x *Reservation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_product_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reservation.ProtoReflect.Descriptor instead.
+func (*Reservation) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_product_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Reservation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Reservation) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Reservation) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *Reservation) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Reservation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Reservation) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
 var File_proto_inventory_product_proto protoreflect.FileDescriptor
 
 const file_proto_inventory_product_proto_rawDesc = "" +
 	"\n" +
-	"\x1dproto/inventory/product.proto\x12\tinventory\x1a\x1fgoogle/protobuf/timestamp.proto\"\"\n" +
+	"\x1dproto/inventory/product.proto\x12\tinventory\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17proto/money/money.proto\">\n" +
 	"\x10ProductIDRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\xa2\x02\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"\xd1\x02\n" +
 	"\x14CreateProductRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x14\n" +
@@ -903,7 +1345,9 @@ const file_proto_inventory_product_proto_rawDesc = "" +
 	"\x05color\x18\b \x01(\tR\x05color\x12\x16\n" +
 	"\x06weight\x18\t \x01(\x01R\x06weight\x12\x1b\n" +
 	"\tbike_type\x18\n" +
-	" \x01(\tR\bbikeType\"\xb2\x02\n" +
+	" \x01(\tR\bbikeType\x12-\n" +
+	"\vprice_money\x18\v \x01(\v2\f.money.MoneyR\n" +
+	"priceMoney\"\xe1\x02\n" +
 	"\x14UpdateProductRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -919,7 +1363,9 @@ const file_proto_inventory_product_proto_rawDesc = "" +
 	"\x05color\x18\t \x01(\tR\x05color\x12\x16\n" +
 	"\x06weight\x18\n" +
 	" \x01(\x01R\x06weight\x12\x1b\n" +
-	"\tbike_type\x18\v \x01(\tR\bbikeType\"\xa3\x03\n" +
+	"\tbike_type\x18\v \x01(\tR\bbikeType\x12-\n" +
+	"\vprice_money\x18\f \x01(\v2\f.money.MoneyR\n" +
+	"priceMoney\"\xb1\x04\n" +
 	"\x0fProductResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -939,7 +1385,12 @@ const file_proto_inventory_product_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xc6\x02\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1a\n" +
+	"\breserved\x18\x0e \x01(\x05R\breserved\x12-\n" +
+	"\vprice_money\x18\x0f \x01(\v2\f.money.MoneyR\n" +
+	"priceMoney\x12#\n" +
+	"\rexchange_rate\x18\x10 \x01(\x01R\fexchangeRate\x12\x1c\n" +
+	"\tavailable\x18\x11 \x01(\x05R\tavailable\"\xb2\x03\n" +
 	"\rProductFilter\x12\x1f\n" +
 	"\vcategory_id\x18\x01 \x01(\tR\n" +
 	"categoryId\x12\x1b\n" +
@@ -956,12 +1407,15 @@ const file_proto_inventory_product_proto_rawDesc = "" +
 	"max_weight\x18\t \x01(\x01R\tmaxWeight\x12\x12\n" +
 	"\x04page\x18\n" +
 	" \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\v \x01(\x05R\bpageSize\"D\n" +
+	"\tpage_size\x18\v \x01(\x05R\bpageSize\x124\n" +
+	"\x0fmin_price_money\x18\f \x01(\v2\f.money.MoneyR\rminPriceMoney\x124\n" +
+	"\x0fmax_price_money\x18\r \x01(\v2\f.money.MoneyR\rmaxPriceMoney\"D\n" +
 	"\x0eDeleteResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"G\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"c\n" +
 	"\x13ListProductsRequest\x120\n" +
-	"\x06filter\x18\x01 \x01(\v2\x18.inventory.ProductFilterR\x06filter\"\x95\x01\n" +
+	"\x06filter\x18\x01 \x01(\v2\x18.inventory.ProductFilterR\x06filter\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"\x95\x01\n" +
 	"\x14ListProductsResponse\x126\n" +
 	"\bproducts\x18\x01 \x03(\v2\x1a.inventory.ProductResponseR\bproducts\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
@@ -975,7 +1429,31 @@ const file_proto_inventory_product_proto_rawDesc = "" +
 	"\bquantity\x18\x02 \x01(\x05R\bquantity\"{\n" +
 	"\x12CheckStockResponse\x12\x1c\n" +
 	"\tavailable\x18\x01 \x01(\bR\tavailable\x12G\n" +
-	"\x11unavailable_items\x18\x02 \x03(\v2\x1a.inventory.ProductQuantityR\x10unavailableItems2\xd8\x03\n" +
+	"\x11unavailable_items\x18\x02 \x03(\v2\x1a.inventory.ProductQuantityR\x10unavailableItems\"h\n" +
+	"\x16StockAdjustmentRequest\x120\n" +
+	"\x05items\x18\x01 \x03(\v2\x1a.inventory.ProductQuantityR\x05items\x12\x1c\n" +
+	"\treference\x18\x02 \x01(\tR\treference\"|\n" +
+	"\x17StockAdjustmentResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12G\n" +
+	"\x11unavailable_items\x18\x02 \x03(\v2\x1a.inventory.ProductQuantityR\x10unavailableItems\"_\n" +
+	"\x10HoldStockRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x120\n" +
+	"\x05items\x18\x02 \x03(\v2\x1a.inventory.ProductQuantityR\x05items\"/\n" +
+	"\x12ReservationRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"\xb4\x01\n" +
+	"\x13ReservationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12G\n" +
+	"\x11unavailable_items\x18\x02 \x03(\v2\x1a.inventory.ProductQuantityR\x10unavailableItems\x12:\n" +
+	"\freservations\x18\x03 \x03(\v2\x16.inventory.ReservationR\freservations\"\xc6\x01\n" +
+	"\vReservation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt2\xcb\a\n" +
 	"\x0eProductService\x12L\n" +
 	"\rCreateProduct\x12\x1f.inventory.CreateProductRequest\x1a\x1a.inventory.ProductResponse\x12E\n" +
 	"\n" +
@@ -984,7 +1462,13 @@ const file_proto_inventory_product_proto_rawDesc = "" +
 	"\rDeleteProduct\x12\x1b.inventory.ProductIDRequest\x1a\x19.inventory.DeleteResponse\x12O\n" +
 	"\fListProducts\x12\x1e.inventory.ListProductsRequest\x1a\x1f.inventory.ListProductsResponse\x12I\n" +
 	"\n" +
-	"CheckStock\x12\x1c.inventory.CheckStockRequest\x1a\x1d.inventory.CheckStockResponseB\x11Z\x0fproto/inventoryb\x06proto3"
+	"CheckStock\x12\x1c.inventory.CheckStockRequest\x1a\x1d.inventory.CheckStockResponse\x12U\n" +
+	"\fReserveStock\x12!.inventory.StockAdjustmentRequest\x1a\".inventory.StockAdjustmentResponse\x12U\n" +
+	"\fReleaseStock\x12!.inventory.StockAdjustmentRequest\x1a\".inventory.StockAdjustmentResponse\x12H\n" +
+	"\tHoldStock\x12\x1b.inventory.HoldStockRequest\x1a\x1e.inventory.ReservationResponse\x12P\n" +
+	"\x11AdjustReservation\x12\x1b.inventory.HoldStockRequest\x1a\x1e.inventory.ReservationResponse\x12R\n" +
+	"\x11CommitReservation\x12\x1d.inventory.ReservationRequest\x1a\x1e.inventory.ReservationResponse\x12S\n" +
+	"\x12ReleaseReservation\x12\x1d.inventory.ReservationRequest\x1a\x1e.inventory.ReservationResponseB\x11Z\x0fproto/inventoryb\x06proto3"
 
 var (
 	file_proto_inventory_product_proto_rawDescOnce sync.Once
@@ -998,45 +1482,75 @@ func file_proto_inventory_product_proto_rawDescGZIP() []byte {
 	return file_proto_inventory_product_proto_rawDescData
 }
 
-var file_proto_inventory_product_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_proto_inventory_product_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_proto_inventory_product_proto_goTypes = []any{
-	(*ProductIDRequest)(nil),      // 0: inventory.ProductIDRequest
-	(*CreateProductRequest)(nil),  // 1: inventory.CreateProductRequest
-	(*UpdateProductRequest)(nil),  // 2: inventory.UpdateProductRequest
-	(*ProductResponse)(nil),       // 3: inventory.ProductResponse
-	(*ProductFilter)(nil),         // 4: inventory.ProductFilter
-	(*DeleteResponse)(nil),        // 5: inventory.DeleteResponse
-	(*ListProductsRequest)(nil),   // 6: inventory.ListProductsRequest
-	(*ListProductsResponse)(nil),  // 7: inventory.ListProductsResponse
-	(*CheckStockRequest)(nil),     // 8: inventory.CheckStockRequest
-	(*ProductQuantity)(nil),       // 9: inventory.ProductQuantity
-	(*CheckStockResponse)(nil),    // 10: inventory.CheckStockResponse
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(*ProductIDRequest)(nil),        // 0: inventory.ProductIDRequest
+	(*CreateProductRequest)(nil),    // 1: inventory.CreateProductRequest
+	(*UpdateProductRequest)(nil),    // 2: inventory.UpdateProductRequest
+	(*ProductResponse)(nil),         // 3: inventory.ProductResponse
+	(*ProductFilter)(nil),           // 4: inventory.ProductFilter
+	(*DeleteResponse)(nil),          // 5: inventory.DeleteResponse
+	(*ListProductsRequest)(nil),     // 6: inventory.ListProductsRequest
+	(*ListProductsResponse)(nil),    // 7: inventory.ListProductsResponse
+	(*CheckStockRequest)(nil),       // 8: inventory.CheckStockRequest
+	(*ProductQuantity)(nil),         // 9: inventory.ProductQuantity
+	(*CheckStockResponse)(nil),      // 10: inventory.CheckStockResponse
+	(*StockAdjustmentRequest)(nil),  // 11: inventory.StockAdjustmentRequest
+	(*StockAdjustmentResponse)(nil), // 12: inventory.StockAdjustmentResponse
+	(*HoldStockRequest)(nil),        // 13: inventory.HoldStockRequest
+	(*ReservationRequest)(nil),      // 14: inventory.ReservationRequest
+	(*ReservationResponse)(nil),     // 15: inventory.ReservationResponse
+	(*Reservation)(nil),             // 16: inventory.Reservation
+	(*money.Money)(nil),             // 17: money.Money
+	(*timestamppb.Timestamp)(nil),   // 18: google.protobuf.Timestamp
 }
 var file_proto_inventory_product_proto_depIdxs = []int32{
-	11, // 0: inventory.ProductResponse.created_at:type_name -> google.protobuf.Timestamp
-	11, // 1: inventory.ProductResponse.updated_at:type_name -> google.protobuf.Timestamp
-	4,  // 2: inventory.ListProductsRequest.filter:type_name -> inventory.ProductFilter
-	3,  // 3: inventory.ListProductsResponse.products:type_name -> inventory.ProductResponse
-	9,  // 4: inventory.CheckStockRequest.items:type_name -> inventory.ProductQuantity
-	9,  // 5: inventory.CheckStockResponse.unavailable_items:type_name -> inventory.ProductQuantity
-	1,  // 6: inventory.ProductService.CreateProduct:input_type -> inventory.CreateProductRequest
-	0,  // 7: inventory.ProductService.GetProduct:input_type -> inventory.ProductIDRequest
-	2,  // 8: inventory.ProductService.UpdateProduct:input_type -> inventory.UpdateProductRequest
-	0,  // 9: inventory.ProductService.DeleteProduct:input_type -> inventory.ProductIDRequest
-	6,  // 10: inventory.ProductService.ListProducts:input_type -> inventory.ListProductsRequest
-	8,  // 11: inventory.ProductService.CheckStock:input_type -> inventory.CheckStockRequest
-	3,  // 12: inventory.ProductService.CreateProduct:output_type -> inventory.ProductResponse
-	3,  // 13: inventory.ProductService.GetProduct:output_type -> inventory.ProductResponse
-	3,  // 14: inventory.ProductService.UpdateProduct:output_type -> inventory.ProductResponse
-	5,  // 15: inventory.ProductService.DeleteProduct:output_type -> inventory.DeleteResponse
-	7,  // 16: inventory.ProductService.ListProducts:output_type -> inventory.ListProductsResponse
-	10, // 17: inventory.ProductService.CheckStock:output_type -> inventory.CheckStockResponse
-	12, // [12:18] is the sub-list for method output_type
-	6,  // [6:12] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	17, // 0: inventory.CreateProductRequest.price_money:type_name -> money.Money
+	17, // 1: inventory.UpdateProductRequest.price_money:type_name -> money.Money
+	18, // 2: inventory.ProductResponse.created_at:type_name -> google.protobuf.Timestamp
+	18, // 3: inventory.ProductResponse.updated_at:type_name -> google.protobuf.Timestamp
+	17, // 4: inventory.ProductResponse.price_money:type_name -> money.Money
+	17, // 5: inventory.ProductFilter.min_price_money:type_name -> money.Money
+	17, // 6: inventory.ProductFilter.max_price_money:type_name -> money.Money
+	4,  // 7: inventory.ListProductsRequest.filter:type_name -> inventory.ProductFilter
+	3,  // 8: inventory.ListProductsResponse.products:type_name -> inventory.ProductResponse
+	9,  // 9: inventory.CheckStockRequest.items:type_name -> inventory.ProductQuantity
+	9,  // 10: inventory.CheckStockResponse.unavailable_items:type_name -> inventory.ProductQuantity
+	9,  // 11: inventory.StockAdjustmentRequest.items:type_name -> inventory.ProductQuantity
+	9,  // 12: inventory.StockAdjustmentResponse.unavailable_items:type_name -> inventory.ProductQuantity
+	9,  // 13: inventory.HoldStockRequest.items:type_name -> inventory.ProductQuantity
+	9,  // 14: inventory.ReservationResponse.unavailable_items:type_name -> inventory.ProductQuantity
+	16, // 15: inventory.ReservationResponse.reservations:type_name -> inventory.Reservation
+	18, // 16: inventory.Reservation.expires_at:type_name -> google.protobuf.Timestamp
+	1,  // 17: inventory.ProductService.CreateProduct:input_type -> inventory.CreateProductRequest
+	0,  // 18: inventory.ProductService.GetProduct:input_type -> inventory.ProductIDRequest
+	2,  // 19: inventory.ProductService.UpdateProduct:input_type -> inventory.UpdateProductRequest
+	0,  // 20: inventory.ProductService.DeleteProduct:input_type -> inventory.ProductIDRequest
+	6,  // 21: inventory.ProductService.ListProducts:input_type -> inventory.ListProductsRequest
+	8,  // 22: inventory.ProductService.CheckStock:input_type -> inventory.CheckStockRequest
+	11, // 23: inventory.ProductService.ReserveStock:input_type -> inventory.StockAdjustmentRequest
+	11, // 24: inventory.ProductService.ReleaseStock:input_type -> inventory.StockAdjustmentRequest
+	13, // 25: inventory.ProductService.HoldStock:input_type -> inventory.HoldStockRequest
+	13, // 26: inventory.ProductService.AdjustReservation:input_type -> inventory.HoldStockRequest
+	14, // 27: inventory.ProductService.CommitReservation:input_type -> inventory.ReservationRequest
+	14, // 28: inventory.ProductService.ReleaseReservation:input_type -> inventory.ReservationRequest
+	3,  // 29: inventory.ProductService.CreateProduct:output_type -> inventory.ProductResponse
+	3,  // 30: inventory.ProductService.GetProduct:output_type -> inventory.ProductResponse
+	3,  // 31: inventory.ProductService.UpdateProduct:output_type -> inventory.ProductResponse
+	5,  // 32: inventory.ProductService.DeleteProduct:output_type -> inventory.DeleteResponse
+	7,  // 33: inventory.ProductService.ListProducts:output_type -> inventory.ListProductsResponse
+	10, // 34: inventory.ProductService.CheckStock:output_type -> inventory.CheckStockResponse
+	12, // 35: inventory.ProductService.ReserveStock:output_type -> inventory.StockAdjustmentResponse
+	12, // 36: inventory.ProductService.ReleaseStock:output_type -> inventory.StockAdjustmentResponse
+	15, // 37: inventory.ProductService.HoldStock:output_type -> inventory.ReservationResponse
+	15, // 38: inventory.ProductService.AdjustReservation:output_type -> inventory.ReservationResponse
+	15, // 39: inventory.ProductService.CommitReservation:output_type -> inventory.ReservationResponse
+	15, // 40: inventory.ProductService.ReleaseReservation:output_type -> inventory.ReservationResponse
+	29, // [29:41] is the sub-list for method output_type
+	17, // [17:29] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_proto_inventory_product_proto_init() }
@@ -1050,7 +1564,7 @@ func file_proto_inventory_product_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_inventory_product_proto_rawDesc), len(file_proto_inventory_product_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
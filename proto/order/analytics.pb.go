@@ -0,0 +1,1047 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.30.2
+// source: proto/order/analytics.proto
+
+package order
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	money "proto/money"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReportRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportRange) Reset() {
+	*x = ReportRange{}
+	mi := &file_proto_order_analytics_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportRange) ProtoMessage() {}
+
+func (x *ReportRange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_analytics_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportRange.ProtoReflect.Descriptor instead.
+func (*ReportRange) Descriptor() ([]byte, []int) {
+	return file_proto_order_analytics_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReportRange) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ReportRange) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type RevenueReportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Range *ReportRange           `protobuf:"bytes,1,opt,name=range,proto3" json:"range,omitempty"`
+	// day, week or month
+	Period        string `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevenueReportRequest) Reset() {
+	*x = RevenueReportRequest{}
+	mi := &file_proto_order_analytics_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevenueReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevenueReportRequest) ProtoMessage() {}
+
+func (x *RevenueReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_analytics_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevenueReportRequest.ProtoReflect.Descriptor instead.
+func (*RevenueReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_analytics_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RevenueReportRequest) GetRange() *ReportRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+func (x *RevenueReportRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+// Revenue is what customers paid: subtotal - discount + tax + shipping
+type RevenueBucket struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	PeriodStart *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	Revenue     float64                `protobuf:"fixed64,2,opt,name=revenue,proto3" json:"revenue,omitempty"`
+	Orders      int32                  `protobuf:"varint,3,opt,name=orders,proto3" json:"orders,omitempty"`
+	Subtotal    float64                `protobuf:"fixed64,4,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	Discount    float64                `protobuf:"fixed64,5,opt,name=discount,proto3" json:"discount,omitempty"`
+	Tax         float64                `protobuf:"fixed64,6,opt,name=tax,proto3" json:"tax,omitempty"`
+	Shipping    float64                `protobuf:"fixed64,7,opt,name=shipping,proto3" json:"shipping,omitempty"`
+	// Exact amounts; the double fields carry the same amounts as decimals for older clients
+	RevenueMoney  *money.Money `protobuf:"bytes,8,opt,name=revenue_money,json=revenueMoney,proto3" json:"revenue_money,omitempty"`
+	SubtotalMoney *money.Money `protobuf:"bytes,9,opt,name=subtotal_money,json=subtotalMoney,proto3" json:"subtotal_money,omitempty"`
+	DiscountMoney *money.Money `protobuf:"bytes,10,opt,name=discount_money,json=discountMoney,proto3" json:"discount_money,omitempty"`
+	TaxMoney      *money.Money `protobuf:"bytes,11,opt,name=tax_money,json=taxMoney,proto3" json:"tax_money,omitempty"`
+	ShippingMoney *money.Money `protobuf:"bytes,12,opt,name=shipping_money,json=shippingMoney,proto3" json:"shipping_money,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevenueBucket) Reset() {
+	*x = RevenueBucket{}
+	mi := &file_proto_order_analytics_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevenueBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevenueBucket) ProtoMessage() {}
+
+func (x *RevenueBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_analytics_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevenueBucket.ProtoReflect.Descriptor instead.
+func (*RevenueBucket) Descriptor() ([]byte, []int) {
+	return file_proto_order_analytics_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RevenueBucket) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *RevenueBucket) GetRevenue() float64 {
+	if x != nil {
+		return x.Revenue
+	}
+	return 0
+}
+
+func (x *RevenueBucket) GetOrders() int32 {
+	if x != nil {
+		return x.Orders
+	}
+	return 0
+}
+
+func (x *RevenueBucket) GetSubtotal() float64 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
+}
+
+func (x *RevenueBucket) GetDiscount() float64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *RevenueBucket) GetTax() float64 {
+	if x != nil {
+		return x.Tax
+	}
+	return 0
+}
+
+func (x *RevenueBucket) GetShipping() float64 {
+	if x != nil {
+		return x.Shipping
+	}
+	return 0
+}
+
+func (x *RevenueBucket) GetRevenueMoney() *money.Money {
+	if x != nil {
+		return x.RevenueMoney
+	}
+	return nil
+}
+
+func (x *RevenueBucket) GetSubtotalMoney() *money.Money {
+	if x != nil {
+		return x.SubtotalMoney
+	}
+	return nil
+}
+
+func (x *RevenueBucket) GetDiscountMoney() *money.Money {
+	if x != nil {
+		return x.DiscountMoney
+	}
+	return nil
+}
+
+func (x *RevenueBucket) GetTaxMoney() *money.Money {
+	if x != nil {
+		return x.TaxMoney
+	}
+	return nil
+}
+
+func (x *RevenueBucket) GetShippingMoney() *money.Money {
+	if x != nil {
+		return x.ShippingMoney
+	}
+	return nil
+}
+
+type RevenueReportResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Period             string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	Buckets            []*RevenueBucket       `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	TotalRevenue       float64                `protobuf:"fixed64,3,opt,name=total_revenue,json=totalRevenue,proto3" json:"total_revenue,omitempty"`
+	TotalOrders        int32                  `protobuf:"varint,4,opt,name=total_orders,json=totalOrders,proto3" json:"total_orders,omitempty"`
+	TotalSubtotal      float64                `protobuf:"fixed64,5,opt,name=total_subtotal,json=totalSubtotal,proto3" json:"total_subtotal,omitempty"`
+	TotalDiscount      float64                `protobuf:"fixed64,6,opt,name=total_discount,json=totalDiscount,proto3" json:"total_discount,omitempty"`
+	TotalTax           float64                `protobuf:"fixed64,7,opt,name=total_tax,json=totalTax,proto3" json:"total_tax,omitempty"`
+	TotalShipping      float64                `protobuf:"fixed64,8,opt,name=total_shipping,json=totalShipping,proto3" json:"total_shipping,omitempty"`
+	TotalRevenueMoney  *money.Money           `protobuf:"bytes,9,opt,name=total_revenue_money,json=totalRevenueMoney,proto3" json:"total_revenue_money,omitempty"`
+	TotalSubtotalMoney *money.Money           `protobuf:"bytes,10,opt,name=total_subtotal_money,json=totalSubtotalMoney,proto3" json:"total_subtotal_money,omitempty"`
+	TotalDiscountMoney *money.Money           `protobuf:"bytes,11,opt,name=total_discount_money,json=totalDiscountMoney,proto3" json:"total_discount_money,omitempty"`
+	TotalTaxMoney      *money.Money           `protobuf:"bytes,12,opt,name=total_tax_money,json=totalTaxMoney,proto3" json:"total_tax_money,omitempty"`
+	TotalShippingMoney *money.Money           `protobuf:"bytes,13,opt,name=total_shipping_money,json=totalShippingMoney,proto3" json:"total_shipping_money,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RevenueReportResponse) Reset() {
+	*x = RevenueReportResponse{}
+	mi := &file_proto_order_analytics_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevenueReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevenueReportResponse) ProtoMessage() {}
+
+func (x *RevenueReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_analytics_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevenueReportResponse.ProtoReflect.Descriptor instead.
+func (*RevenueReportResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_analytics_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RevenueReportResponse) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *RevenueReportResponse) GetBuckets() []*RevenueBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *RevenueReportResponse) GetTotalRevenue() float64 {
+	if x != nil {
+		return x.TotalRevenue
+	}
+	return 0
+}
+
+func (x *RevenueReportResponse) GetTotalOrders() int32 {
+	if x != nil {
+		return x.TotalOrders
+	}
+	return 0
+}
+
+func (x *RevenueReportResponse) GetTotalSubtotal() float64 {
+	if x != nil {
+		return x.TotalSubtotal
+	}
+	return 0
+}
+
+func (x *RevenueReportResponse) GetTotalDiscount() float64 {
+	if x != nil {
+		return x.TotalDiscount
+	}
+	return 0
+}
+
+func (x *RevenueReportResponse) GetTotalTax() float64 {
+	if x != nil {
+		return x.TotalTax
+	}
+	return 0
+}
+
+func (x *RevenueReportResponse) GetTotalShipping() float64 {
+	if x != nil {
+		return x.TotalShipping
+	}
+	return 0
+}
+
+func (x *RevenueReportResponse) GetTotalRevenueMoney() *money.Money {
+	if x != nil {
+		return x.TotalRevenueMoney
+	}
+	return nil
+}
+
+func (x *RevenueReportResponse) GetTotalSubtotalMoney() *money.Money {
+	if x != nil {
+		return x.TotalSubtotalMoney
+	}
+	return nil
+}
+
+func (x *RevenueReportResponse) GetTotalDiscountMoney() *money.Money {
+	if x != nil {
+		return x.TotalDiscountMoney
+	}
+	return nil
+}
+
+func (x *RevenueReportResponse) GetTotalTaxMoney() *money.Money {
+	if x != nil {
+		return x.TotalTaxMoney
+	}
+	return nil
+}
+
+func (x *RevenueReportResponse) GetTotalShippingMoney() *money.Money {
+	if x != nil {
+		return x.TotalShippingMoney
+	}
+	return nil
+}
+
+type OrderStatusCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Orders        int32                  `protobuf:"varint,2,opt,name=orders,proto3" json:"orders,omitempty"`
+	Total         float64                `protobuf:"fixed64,3,opt,name=total,proto3" json:"total,omitempty"`
+	TotalMoney    *money.Money           `protobuf:"bytes,4,opt,name=total_money,json=totalMoney,proto3" json:"total_money,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderStatusCount) Reset() {
+	*x = OrderStatusCount{}
+	mi := &file_proto_order_analytics_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderStatusCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderStatusCount) ProtoMessage() {}
+
+func (x *OrderStatusCount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_analytics_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderStatusCount.ProtoReflect.Descriptor instead.
+func (*OrderStatusCount) Descriptor() ([]byte, []int) {
+	return file_proto_order_analytics_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OrderStatusCount) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OrderStatusCount) GetOrders() int32 {
+	if x != nil {
+		return x.Orders
+	}
+	return 0
+}
+
+func (x *OrderStatusCount) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *OrderStatusCount) GetTotalMoney() *money.Money {
+	if x != nil {
+		return x.TotalMoney
+	}
+	return nil
+}
+
+type OrderStatusReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statuses      []*OrderStatusCount    `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderStatusReportResponse) Reset() {
+	*x = OrderStatusReportResponse{}
+	mi := &file_proto_order_analytics_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderStatusReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderStatusReportResponse) ProtoMessage() {}
+
+func (x *OrderStatusReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_analytics_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderStatusReportResponse.ProtoReflect.Descriptor instead.
+func (*OrderStatusReportResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_analytics_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *OrderStatusReportResponse) GetStatuses() []*OrderStatusCount {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type TopProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Range         *ReportRange           `protobuf:"bytes,1,opt,name=range,proto3" json:"range,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopProductsRequest) Reset() {
+	*x = TopProductsRequest{}
+	mi := &file_proto_order_analytics_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopProductsRequest) ProtoMessage() {}
+
+func (x *TopProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_analytics_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopProductsRequest.ProtoReflect.Descriptor instead.
+func (*TopProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_analytics_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TopProductsRequest) GetRange() *ReportRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+func (x *TopProductsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ProductSales struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Units         int32                  `protobuf:"varint,3,opt,name=units,proto3" json:"units,omitempty"`
+	Revenue       float64                `protobuf:"fixed64,4,opt,name=revenue,proto3" json:"revenue,omitempty"`
+	Orders        int32                  `protobuf:"varint,5,opt,name=orders,proto3" json:"orders,omitempty"`
+	RevenueMoney  *money.Money           `protobuf:"bytes,6,opt,name=revenue_money,json=revenueMoney,proto3" json:"revenue_money,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductSales) Reset() {
+	*x = ProductSales{}
+	mi := &file_proto_order_analytics_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductSales) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductSales) ProtoMessage() {}
+
+func (x *ProductSales) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_analytics_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductSales.ProtoReflect.Descriptor instead.
+func (*ProductSales) Descriptor() ([]byte, []int) {
+	return file_proto_order_analytics_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ProductSales) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ProductSales) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProductSales) GetUnits() int32 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+func (x *ProductSales) GetRevenue() float64 {
+	if x != nil {
+		return x.Revenue
+	}
+	return 0
+}
+
+func (x *ProductSales) GetOrders() int32 {
+	if x != nil {
+		return x.Orders
+	}
+	return 0
+}
+
+func (x *ProductSales) GetRevenueMoney() *money.Money {
+	if x != nil {
+		return x.RevenueMoney
+	}
+	return nil
+}
+
+type TopProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*ProductSales        `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopProductsResponse) Reset() {
+	*x = TopProductsResponse{}
+	mi := &file_proto_order_analytics_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopProductsResponse) ProtoMessage() {}
+
+func (x *TopProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_analytics_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopProductsResponse.ProtoReflect.Descriptor instead.
+func (*TopProductsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_analytics_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TopProductsResponse) GetProducts() []*ProductSales {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type SalesByAttributeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Range *ReportRange           `protobuf:"bytes,1,opt,name=range,proto3" json:"range,omitempty"`
+	// bike_type, frame_size or color
+	Attribute     string `protobuf:"bytes,2,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SalesByAttributeRequest) Reset() {
+	*x = SalesByAttributeRequest{}
+	mi := &file_proto_order_analytics_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SalesByAttributeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SalesByAttributeRequest) ProtoMessage() {}
+
+func (x *SalesByAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_analytics_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SalesByAttributeRequest.ProtoReflect.Descriptor instead.
+func (*SalesByAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_analytics_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SalesByAttributeRequest) GetRange() *ReportRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+func (x *SalesByAttributeRequest) GetAttribute() string {
+	if x != nil {
+		return x.Attribute
+	}
+	return ""
+}
+
+type AttributeSales struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Units         int32                  `protobuf:"varint,2,opt,name=units,proto3" json:"units,omitempty"`
+	Revenue       float64                `protobuf:"fixed64,3,opt,name=revenue,proto3" json:"revenue,omitempty"`
+	Orders        int32                  `protobuf:"varint,4,opt,name=orders,proto3" json:"orders,omitempty"`
+	RevenueMoney  *money.Money           `protobuf:"bytes,5,opt,name=revenue_money,json=revenueMoney,proto3" json:"revenue_money,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributeSales) Reset() {
+	*x = AttributeSales{}
+	mi := &file_proto_order_analytics_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeSales) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeSales) ProtoMessage() {}
+
+func (x *AttributeSales) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_analytics_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeSales.ProtoReflect.Descriptor instead.
+func (*AttributeSales) Descriptor() ([]byte, []int) {
+	return file_proto_order_analytics_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AttributeSales) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *AttributeSales) GetUnits() int32 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+func (x *AttributeSales) GetRevenue() float64 {
+	if x != nil {
+		return x.Revenue
+	}
+	return 0
+}
+
+func (x *AttributeSales) GetOrders() int32 {
+	if x != nil {
+		return x.Orders
+	}
+	return 0
+}
+
+func (x *AttributeSales) GetRevenueMoney() *money.Money {
+	if x != nil {
+		return x.RevenueMoney
+	}
+	return nil
+}
+
+type SalesByAttributeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attribute     string                 `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	Values        []*AttributeSales      `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SalesByAttributeResponse) Reset() {
+	*x = SalesByAttributeResponse{}
+	mi := &file_proto_order_analytics_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SalesByAttributeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SalesByAttributeResponse) ProtoMessage() {}
+
+func (x *SalesByAttributeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_analytics_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SalesByAttributeResponse.ProtoReflect.Descriptor instead.
+func (*SalesByAttributeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_analytics_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SalesByAttributeResponse) GetAttribute() string {
+	if x != nil {
+		return x.Attribute
+	}
+	return ""
+}
+
+func (x *SalesByAttributeResponse) GetValues() []*AttributeSales {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+var File_proto_order_analytics_proto protoreflect.FileDescriptor
+
+const file_proto_order_analytics_proto_rawDesc = "" +
+	"\n" +
+	"\x1bproto/order/analytics.proto\x12\x05order\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17proto/money/money.proto\"i\n" +
+	"\vReportRange\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"X\n" +
+	"\x14RevenueReportRequest\x12(\n" +
+	"\x05range\x18\x01 \x01(\v2\x12.order.ReportRangeR\x05range\x12\x16\n" +
+	"\x06period\x18\x02 \x01(\tR\x06period\"\xe3\x03\n" +
+	"\rRevenueBucket\x12=\n" +
+	"\fperiod_start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x12\x18\n" +
+	"\arevenue\x18\x02 \x01(\x01R\arevenue\x12\x16\n" +
+	"\x06orders\x18\x03 \x01(\x05R\x06orders\x12\x1a\n" +
+	"\bsubtotal\x18\x04 \x01(\x01R\bsubtotal\x12\x1a\n" +
+	"\bdiscount\x18\x05 \x01(\x01R\bdiscount\x12\x10\n" +
+	"\x03tax\x18\x06 \x01(\x01R\x03tax\x12\x1a\n" +
+	"\bshipping\x18\a \x01(\x01R\bshipping\x121\n" +
+	"\rrevenue_money\x18\b \x01(\v2\f.money.MoneyR\frevenueMoney\x123\n" +
+	"\x0esubtotal_money\x18\t \x01(\v2\f.money.MoneyR\rsubtotalMoney\x123\n" +
+	"\x0ediscount_money\x18\n" +
+	" \x01(\v2\f.money.MoneyR\rdiscountMoney\x12)\n" +
+	"\ttax_money\x18\v \x01(\v2\f.money.MoneyR\btaxMoney\x123\n" +
+	"\x0eshipping_money\x18\f \x01(\v2\f.money.MoneyR\rshippingMoney\"\xed\x04\n" +
+	"\x15RevenueReportResponse\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\x12.\n" +
+	"\abuckets\x18\x02 \x03(\v2\x14.order.RevenueBucketR\abuckets\x12#\n" +
+	"\rtotal_revenue\x18\x03 \x01(\x01R\ftotalRevenue\x12!\n" +
+	"\ftotal_orders\x18\x04 \x01(\x05R\vtotalOrders\x12%\n" +
+	"\x0etotal_subtotal\x18\x05 \x01(\x01R\rtotalSubtotal\x12%\n" +
+	"\x0etotal_discount\x18\x06 \x01(\x01R\rtotalDiscount\x12\x1b\n" +
+	"\ttotal_tax\x18\a \x01(\x01R\btotalTax\x12%\n" +
+	"\x0etotal_shipping\x18\b \x01(\x01R\rtotalShipping\x12<\n" +
+	"\x13total_revenue_money\x18\t \x01(\v2\f.money.MoneyR\x11totalRevenueMoney\x12>\n" +
+	"\x14total_subtotal_money\x18\n" +
+	" \x01(\v2\f.money.MoneyR\x12totalSubtotalMoney\x12>\n" +
+	"\x14total_discount_money\x18\v \x01(\v2\f.money.MoneyR\x12totalDiscountMoney\x124\n" +
+	"\x0ftotal_tax_money\x18\f \x01(\v2\f.money.MoneyR\rtotalTaxMoney\x12>\n" +
+	"\x14total_shipping_money\x18\r \x01(\v2\f.money.MoneyR\x12totalShippingMoney\"\x87\x01\n" +
+	"\x10OrderStatusCount\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x16\n" +
+	"\x06orders\x18\x02 \x01(\x05R\x06orders\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x01R\x05total\x12-\n" +
+	"\vtotal_money\x18\x04 \x01(\v2\f.money.MoneyR\n" +
+	"totalMoney\"P\n" +
+	"\x19OrderStatusReportResponse\x123\n" +
+	"\bstatuses\x18\x01 \x03(\v2\x17.order.OrderStatusCountR\bstatuses\"T\n" +
+	"\x12TopProductsRequest\x12(\n" +
+	"\x05range\x18\x01 \x01(\v2\x12.order.ReportRangeR\x05range\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\xbc\x01\n" +
+	"\fProductSales\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05units\x18\x03 \x01(\x05R\x05units\x12\x18\n" +
+	"\arevenue\x18\x04 \x01(\x01R\arevenue\x12\x16\n" +
+	"\x06orders\x18\x05 \x01(\x05R\x06orders\x121\n" +
+	"\rrevenue_money\x18\x06 \x01(\v2\f.money.MoneyR\frevenueMoney\"F\n" +
+	"\x13TopProductsResponse\x12/\n" +
+	"\bproducts\x18\x01 \x03(\v2\x13.order.ProductSalesR\bproducts\"a\n" +
+	"\x17SalesByAttributeRequest\x12(\n" +
+	"\x05range\x18\x01 \x01(\v2\x12.order.ReportRangeR\x05range\x12\x1c\n" +
+	"\tattribute\x18\x02 \x01(\tR\tattribute\"\xa1\x01\n" +
+	"\x0eAttributeSales\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05units\x18\x02 \x01(\x05R\x05units\x12\x18\n" +
+	"\arevenue\x18\x03 \x01(\x01R\arevenue\x12\x16\n" +
+	"\x06orders\x18\x04 \x01(\x05R\x06orders\x121\n" +
+	"\rrevenue_money\x18\x05 \x01(\v2\f.money.MoneyR\frevenueMoney\"g\n" +
+	"\x18SalesByAttributeResponse\x12\x1c\n" +
+	"\tattribute\x18\x01 \x01(\tR\tattribute\x12-\n" +
+	"\x06values\x18\x02 \x03(\v2\x15.order.AttributeSalesR\x06values2\xd0\x02\n" +
+	"\x10AnalyticsService\x12M\n" +
+	"\x10GetRevenueReport\x12\x1b.order.RevenueReportRequest\x1a\x1c.order.RevenueReportResponse\x12L\n" +
+	"\x14GetOrderStatusReport\x12\x12.order.ReportRange\x1a .order.OrderStatusReportResponse\x12G\n" +
+	"\x0eGetTopProducts\x12\x19.order.TopProductsRequest\x1a\x1a.order.TopProductsResponse\x12V\n" +
+	"\x13GetSalesByAttribute\x12\x1e.order.SalesByAttributeRequest\x1a\x1f.order.SalesByAttributeResponseB\rZ\vproto/orderb\x06proto3"
+
+var (
+	file_proto_order_analytics_proto_rawDescOnce sync.Once
+	file_proto_order_analytics_proto_rawDescData []byte
+)
+
+func file_proto_order_analytics_proto_rawDescGZIP() []byte {
+	file_proto_order_analytics_proto_rawDescOnce.Do(func() {
+		file_proto_order_analytics_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_order_analytics_proto_rawDesc), len(file_proto_order_analytics_proto_rawDesc)))
+	})
+	return file_proto_order_analytics_proto_rawDescData
+}
+
+var file_proto_order_analytics_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_proto_order_analytics_proto_goTypes = []any{
+	(*ReportRange)(nil),               // 0: order.ReportRange
+	(*RevenueReportRequest)(nil),      // 1: order.RevenueReportRequest
+	(*RevenueBucket)(nil),             // 2: order.RevenueBucket
+	(*RevenueReportResponse)(nil),     // 3: order.RevenueReportResponse
+	(*OrderStatusCount)(nil),          // 4: order.OrderStatusCount
+	(*OrderStatusReportResponse)(nil), // 5: order.OrderStatusReportResponse
+	(*TopProductsRequest)(nil),        // 6: order.TopProductsRequest
+	(*ProductSales)(nil),              // 7: order.ProductSales
+	(*TopProductsResponse)(nil),       // 8: order.TopProductsResponse
+	(*SalesByAttributeRequest)(nil),   // 9: order.SalesByAttributeRequest
+	(*AttributeSales)(nil),            // 10: order.AttributeSales
+	(*SalesByAttributeResponse)(nil),  // 11: order.SalesByAttributeResponse
+	(*timestamppb.Timestamp)(nil),     // 12: google.protobuf.Timestamp
+	(*money.Money)(nil),               // 13: money.Money
+}
+var file_proto_order_analytics_proto_depIdxs = []int32{
+	12, // 0: order.ReportRange.from:type_name -> google.protobuf.Timestamp
+	12, // 1: order.ReportRange.to:type_name -> google.protobuf.Timestamp
+	0,  // 2: order.RevenueReportRequest.range:type_name -> order.ReportRange
+	12, // 3: order.RevenueBucket.period_start:type_name -> google.protobuf.Timestamp
+	13, // 4: order.RevenueBucket.revenue_money:type_name -> money.Money
+	13, // 5: order.RevenueBucket.subtotal_money:type_name -> money.Money
+	13, // 6: order.RevenueBucket.discount_money:type_name -> money.Money
+	13, // 7: order.RevenueBucket.tax_money:type_name -> money.Money
+	13, // 8: order.RevenueBucket.shipping_money:type_name -> money.Money
+	2,  // 9: order.RevenueReportResponse.buckets:type_name -> order.RevenueBucket
+	13, // 10: order.RevenueReportResponse.total_revenue_money:type_name -> money.Money
+	13, // 11: order.RevenueReportResponse.total_subtotal_money:type_name -> money.Money
+	13, // 12: order.RevenueReportResponse.total_discount_money:type_name -> money.Money
+	13, // 13: order.RevenueReportResponse.total_tax_money:type_name -> money.Money
+	13, // 14: order.RevenueReportResponse.total_shipping_money:type_name -> money.Money
+	13, // 15: order.OrderStatusCount.total_money:type_name -> money.Money
+	4,  // 16: order.OrderStatusReportResponse.statuses:type_name -> order.OrderStatusCount
+	0,  // 17: order.TopProductsRequest.range:type_name -> order.ReportRange
+	13, // 18: order.ProductSales.revenue_money:type_name -> money.Money
+	7,  // 19: order.TopProductsResponse.products:type_name -> order.ProductSales
+	0,  // 20: order.SalesByAttributeRequest.range:type_name -> order.ReportRange
+	13, // 21: order.AttributeSales.revenue_money:type_name -> money.Money
+	10, // 22: order.SalesByAttributeResponse.values:type_name -> order.AttributeSales
+	1,  // 23: order.AnalyticsService.GetRevenueReport:input_type -> order.RevenueReportRequest
+	0,  // 24: order.AnalyticsService.GetOrderStatusReport:input_type -> order.ReportRange
+	6,  // 25: order.AnalyticsService.GetTopProducts:input_type -> order.TopProductsRequest
+	9,  // 26: order.AnalyticsService.GetSalesByAttribute:input_type -> order.SalesByAttributeRequest
+	3,  // 27: order.AnalyticsService.GetRevenueReport:output_type -> order.RevenueReportResponse
+	5,  // 28: order.AnalyticsService.GetOrderStatusReport:output_type -> order.OrderStatusReportResponse
+	8,  // 29: order.AnalyticsService.GetTopProducts:output_type -> order.TopProductsResponse
+	11, // 30: order.AnalyticsService.GetSalesByAttribute:output_type -> order.SalesByAttributeResponse
+	27, // [27:31] is the sub-list for method output_type
+	23, // [23:27] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
+}
+
+func init() { file_proto_order_analytics_proto_init() }
+func file_proto_order_analytics_proto_init() {
+	if File_proto_order_analytics_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_order_analytics_proto_rawDesc), len(file_proto_order_analytics_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_order_analytics_proto_goTypes,
+		DependencyIndexes: file_proto_order_analytics_proto_depIdxs,
+		MessageInfos:      file_proto_order_analytics_proto_msgTypes,
+	}.Build()
+	File_proto_order_analytics_proto = out.File
+	file_proto_order_analytics_proto_goTypes = nil
+	file_proto_order_analytics_proto_depIdxs = nil
+}
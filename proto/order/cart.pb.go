@@ -0,0 +1,556 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.30.2
+// source: proto/order/cart.proto
+
+package order
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	money "proto/money"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The cart of a signed-in user, or of a guest's session; user_id wins when both are set.
+type CartOwner struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartOwner) Reset() {
+	*x = CartOwner{}
+	mi := &file_proto_order_cart_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartOwner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartOwner) ProtoMessage() {}
+
+func (x *CartOwner) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_cart_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartOwner.ProtoReflect.Descriptor instead.
+func (*CartOwner) Descriptor() ([]byte, []int) {
+	return file_proto_order_cart_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CartOwner) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CartOwner) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type CartRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Owner *CartOwner             `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Currency to price the cart in; empty for the base currency
+	Currency      string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartRequest) Reset() {
+	*x = CartRequest{}
+	mi := &file_proto_order_cart_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartRequest) ProtoMessage() {}
+
+func (x *CartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_cart_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartRequest.ProtoReflect.Descriptor instead.
+func (*CartRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_cart_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CartRequest) GetOwner() *CartOwner {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *CartRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type CartItemRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Owner     *CartOwner             `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Currency  string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	ProductId string                 `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// The quantity to add, or the new quantity when updating; zero removes the item.
+	// Ignored when removing.
+	Quantity      int32 `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartItemRequest) Reset() {
+	*x = CartItemRequest{}
+	mi := &file_proto_order_cart_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartItemRequest) ProtoMessage() {}
+
+func (x *CartItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_cart_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartItemRequest.ProtoReflect.Descriptor instead.
+func (*CartItemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_cart_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CartItemRequest) GetOwner() *CartOwner {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *CartItemRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CartItemRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CartItemRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type MergeCartsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeCartsRequest) Reset() {
+	*x = MergeCartsRequest{}
+	mi := &file_proto_order_cart_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeCartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeCartsRequest) ProtoMessage() {}
+
+func (x *MergeCartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_cart_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeCartsRequest.ProtoReflect.Descriptor instead.
+func (*MergeCartsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_cart_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MergeCartsRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *MergeCartsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MergeCartsRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type CartItem struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Quantity  int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Current catalogue price; unset when the product no longer exists
+	Price     *money.Money `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	LineTotal *money.Money `protobuf:"bytes,5,opt,name=line_total,json=lineTotal,proto3" json:"line_total,omitempty"`
+	// False when the product no longer exists or has too little stock for the quantity
+	Available     bool                   `protobuf:"varint,6,opt,name=available,proto3" json:"available,omitempty"`
+	AddedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartItem) Reset() {
+	*x = CartItem{}
+	mi := &file_proto_order_cart_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartItem) ProtoMessage() {}
+
+func (x *CartItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_cart_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartItem.ProtoReflect.Descriptor instead.
+func (*CartItem) Descriptor() ([]byte, []int) {
+	return file_proto_order_cart_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CartItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CartItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CartItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CartItem) GetPrice() *money.Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *CartItem) GetLineTotal() *money.Money {
+	if x != nil {
+		return x.LineTotal
+	}
+	return nil
+}
+
+func (x *CartItem) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *CartItem) GetAddedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AddedAt
+	}
+	return nil
+}
+
+type CartResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserId    string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SessionId string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Items     []*CartItem            `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	// Sum of the available items
+	Subtotal      *money.Money           `protobuf:"bytes,4,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartResponse) Reset() {
+	*x = CartResponse{}
+	mi := &file_proto_order_cart_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartResponse) ProtoMessage() {}
+
+func (x *CartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_cart_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartResponse.ProtoReflect.Descriptor instead.
+func (*CartResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_cart_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CartResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CartResponse) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *CartResponse) GetItems() []*CartItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CartResponse) GetSubtotal() *money.Money {
+	if x != nil {
+		return x.Subtotal
+	}
+	return nil
+}
+
+func (x *CartResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_proto_order_cart_proto protoreflect.FileDescriptor
+
+const file_proto_order_cart_proto_rawDesc = "" +
+	"\n" +
+	"\x16proto/order/cart.proto\x12\x05order\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17proto/money/money.proto\"C\n" +
+	"\tCartOwner\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\"Q\n" +
+	"\vCartRequest\x12&\n" +
+	"\x05owner\x18\x01 \x01(\v2\x10.order.CartOwnerR\x05owner\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"\x90\x01\n" +
+	"\x0fCartItemRequest\x12&\n" +
+	"\x05owner\x18\x01 \x01(\v2\x10.order.CartOwnerR\x05owner\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\"g\n" +
+	"\x11MergeCartsRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\"\xff\x01\n" +
+	"\bCartItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\"\n" +
+	"\x05price\x18\x04 \x01(\v2\f.money.MoneyR\x05price\x12+\n" +
+	"\n" +
+	"line_total\x18\x05 \x01(\v2\f.money.MoneyR\tlineTotal\x12\x1c\n" +
+	"\tavailable\x18\x06 \x01(\bR\tavailable\x125\n" +
+	"\badded_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\aaddedAt\"\xd2\x01\n" +
+	"\fCartResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12%\n" +
+	"\x05items\x18\x03 \x03(\v2\x0f.order.CartItemR\x05items\x12(\n" +
+	"\bsubtotal\x18\x04 \x01(\v2\f.money.MoneyR\bsubtotal\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt2\xee\x02\n" +
+	"\vCartService\x122\n" +
+	"\aGetCart\x12\x12.order.CartRequest\x1a\x13.order.CartResponse\x12:\n" +
+	"\vAddCartItem\x12\x16.order.CartItemRequest\x1a\x13.order.CartResponse\x12=\n" +
+	"\x0eUpdateCartItem\x12\x16.order.CartItemRequest\x1a\x13.order.CartResponse\x12=\n" +
+	"\x0eRemoveCartItem\x12\x16.order.CartItemRequest\x1a\x13.order.CartResponse\x124\n" +
+	"\tClearCart\x12\x12.order.CartRequest\x1a\x13.order.CartResponse\x12;\n" +
+	"\n" +
+	"MergeCarts\x12\x18.order.MergeCartsRequest\x1a\x13.order.CartResponseB\rZ\vproto/orderb\x06proto3"
+
+var (
+	file_proto_order_cart_proto_rawDescOnce sync.Once
+	file_proto_order_cart_proto_rawDescData []byte
+)
+
+func file_proto_order_cart_proto_rawDescGZIP() []byte {
+	file_proto_order_cart_proto_rawDescOnce.Do(func() {
+		file_proto_order_cart_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_order_cart_proto_rawDesc), len(file_proto_order_cart_proto_rawDesc)))
+	})
+	return file_proto_order_cart_proto_rawDescData
+}
+
+var file_proto_order_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_proto_order_cart_proto_goTypes = []any{
+	(*CartOwner)(nil),             // 0: order.CartOwner
+	(*CartRequest)(nil),           // 1: order.CartRequest
+	(*CartItemRequest)(nil),       // 2: order.CartItemRequest
+	(*MergeCartsRequest)(nil),     // 3: order.MergeCartsRequest
+	(*CartItem)(nil),              // 4: order.CartItem
+	(*CartResponse)(nil),          // 5: order.CartResponse
+	(*money.Money)(nil),           // 6: money.Money
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+}
+var file_proto_order_cart_proto_depIdxs = []int32{
+	0,  // 0: order.CartRequest.owner:type_name -> order.CartOwner
+	0,  // 1: order.CartItemRequest.owner:type_name -> order.CartOwner
+	6,  // 2: order.CartItem.price:type_name -> money.Money
+	6,  // 3: order.CartItem.line_total:type_name -> money.Money
+	7,  // 4: order.CartItem.added_at:type_name -> google.protobuf.Timestamp
+	4,  // 5: order.CartResponse.items:type_name -> order.CartItem
+	6,  // 6: order.CartResponse.subtotal:type_name -> money.Money
+	7,  // 7: order.CartResponse.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 8: order.CartService.GetCart:input_type -> order.CartRequest
+	2,  // 9: order.CartService.AddCartItem:input_type -> order.CartItemRequest
+	2,  // 10: order.CartService.UpdateCartItem:input_type -> order.CartItemRequest
+	2,  // 11: order.CartService.RemoveCartItem:input_type -> order.CartItemRequest
+	1,  // 12: order.CartService.ClearCart:input_type -> order.CartRequest
+	3,  // 13: order.CartService.MergeCarts:input_type -> order.MergeCartsRequest
+	5,  // 14: order.CartService.GetCart:output_type -> order.CartResponse
+	5,  // 15: order.CartService.AddCartItem:output_type -> order.CartResponse
+	5,  // 16: order.CartService.UpdateCartItem:output_type -> order.CartResponse
+	5,  // 17: order.CartService.RemoveCartItem:output_type -> order.CartResponse
+	5,  // 18: order.CartService.ClearCart:output_type -> order.CartResponse
+	5,  // 19: order.CartService.MergeCarts:output_type -> order.CartResponse
+	14, // [14:20] is the sub-list for method output_type
+	8,  // [8:14] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_proto_order_cart_proto_init() }
+func file_proto_order_cart_proto_init() {
+	if File_proto_order_cart_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_order_cart_proto_rawDesc), len(file_proto_order_cart_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_order_cart_proto_goTypes,
+		DependencyIndexes: file_proto_order_cart_proto_depIdxs,
+		MessageInfos:      file_proto_order_cart_proto_msgTypes,
+	}.Build()
+	File_proto_order_cart_proto = out.File
+	file_proto_order_cart_proto_goTypes = nil
+	file_proto_order_cart_proto_depIdxs = nil
+}
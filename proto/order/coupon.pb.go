@@ -0,0 +1,681 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.30.2
+// source: proto/order/coupon.proto
+
+package order
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	money "proto/money"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Zero limits mean unlimited; unset timestamps leave the validity window open.
+type CouponRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ignored when creating
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code        string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// percentage or fixed
+	DiscountType string `protobuf:"bytes,4,opt,name=discount_type,json=discountType,proto3" json:"discount_type,omitempty"`
+	// The percentage of percentage coupons; for fixed coupons discount_amount takes precedence
+	DiscountValue float64 `protobuf:"fixed64,5,opt,name=discount_value,json=discountValue,proto3" json:"discount_value,omitempty"`
+	// Restrict the coupon to items of this category and/or bike type
+	CategoryId     string                 `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	BikeType       string                 `protobuf:"bytes,7,opt,name=bike_type,json=bikeType,proto3" json:"bike_type,omitempty"`
+	MinOrderValue  float64                `protobuf:"fixed64,8,opt,name=min_order_value,json=minOrderValue,proto3" json:"min_order_value,omitempty"`
+	StartsAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	MaxUses        int32                  `protobuf:"varint,11,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	MaxUsesPerUser int32                  `protobuf:"varint,12,opt,name=max_uses_per_user,json=maxUsesPerUser,proto3" json:"max_uses_per_user,omitempty"`
+	Active         bool                   `protobuf:"varint,13,opt,name=active,proto3" json:"active,omitempty"`
+	// Exact amounts; when unset discount_value and min_order_value are used
+	DiscountAmount     *money.Money `protobuf:"bytes,14,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	MinOrderValueMoney *money.Money `protobuf:"bytes,15,opt,name=min_order_value_money,json=minOrderValueMoney,proto3" json:"min_order_value_money,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CouponRequest) Reset() {
+	*x = CouponRequest{}
+	mi := &file_proto_order_coupon_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CouponRequest) ProtoMessage() {}
+
+func (x *CouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_coupon_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CouponRequest.ProtoReflect.Descriptor instead.
+func (*CouponRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_coupon_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CouponRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CouponRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CouponRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CouponRequest) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+func (x *CouponRequest) GetDiscountValue() float64 {
+	if x != nil {
+		return x.DiscountValue
+	}
+	return 0
+}
+
+func (x *CouponRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *CouponRequest) GetBikeType() string {
+	if x != nil {
+		return x.BikeType
+	}
+	return ""
+}
+
+func (x *CouponRequest) GetMinOrderValue() float64 {
+	if x != nil {
+		return x.MinOrderValue
+	}
+	return 0
+}
+
+func (x *CouponRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *CouponRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *CouponRequest) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *CouponRequest) GetMaxUsesPerUser() int32 {
+	if x != nil {
+		return x.MaxUsesPerUser
+	}
+	return 0
+}
+
+func (x *CouponRequest) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *CouponRequest) GetDiscountAmount() *money.Money {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return nil
+}
+
+func (x *CouponRequest) GetMinOrderValueMoney() *money.Money {
+	if x != nil {
+		return x.MinOrderValueMoney
+	}
+	return nil
+}
+
+type CouponIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CouponIDRequest) Reset() {
+	*x = CouponIDRequest{}
+	mi := &file_proto_order_coupon_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CouponIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CouponIDRequest) ProtoMessage() {}
+
+func (x *CouponIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_coupon_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CouponIDRequest.ProtoReflect.Descriptor instead.
+func (*CouponIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_coupon_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CouponIDRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CouponResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code           string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	DiscountType   string                 `protobuf:"bytes,4,opt,name=discount_type,json=discountType,proto3" json:"discount_type,omitempty"`
+	DiscountValue  float64                `protobuf:"fixed64,5,opt,name=discount_value,json=discountValue,proto3" json:"discount_value,omitempty"`
+	CategoryId     string                 `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	BikeType       string                 `protobuf:"bytes,7,opt,name=bike_type,json=bikeType,proto3" json:"bike_type,omitempty"`
+	MinOrderValue  float64                `protobuf:"fixed64,8,opt,name=min_order_value,json=minOrderValue,proto3" json:"min_order_value,omitempty"`
+	StartsAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	MaxUses        int32                  `protobuf:"varint,11,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	MaxUsesPerUser int32                  `protobuf:"varint,12,opt,name=max_uses_per_user,json=maxUsesPerUser,proto3" json:"max_uses_per_user,omitempty"`
+	TimesUsed      int32                  `protobuf:"varint,13,opt,name=times_used,json=timesUsed,proto3" json:"times_used,omitempty"`
+	Active         bool                   `protobuf:"varint,14,opt,name=active,proto3" json:"active,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Set for fixed coupons
+	DiscountAmount     *money.Money `protobuf:"bytes,17,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	MinOrderValueMoney *money.Money `protobuf:"bytes,18,opt,name=min_order_value_money,json=minOrderValueMoney,proto3" json:"min_order_value_money,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CouponResponse) Reset() {
+	*x = CouponResponse{}
+	mi := &file_proto_order_coupon_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CouponResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CouponResponse) ProtoMessage() {}
+
+func (x *CouponResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_coupon_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CouponResponse.ProtoReflect.Descriptor instead.
+func (*CouponResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_coupon_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CouponResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CouponResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CouponResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CouponResponse) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+func (x *CouponResponse) GetDiscountValue() float64 {
+	if x != nil {
+		return x.DiscountValue
+	}
+	return 0
+}
+
+func (x *CouponResponse) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *CouponResponse) GetBikeType() string {
+	if x != nil {
+		return x.BikeType
+	}
+	return ""
+}
+
+func (x *CouponResponse) GetMinOrderValue() float64 {
+	if x != nil {
+		return x.MinOrderValue
+	}
+	return 0
+}
+
+func (x *CouponResponse) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *CouponResponse) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *CouponResponse) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *CouponResponse) GetMaxUsesPerUser() int32 {
+	if x != nil {
+		return x.MaxUsesPerUser
+	}
+	return 0
+}
+
+func (x *CouponResponse) GetTimesUsed() int32 {
+	if x != nil {
+		return x.TimesUsed
+	}
+	return 0
+}
+
+func (x *CouponResponse) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *CouponResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CouponResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *CouponResponse) GetDiscountAmount() *money.Money {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return nil
+}
+
+func (x *CouponResponse) GetMinOrderValueMoney() *money.Money {
+	if x != nil {
+		return x.MinOrderValueMoney
+	}
+	return nil
+}
+
+type DeleteCouponResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCouponResponse) Reset() {
+	*x = DeleteCouponResponse{}
+	mi := &file_proto_order_coupon_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCouponResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCouponResponse) ProtoMessage() {}
+
+func (x *DeleteCouponResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_coupon_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCouponResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCouponResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_coupon_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteCouponResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteCouponResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListCouponsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCouponsRequest) Reset() {
+	*x = ListCouponsRequest{}
+	mi := &file_proto_order_coupon_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCouponsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCouponsRequest) ProtoMessage() {}
+
+func (x *ListCouponsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_coupon_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCouponsRequest.ProtoReflect.Descriptor instead.
+func (*ListCouponsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_coupon_proto_rawDescGZIP(), []int{4}
+}
+
+type ListCouponsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Coupons       []*CouponResponse      `protobuf:"bytes,1,rep,name=coupons,proto3" json:"coupons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCouponsResponse) Reset() {
+	*x = ListCouponsResponse{}
+	mi := &file_proto_order_coupon_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCouponsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCouponsResponse) ProtoMessage() {}
+
+func (x *ListCouponsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_coupon_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCouponsResponse.ProtoReflect.Descriptor instead.
+func (*ListCouponsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_coupon_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListCouponsResponse) GetCoupons() []*CouponResponse {
+	if x != nil {
+		return x.Coupons
+	}
+	return nil
+}
+
+var File_proto_order_coupon_proto protoreflect.FileDescriptor
+
+const file_proto_order_coupon_proto_rawDesc = "" +
+	"\n" +
+	"\x18proto/order/coupon.proto\x12\x05order\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17proto/money/money.proto\"\xcb\x04\n" +
+	"\rCouponRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12#\n" +
+	"\rdiscount_type\x18\x04 \x01(\tR\fdiscountType\x12%\n" +
+	"\x0ediscount_value\x18\x05 \x01(\x01R\rdiscountValue\x12\x1f\n" +
+	"\vcategory_id\x18\x06 \x01(\tR\n" +
+	"categoryId\x12\x1b\n" +
+	"\tbike_type\x18\a \x01(\tR\bbikeType\x12&\n" +
+	"\x0fmin_order_value\x18\b \x01(\x01R\rminOrderValue\x127\n" +
+	"\tstarts_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x19\n" +
+	"\bmax_uses\x18\v \x01(\x05R\amaxUses\x12)\n" +
+	"\x11max_uses_per_user\x18\f \x01(\x05R\x0emaxUsesPerUser\x12\x16\n" +
+	"\x06active\x18\r \x01(\bR\x06active\x125\n" +
+	"\x0fdiscount_amount\x18\x0e \x01(\v2\f.money.MoneyR\x0ediscountAmount\x12?\n" +
+	"\x15min_order_value_money\x18\x0f \x01(\v2\f.money.MoneyR\x12minOrderValueMoney\"!\n" +
+	"\x0fCouponIDRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xe1\x05\n" +
+	"\x0eCouponResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12#\n" +
+	"\rdiscount_type\x18\x04 \x01(\tR\fdiscountType\x12%\n" +
+	"\x0ediscount_value\x18\x05 \x01(\x01R\rdiscountValue\x12\x1f\n" +
+	"\vcategory_id\x18\x06 \x01(\tR\n" +
+	"categoryId\x12\x1b\n" +
+	"\tbike_type\x18\a \x01(\tR\bbikeType\x12&\n" +
+	"\x0fmin_order_value\x18\b \x01(\x01R\rminOrderValue\x127\n" +
+	"\tstarts_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x19\n" +
+	"\bmax_uses\x18\v \x01(\x05R\amaxUses\x12)\n" +
+	"\x11max_uses_per_user\x18\f \x01(\x05R\x0emaxUsesPerUser\x12\x1d\n" +
+	"\n" +
+	"times_used\x18\r \x01(\x05R\ttimesUsed\x12\x16\n" +
+	"\x06active\x18\x0e \x01(\bR\x06active\x129\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x125\n" +
+	"\x0fdiscount_amount\x18\x11 \x01(\v2\f.money.MoneyR\x0ediscountAmount\x12?\n" +
+	"\x15min_order_value_money\x18\x12 \x01(\v2\f.money.MoneyR\x12minOrderValueMoney\"J\n" +
+	"\x14DeleteCouponResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x14\n" +
+	"\x12ListCouponsRequest\"F\n" +
+	"\x13ListCouponsResponse\x12/\n" +
+	"\acoupons\x18\x01 \x03(\v2\x15.order.CouponResponseR\acoupons2\xd0\x02\n" +
+	"\rCouponService\x12;\n" +
+	"\fCreateCoupon\x12\x14.order.CouponRequest\x1a\x15.order.CouponResponse\x12:\n" +
+	"\tGetCoupon\x12\x16.order.CouponIDRequest\x1a\x15.order.CouponResponse\x12;\n" +
+	"\fUpdateCoupon\x12\x14.order.CouponRequest\x1a\x15.order.CouponResponse\x12C\n" +
+	"\fDeleteCoupon\x12\x16.order.CouponIDRequest\x1a\x1b.order.DeleteCouponResponse\x12D\n" +
+	"\vListCoupons\x12\x19.order.ListCouponsRequest\x1a\x1a.order.ListCouponsResponseB\rZ\vproto/orderb\x06proto3"
+
+var (
+	file_proto_order_coupon_proto_rawDescOnce sync.Once
+	file_proto_order_coupon_proto_rawDescData []byte
+)
+
+func file_proto_order_coupon_proto_rawDescGZIP() []byte {
+	file_proto_order_coupon_proto_rawDescOnce.Do(func() {
+		file_proto_order_coupon_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_order_coupon_proto_rawDesc), len(file_proto_order_coupon_proto_rawDesc)))
+	})
+	return file_proto_order_coupon_proto_rawDescData
+}
+
+var file_proto_order_coupon_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_proto_order_coupon_proto_goTypes = []any{
+	(*CouponRequest)(nil),         // 0: order.CouponRequest
+	(*CouponIDRequest)(nil),       // 1: order.CouponIDRequest
+	(*CouponResponse)(nil),        // 2: order.CouponResponse
+	(*DeleteCouponResponse)(nil),  // 3: order.DeleteCouponResponse
+	(*ListCouponsRequest)(nil),    // 4: order.ListCouponsRequest
+	(*ListCouponsResponse)(nil),   // 5: order.ListCouponsResponse
+	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+	(*money.Money)(nil),           // 7: money.Money
+}
+var file_proto_order_coupon_proto_depIdxs = []int32{
+	6,  // 0: order.CouponRequest.starts_at:type_name -> google.protobuf.Timestamp
+	6,  // 1: order.CouponRequest.ends_at:type_name -> google.protobuf.Timestamp
+	7,  // 2: order.CouponRequest.discount_amount:type_name -> money.Money
+	7,  // 3: order.CouponRequest.min_order_value_money:type_name -> money.Money
+	6,  // 4: order.CouponResponse.starts_at:type_name -> google.protobuf.Timestamp
+	6,  // 5: order.CouponResponse.ends_at:type_name -> google.protobuf.Timestamp
+	6,  // 6: order.CouponResponse.created_at:type_name -> google.protobuf.Timestamp
+	6,  // 7: order.CouponResponse.updated_at:type_name -> google.protobuf.Timestamp
+	7,  // 8: order.CouponResponse.discount_amount:type_name -> money.Money
+	7,  // 9: order.CouponResponse.min_order_value_money:type_name -> money.Money
+	2,  // 10: order.ListCouponsResponse.coupons:type_name -> order.CouponResponse
+	0,  // 11: order.CouponService.CreateCoupon:input_type -> order.CouponRequest
+	1,  // 12: order.CouponService.GetCoupon:input_type -> order.CouponIDRequest
+	0,  // 13: order.CouponService.UpdateCoupon:input_type -> order.CouponRequest
+	1,  // 14: order.CouponService.DeleteCoupon:input_type -> order.CouponIDRequest
+	4,  // 15: order.CouponService.ListCoupons:input_type -> order.ListCouponsRequest
+	2,  // 16: order.CouponService.CreateCoupon:output_type -> order.CouponResponse
+	2,  // 17: order.CouponService.GetCoupon:output_type -> order.CouponResponse
+	2,  // 18: order.CouponService.UpdateCoupon:output_type -> order.CouponResponse
+	3,  // 19: order.CouponService.DeleteCoupon:output_type -> order.DeleteCouponResponse
+	5,  // 20: order.CouponService.ListCoupons:output_type -> order.ListCouponsResponse
+	16, // [16:21] is the sub-list for method output_type
+	11, // [11:16] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_proto_order_coupon_proto_init() }
+func file_proto_order_coupon_proto_init() {
+	if File_proto_order_coupon_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_order_coupon_proto_rawDesc), len(file_proto_order_coupon_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_order_coupon_proto_goTypes,
+		DependencyIndexes: file_proto_order_coupon_proto_depIdxs,
+		MessageInfos:      file_proto_order_coupon_proto_msgTypes,
+	}.Build()
+	File_proto_order_coupon_proto = out.File
+	file_proto_order_coupon_proto_goTypes = nil
+	file_proto_order_coupon_proto_depIdxs = nil
+}
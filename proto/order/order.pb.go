@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	money "proto/money"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -30,6 +31,8 @@ const (
 	OrderStatus_SHIPPED   OrderStatus = 2
 	OrderStatus_DELIVERED OrderStatus = 3
 	OrderStatus_CANCELLED OrderStatus = 4
+	// A paid pickup order waiting in the store to be collected
+	OrderStatus_READY_FOR_PICKUP OrderStatus = 5
 )
 
 // Enum value maps for OrderStatus.
@@ -40,13 +43,15 @@ var (
 		2: "SHIPPED",
 		3: "DELIVERED",
 		4: "CANCELLED",
+		5: "READY_FOR_PICKUP",
 	}
 	OrderStatus_value = map[string]int32{
-		"PENDING":   0,
-		"PAID":      1,
-		"SHIPPED":   2,
-		"DELIVERED": 3,
-		"CANCELLED": 4,
+		"PENDING":          0,
+		"PAID":             1,
+		"SHIPPED":          2,
+		"DELIVERED":        3,
+		"CANCELLED":        4,
+		"READY_FOR_PICKUP": 5,
 	}
 )
 
@@ -77,10 +82,86 @@ func (OrderStatus) EnumDescriptor() ([]byte, []int) {
 	return file_proto_order_order_proto_rawDescGZIP(), []int{0}
 }
 
+type PaymentStatus int32
+
+const (
+	PaymentStatus_PAYMENT_PENDING   PaymentStatus = 0
+	PaymentStatus_PAYMENT_COMPLETED PaymentStatus = 1
+	PaymentStatus_PAYMENT_FAILED    PaymentStatus = 2
+	PaymentStatus_PAYMENT_REFUNDED  PaymentStatus = 3
+	// A full refund has been claimed and is on its way to the provider
+	PaymentStatus_PAYMENT_REFUNDING PaymentStatus = 4
+	// The order was cancelled but its refund failed; it needs refunding by hand
+	PaymentStatus_PAYMENT_REFUND_FAILED PaymentStatus = 5
+)
+
+// Enum value maps for PaymentStatus.
+var (
+	PaymentStatus_name = map[int32]string{
+		0: "PAYMENT_PENDING",
+		1: "PAYMENT_COMPLETED",
+		2: "PAYMENT_FAILED",
+		3: "PAYMENT_REFUNDED",
+		4: "PAYMENT_REFUNDING",
+		5: "PAYMENT_REFUND_FAILED",
+	}
+	PaymentStatus_value = map[string]int32{
+		"PAYMENT_PENDING":       0,
+		"PAYMENT_COMPLETED":     1,
+		"PAYMENT_FAILED":        2,
+		"PAYMENT_REFUNDED":      3,
+		"PAYMENT_REFUNDING":     4,
+		"PAYMENT_REFUND_FAILED": 5,
+	}
+)
+
+func (x PaymentStatus) Enum() *PaymentStatus {
+	p := new(PaymentStatus)
+	*p = x
+	return p
+}
+
+func (x PaymentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PaymentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_order_order_proto_enumTypes[1].Descriptor()
+}
+
+func (PaymentStatus) Type() protoreflect.EnumType {
+	return &file_proto_order_order_proto_enumTypes[1]
+}
+
+func (x PaymentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PaymentStatus.Descriptor instead.
+func (PaymentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{1}
+}
+
 type CreateOrderRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Items         []*OrderItemRequest    `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Items  []*OrderItemRequest    `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	// Optional promotion code
+	CouponCode string `protobuf:"bytes,3,opt,name=coupon_code,json=couponCode,proto3" json:"coupon_code,omitempty"`
+	// Region whose tax rate applies, e.g. "DE" or "US-CA"
+	TaxRegion string `protobuf:"bytes,4,opt,name=tax_region,json=taxRegion,proto3" json:"tax_region,omitempty"`
+	// Currency to charge the order in; empty for the base currency
+	Currency string `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Client supplied key identifying the request; a retry with the same key
+	// returns the order the first attempt created, a different request with it
+	// fails with ALREADY_EXISTS
+	IdempotencyKey string `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// Where to ship: an address from the user's address book, or an address given here.
+	// With neither, the user's default address is used if there is one.
+	AddressId       string           `protobuf:"bytes,7,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	ShippingAddress *ShippingAddress `protobuf:"bytes,8,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"`
+	// Collect the order in store instead of shipping it; pickup orders take no address
+	Pickup        *Pickup `protobuf:"bytes,9,opt,name=pickup,proto3" json:"pickup,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -129,22 +210,251 @@ func (x *CreateOrderRequest) GetItems() []*OrderItemRequest {
 	return nil
 }
 
-type OrderResponse struct {
+func (x *CreateOrderRequest) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetTaxRegion() string {
+	if x != nil {
+		return x.TaxRegion
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetAddressId() string {
+	if x != nil {
+		return x.AddressId
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetShippingAddress() *ShippingAddress {
+	if x != nil {
+		return x.ShippingAddress
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetPickup() *Pickup {
+	if x != nil {
+		return x.Pickup
+	}
+	return nil
+}
+
+// Where and on which day an order is collected in store
+type Pickup struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	StoreId string                 `protobuf:"bytes,1,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	// YYYY-MM-DD
+	Date          string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pickup) Reset() {
+	*x = Pickup{}
+	mi := &file_proto_order_order_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pickup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pickup) ProtoMessage() {}
+
+func (x *Pickup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pickup.ProtoReflect.Descriptor instead.
+func (*Pickup) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Pickup) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *Pickup) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+// The address an order ships to, copied onto the order when it is placed
+type ShippingAddress struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Status        OrderStatus            `protobuf:"varint,3,opt,name=status,proto3,enum=order.OrderStatus" json:"status,omitempty"`
-	Total         float64                `protobuf:"fixed64,4,opt,name=total,proto3" json:"total,omitempty"`
-	Items         []*OrderItemResponse   `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	RecipientName string                 `protobuf:"bytes,1,opt,name=recipient_name,json=recipientName,proto3" json:"recipient_name,omitempty"`
+	Line1         string                 `protobuf:"bytes,2,opt,name=line1,proto3" json:"line1,omitempty"`
+	Line2         string                 `protobuf:"bytes,3,opt,name=line2,proto3" json:"line2,omitempty"`
+	City          string                 `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`
+	Region        string                 `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`
+	PostalCode    string                 `protobuf:"bytes,6,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	// ISO 3166-1 alpha-2 code, e.g. "DE"
+	Country       string `protobuf:"bytes,7,opt,name=country,proto3" json:"country,omitempty"`
+	Phone         string `protobuf:"bytes,8,opt,name=phone,proto3" json:"phone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShippingAddress) Reset() {
+	*x = ShippingAddress{}
+	mi := &file_proto_order_order_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShippingAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShippingAddress) ProtoMessage() {}
+
+func (x *ShippingAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShippingAddress.ProtoReflect.Descriptor instead.
+func (*ShippingAddress) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ShippingAddress) GetRecipientName() string {
+	if x != nil {
+		return x.RecipientName
+	}
+	return ""
+}
+
+func (x *ShippingAddress) GetLine1() string {
+	if x != nil {
+		return x.Line1
+	}
+	return ""
+}
+
+func (x *ShippingAddress) GetLine2() string {
+	if x != nil {
+		return x.Line2
+	}
+	return ""
+}
+
+func (x *ShippingAddress) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *ShippingAddress) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ShippingAddress) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *ShippingAddress) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *ShippingAddress) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type OrderResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId    string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status    OrderStatus            `protobuf:"varint,3,opt,name=status,proto3,enum=order.OrderStatus" json:"status,omitempty"`
+	Total     float64                `protobuf:"fixed64,4,opt,name=total,proto3" json:"total,omitempty"`
+	Items     []*OrderItemResponse   `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Set when the order was cancelled for a reason worth showing, e.g. stock ran out
+	CancellationReason string              `protobuf:"bytes,9,opt,name=cancellation_reason,json=cancellationReason,proto3" json:"cancellation_reason,omitempty"`
+	Shipments          []*ShipmentResponse `protobuf:"bytes,10,rep,name=shipments,proto3" json:"shipments,omitempty"`
+	CouponCode         string              `protobuf:"bytes,11,opt,name=coupon_code,json=couponCode,proto3" json:"coupon_code,omitempty"`
+	// Taken off the subtotal by the coupon
+	Discount float64 `protobuf:"fixed64,12,opt,name=discount,proto3" json:"discount,omitempty"`
+	// total = subtotal - discount + tax + shipping
+	Subtotal  float64 `protobuf:"fixed64,13,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	Tax       float64 `protobuf:"fixed64,14,opt,name=tax,proto3" json:"tax,omitempty"`
+	Shipping  float64 `protobuf:"fixed64,15,opt,name=shipping,proto3" json:"shipping,omitempty"`
+	TaxRegion string  `protobuf:"bytes,16,opt,name=tax_region,json=taxRegion,proto3" json:"tax_region,omitempty"`
+	// Exact amounts; the double fields above carry the same amounts as decimals for older clients
+	TotalMoney    *money.Money `protobuf:"bytes,17,opt,name=total_money,json=totalMoney,proto3" json:"total_money,omitempty"`
+	SubtotalMoney *money.Money `protobuf:"bytes,18,opt,name=subtotal_money,json=subtotalMoney,proto3" json:"subtotal_money,omitempty"`
+	DiscountMoney *money.Money `protobuf:"bytes,19,opt,name=discount_money,json=discountMoney,proto3" json:"discount_money,omitempty"`
+	TaxMoney      *money.Money `protobuf:"bytes,20,opt,name=tax_money,json=taxMoney,proto3" json:"tax_money,omitempty"`
+	ShippingMoney *money.Money `protobuf:"bytes,21,opt,name=shipping_money,json=shippingMoney,proto3" json:"shipping_money,omitempty"`
+	// Currency of all the order's amounts, and the rate from the base currency at checkout
+	Currency     string  `protobuf:"bytes,22,opt,name=currency,proto3" json:"currency,omitempty"`
+	ExchangeRate float64 `protobuf:"fixed64,23,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	// Unset for orders placed without an address
+	ShippingAddress *ShippingAddress `protobuf:"bytes,24,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"`
+	// Set for orders collected in store
+	Pickup        *Pickup `protobuf:"bytes,25,opt,name=pickup,proto3" json:"pickup,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *OrderResponse) Reset() {
 	*x = OrderResponse{}
-	mi := &file_proto_order_order_proto_msgTypes[1]
+	mi := &file_proto_order_order_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -156,7 +466,7 @@ func (x *OrderResponse) String() string {
 func (*OrderResponse) ProtoMessage() {}
 
 func (x *OrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_order_order_proto_msgTypes[1]
+	mi := &file_proto_order_order_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -169,7 +479,7 @@ func (x *OrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrderResponse.ProtoReflect.Descriptor instead.
 func (*OrderResponse) Descriptor() ([]byte, []int) {
-	return file_proto_order_order_proto_rawDescGZIP(), []int{1}
+	return file_proto_order_order_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *OrderResponse) GetId() string {
@@ -221,173 +531,1192 @@ func (x *OrderResponse) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-type OrderIDRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *OrderResponse) GetCancellationReason() string {
+	if x != nil {
+		return x.CancellationReason
+	}
+	return ""
 }
 
-func (x *OrderIDRequest) Reset() {
-	*x = OrderIDRequest{}
-	mi := &file_proto_order_order_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *OrderResponse) GetShipments() []*ShipmentResponse {
+	if x != nil {
+		return x.Shipments
+	}
+	return nil
 }
 
-func (x *OrderIDRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *OrderResponse) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
 }
 
-func (*OrderIDRequest) ProtoMessage() {}
+func (x *OrderResponse) GetDiscount() float64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
 
-func (x *OrderIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_order_order_proto_msgTypes[2]
+func (x *OrderResponse) GetSubtotal() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Subtotal
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use OrderIDRequest.ProtoReflect.Descriptor instead.
-func (*OrderIDRequest) Descriptor() ([]byte, []int) {
-	return file_proto_order_order_proto_rawDescGZIP(), []int{2}
+func (x *OrderResponse) GetTax() float64 {
+	if x != nil {
+		return x.Tax
+	}
+	return 0
 }
 
-func (x *OrderIDRequest) GetId() string {
+func (x *OrderResponse) GetShipping() float64 {
 	if x != nil {
-		return x.Id
+		return x.Shipping
 	}
-	return ""
+	return 0
 }
 
-type UserIDRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *OrderResponse) GetTaxRegion() string {
+	if x != nil {
+		return x.TaxRegion
+	}
+	return ""
 }
 
-func (x *UserIDRequest) Reset() {
-	*x = UserIDRequest{}
-	mi := &file_proto_order_order_proto_msgTypes[3]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *OrderResponse) GetTotalMoney() *money.Money {
+	if x != nil {
+		return x.TotalMoney
+	}
+	return nil
 }
 
-func (x *UserIDRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *OrderResponse) GetSubtotalMoney() *money.Money {
+	if x != nil {
+		return x.SubtotalMoney
+	}
+	return nil
 }
 
-func (*UserIDRequest) ProtoMessage() {}
+func (x *OrderResponse) GetDiscountMoney() *money.Money {
+	if x != nil {
+		return x.DiscountMoney
+	}
+	return nil
+}
 
-func (x *UserIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_order_order_proto_msgTypes[3]
+func (x *OrderResponse) GetTaxMoney() *money.Money {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TaxMoney
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use UserIDRequest.ProtoReflect.Descriptor instead.
-func (*UserIDRequest) Descriptor() ([]byte, []int) {
-	return file_proto_order_order_proto_rawDescGZIP(), []int{3}
+func (x *OrderResponse) GetShippingMoney() *money.Money {
+	if x != nil {
+		return x.ShippingMoney
+	}
+	return nil
 }
 
-func (x *UserIDRequest) GetUserId() string {
+func (x *OrderResponse) GetCurrency() string {
 	if x != nil {
-		return x.UserId
+		return x.Currency
 	}
 	return ""
 }
 
-type UpdateOrderStatusRequest struct {
+func (x *OrderResponse) GetExchangeRate() float64 {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return 0
+}
+
+func (x *OrderResponse) GetShippingAddress() *ShippingAddress {
+	if x != nil {
+		return x.ShippingAddress
+	}
+	return nil
+}
+
+func (x *OrderResponse) GetPickup() *Pickup {
+	if x != nil {
+		return x.Pickup
+	}
+	return nil
+}
+
+type OrderIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderIDRequest) Reset() {
+	*x = OrderIDRequest{}
+	mi := &file_proto_order_order_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderIDRequest) ProtoMessage() {}
+
+func (x *OrderIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderIDRequest.ProtoReflect.Descriptor instead.
+func (*OrderIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OrderIDRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UserOrdersRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Status, date and paging options; its user_id is ignored
+	Filter        *OrderFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserOrdersRequest) Reset() {
+	*x = UserOrdersRequest{}
+	mi := &file_proto_order_order_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserOrdersRequest) ProtoMessage() {}
+
+func (x *UserOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserOrdersRequest.ProtoReflect.Descriptor instead.
+func (*UserOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UserOrdersRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserOrdersRequest) GetFilter() *OrderFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// Actor identifies who asked for a change, for the order's audit trail
+type Actor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Actor) Reset() {
+	*x = Actor{}
+	mi := &file_proto_order_order_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Actor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Actor) ProtoMessage() {}
+
+func (x *Actor) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Actor.ProtoReflect.Descriptor instead.
+func (*Actor) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Actor) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Actor) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type UpdateOrderStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Status        OrderStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=order.OrderStatus" json:"status,omitempty"`
+	Actor         *Actor                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateOrderStatusRequest) Reset() {
 	*x = UpdateOrderStatusRequest{}
-	mi := &file_proto_order_order_proto_msgTypes[4]
+	mi := &file_proto_order_order_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrderStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrderStatusRequest) ProtoMessage() {}
+
+func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrderStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrderStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateOrderStatusRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateOrderStatusRequest) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_PENDING
+}
+
+func (x *UpdateOrderStatusRequest) GetActor() *Actor {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *UpdateOrderStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UpdateOrderItemsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The order's new items; prices are looked up in the catalogue
+	Items         []*OrderItemRequest `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Actor         *Actor              `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrderItemsRequest) Reset() {
+	*x = UpdateOrderItemsRequest{}
+	mi := &file_proto_order_order_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrderItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrderItemsRequest) ProtoMessage() {}
+
+func (x *UpdateOrderItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrderItemsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrderItemsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateOrderItemsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateOrderItemsRequest) GetItems() []*OrderItemRequest {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *UpdateOrderItemsRequest) GetActor() *Actor {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+type OrderFilter struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Unset lists orders in every status
+	Status   *OrderStatus           `protobuf:"varint,2,opt,name=status,proto3,enum=order.OrderStatus,oneof" json:"status,omitempty"`
+	FromDate *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`
+	ToDate   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
+	// Offset paging; prefer page_token, which stays fast however deep the listing goes
+	Page     int32 `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize int32 `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of the previous page; page is ignored when it is set
+	PageToken     string `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderFilter) Reset() {
+	*x = OrderFilter{}
+	mi := &file_proto_order_order_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOrderStatusRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *OrderFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderFilter) ProtoMessage() {}
+
+func (x *OrderFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderFilter.ProtoReflect.Descriptor instead.
+func (*OrderFilter) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *OrderFilter) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *OrderFilter) GetStatus() OrderStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return OrderStatus_PENDING
+}
+
+func (x *OrderFilter) GetFromDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FromDate
+	}
+	return nil
+}
+
+func (x *OrderFilter) GetToDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ToDate
+	}
+	return nil
+}
+
+func (x *OrderFilter) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *OrderFilter) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *OrderFilter) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *OrderFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersRequest) Reset() {
+	*x = ListOrdersRequest{}
+	mi := &file_proto_order_order_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersRequest) ProtoMessage() {}
+
+func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListOrdersRequest) GetFilter() *OrderFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type ListOrdersResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Orders []*OrderResponse       `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	// Orders on all pages; only counted when no page_token is given, 0 otherwise
+	Total    int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page     int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Token for the next page, newest orders first; empty on the last page
+	NextPageToken string `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersResponse) Reset() {
+	*x = ListOrdersResponse{}
+	mi := &file_proto_order_order_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersResponse) ProtoMessage() {}
+
+func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListOrdersResponse) GetOrders() []*OrderResponse {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *ListOrdersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListOrdersResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListOrdersResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListOrdersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Name, price and bike attributes are resolved from inventory when the order is placed.
+// A non-zero price is compared against the catalogue and the order is rejected on mismatch.
+type OrderItemRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Price     float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity  int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	FrameSize string                 `protobuf:"bytes,5,opt,name=frame_size,json=frameSize,proto3" json:"frame_size,omitempty"`
+	WheelSize string                 `protobuf:"bytes,6,opt,name=wheel_size,json=wheelSize,proto3" json:"wheel_size,omitempty"`
+	Color     string                 `protobuf:"bytes,7,opt,name=color,proto3" json:"color,omitempty"`
+	BikeType  string                 `protobuf:"bytes,8,opt,name=bike_type,json=bikeType,proto3" json:"bike_type,omitempty"`
+	// Exact price to compare; when unset the price field is used
+	PriceMoney    *money.Money `protobuf:"bytes,9,opt,name=price_money,json=priceMoney,proto3" json:"price_money,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderItemRequest) Reset() {
+	*x = OrderItemRequest{}
+	mi := &file_proto_order_order_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderItemRequest) ProtoMessage() {}
+
+func (x *OrderItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderItemRequest.ProtoReflect.Descriptor instead.
+func (*OrderItemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *OrderItemRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *OrderItemRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OrderItemRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *OrderItemRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderItemRequest) GetFrameSize() string {
+	if x != nil {
+		return x.FrameSize
+	}
+	return ""
+}
+
+func (x *OrderItemRequest) GetWheelSize() string {
+	if x != nil {
+		return x.WheelSize
+	}
+	return ""
+}
+
+func (x *OrderItemRequest) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *OrderItemRequest) GetBikeType() string {
+	if x != nil {
+		return x.BikeType
+	}
+	return ""
+}
+
+func (x *OrderItemRequest) GetPriceMoney() *money.Money {
+	if x != nil {
+		return x.PriceMoney
+	}
+	return nil
+}
+
+type OrderItemResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId    string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ProductId  string                 `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Name       string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Price      float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity   int32                  `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	FrameSize  string                 `protobuf:"bytes,7,opt,name=frame_size,json=frameSize,proto3" json:"frame_size,omitempty"`
+	WheelSize  string                 `protobuf:"bytes,8,opt,name=wheel_size,json=wheelSize,proto3" json:"wheel_size,omitempty"`
+	Color      string                 `protobuf:"bytes,9,opt,name=color,proto3" json:"color,omitempty"`
+	BikeType   string                 `protobuf:"bytes,10,opt,name=bike_type,json=bikeType,proto3" json:"bike_type,omitempty"`
+	CategoryId string                 `protobuf:"bytes,11,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	// Weight of one unit in kg
+	Weight        float64      `protobuf:"fixed64,12,opt,name=weight,proto3" json:"weight,omitempty"`
+	PriceMoney    *money.Money `protobuf:"bytes,13,opt,name=price_money,json=priceMoney,proto3" json:"price_money,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderItemResponse) Reset() {
+	*x = OrderItemResponse{}
+	mi := &file_proto_order_order_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderItemResponse) ProtoMessage() {}
+
+func (x *OrderItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderItemResponse.ProtoReflect.Descriptor instead.
+func (*OrderItemResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *OrderItemResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OrderItemResponse) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *OrderItemResponse) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *OrderItemResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OrderItemResponse) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *OrderItemResponse) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderItemResponse) GetFrameSize() string {
+	if x != nil {
+		return x.FrameSize
+	}
+	return ""
+}
+
+func (x *OrderItemResponse) GetWheelSize() string {
+	if x != nil {
+		return x.WheelSize
+	}
+	return ""
+}
+
+func (x *OrderItemResponse) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *OrderItemResponse) GetBikeType() string {
+	if x != nil {
+		return x.BikeType
+	}
+	return ""
+}
+
+func (x *OrderItemResponse) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *OrderItemResponse) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *OrderItemResponse) GetPriceMoney() *money.Money {
+	if x != nil {
+		return x.PriceMoney
+	}
+	return nil
+}
+
+type CreatePaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Actor         *Actor                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePaymentRequest) Reset() {
+	*x = CreatePaymentRequest{}
+	mi := &file_proto_order_order_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePaymentRequest) ProtoMessage() {}
+
+func (x *CreatePaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePaymentRequest.ProtoReflect.Descriptor instead.
+func (*CreatePaymentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreatePaymentRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CreatePaymentRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *CreatePaymentRequest) GetActor() *Actor {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+type PaymentIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentIDRequest) Reset() {
+	*x = PaymentIDRequest{}
+	mi := &file_proto_order_order_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentIDRequest) ProtoMessage() {}
+
+func (x *PaymentIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentIDRequest.ProtoReflect.Descriptor instead.
+func (*PaymentIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PaymentIDRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RefundPaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Actor         *Actor                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundPaymentRequest) Reset() {
+	*x = RefundPaymentRequest{}
+	mi := &file_proto_order_order_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundPaymentRequest) ProtoMessage() {}
+
+func (x *RefundPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundPaymentRequest.ProtoReflect.Descriptor instead.
+func (*RefundPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RefundPaymentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RefundPaymentRequest) GetActor() *Actor {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *RefundPaymentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type PaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status        PaymentStatus          `protobuf:"varint,4,opt,name=status,proto3,enum=order.PaymentStatus" json:"status,omitempty"`
+	Method        string                 `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
+	TransactionId string                 `protobuf:"bytes,6,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Given back so far, in full or through returns
+	RefundedAmount      float64      `protobuf:"fixed64,9,opt,name=refunded_amount,json=refundedAmount,proto3" json:"refunded_amount,omitempty"`
+	AmountMoney         *money.Money `protobuf:"bytes,10,opt,name=amount_money,json=amountMoney,proto3" json:"amount_money,omitempty"`
+	RefundedAmountMoney *money.Money `protobuf:"bytes,11,opt,name=refunded_amount_money,json=refundedAmountMoney,proto3" json:"refunded_amount_money,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *PaymentResponse) Reset() {
+	*x = PaymentResponse{}
+	mi := &file_proto_order_order_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentResponse) ProtoMessage() {}
+
+func (x *PaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentResponse.ProtoReflect.Descriptor instead.
+func (*PaymentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PaymentResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PaymentResponse) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *PaymentResponse) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PaymentResponse) GetStatus() PaymentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PaymentStatus_PAYMENT_PENDING
+}
+
+func (x *PaymentResponse) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *PaymentResponse) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *PaymentResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (*UpdateOrderStatusRequest) ProtoMessage() {}
-
-func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_order_order_proto_msgTypes[4]
+func (x *PaymentResponse) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.UpdatedAt
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use UpdateOrderStatusRequest.ProtoReflect.Descriptor instead.
-func (*UpdateOrderStatusRequest) Descriptor() ([]byte, []int) {
-	return file_proto_order_order_proto_rawDescGZIP(), []int{4}
+func (x *PaymentResponse) GetRefundedAmount() float64 {
+	if x != nil {
+		return x.RefundedAmount
+	}
+	return 0
 }
 
-func (x *UpdateOrderStatusRequest) GetId() string {
+func (x *PaymentResponse) GetAmountMoney() *money.Money {
 	if x != nil {
-		return x.Id
+		return x.AmountMoney
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateOrderStatusRequest) GetStatus() OrderStatus {
+func (x *PaymentResponse) GetRefundedAmountMoney() *money.Money {
 	if x != nil {
-		return x.Status
+		return x.RefundedAmountMoney
 	}
-	return OrderStatus_PENDING
+	return nil
 }
 
-type OrderFilter struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Status        OrderStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=order.OrderStatus" json:"status,omitempty"`
-	FromDate      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`
-	ToDate        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
-	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+type OrderStatusChange struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// Empty for the entry recording the order's creation
+	FromStatus    string                 `protobuf:"bytes,3,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"`
+	ToStatus      string                 `protobuf:"bytes,4,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`
+	ActorId       string                 `protobuf:"bytes,5,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorRole     string                 `protobuf:"bytes,6,opt,name=actor_role,json=actorRole,proto3" json:"actor_role,omitempty"`
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderFilter) Reset() {
-	*x = OrderFilter{}
-	mi := &file_proto_order_order_proto_msgTypes[5]
+func (x *OrderStatusChange) Reset() {
+	*x = OrderStatusChange{}
+	mi := &file_proto_order_order_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderFilter) String() string {
+func (x *OrderStatusChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderFilter) ProtoMessage() {}
+func (*OrderStatusChange) ProtoMessage() {}
 
-func (x *OrderFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_order_order_proto_msgTypes[5]
+func (x *OrderStatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -398,75 +1727,89 @@ func (x *OrderFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderFilter.ProtoReflect.Descriptor instead.
-func (*OrderFilter) Descriptor() ([]byte, []int) {
-	return file_proto_order_order_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use OrderStatusChange.ProtoReflect.Descriptor instead.
+func (*OrderStatusChange) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *OrderFilter) GetUserId() string {
+func (x *OrderStatusChange) GetId() string {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *OrderFilter) GetStatus() OrderStatus {
+func (x *OrderStatusChange) GetOrderId() string {
 	if x != nil {
-		return x.Status
+		return x.OrderId
 	}
-	return OrderStatus_PENDING
+	return ""
 }
 
-func (x *OrderFilter) GetFromDate() *timestamppb.Timestamp {
+func (x *OrderStatusChange) GetFromStatus() string {
 	if x != nil {
-		return x.FromDate
+		return x.FromStatus
 	}
-	return nil
+	return ""
 }
 
-func (x *OrderFilter) GetToDate() *timestamppb.Timestamp {
+func (x *OrderStatusChange) GetToStatus() string {
 	if x != nil {
-		return x.ToDate
+		return x.ToStatus
 	}
-	return nil
+	return ""
 }
 
-func (x *OrderFilter) GetPage() int32 {
+func (x *OrderStatusChange) GetActorId() string {
 	if x != nil {
-		return x.Page
+		return x.ActorId
 	}
-	return 0
+	return ""
 }
 
-func (x *OrderFilter) GetPageSize() int32 {
+func (x *OrderStatusChange) GetActorRole() string {
 	if x != nil {
-		return x.PageSize
+		return x.ActorRole
 	}
-	return 0
+	return ""
 }
 
-type ListOrdersRequest struct {
+func (x *OrderStatusChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *OrderStatusChange) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type OrderHistoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Filter        *OrderFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Changes       []*OrderStatusChange   `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOrdersRequest) Reset() {
-	*x = ListOrdersRequest{}
-	mi := &file_proto_order_order_proto_msgTypes[6]
+func (x *OrderHistoryResponse) Reset() {
+	*x = OrderHistoryResponse{}
+	mi := &file_proto_order_order_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOrdersRequest) String() string {
+func (x *OrderHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrdersRequest) ProtoMessage() {}
+func (*OrderHistoryResponse) ProtoMessage() {}
 
-func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_order_order_proto_msgTypes[6]
+func (x *OrderHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -477,43 +1820,42 @@ func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
-func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_proto_order_order_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use OrderHistoryResponse.ProtoReflect.Descriptor instead.
+func (*OrderHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ListOrdersRequest) GetFilter() *OrderFilter {
+func (x *OrderHistoryResponse) GetChanges() []*OrderStatusChange {
 	if x != nil {
-		return x.Filter
+		return x.Changes
 	}
 	return nil
 }
 
-type ListOrdersResponse struct {
+type ShipmentItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Orders        []*OrderResponse       `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	OrderItemId   string                 `protobuf:"bytes,1,opt,name=order_item_id,json=orderItemId,proto3" json:"order_item_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOrdersResponse) Reset() {
-	*x = ListOrdersResponse{}
-	mi := &file_proto_order_order_proto_msgTypes[7]
+func (x *ShipmentItem) Reset() {
+	*x = ShipmentItem{}
+	mi := &file_proto_order_order_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOrdersResponse) String() string {
+func (x *ShipmentItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrdersResponse) ProtoMessage() {}
+func (*ShipmentItem) ProtoMessage() {}
 
-func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_order_order_proto_msgTypes[7]
+func (x *ShipmentItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -524,68 +1866,61 @@ func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
-func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_proto_order_order_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *ListOrdersResponse) GetOrders() []*OrderResponse {
-	if x != nil {
-		return x.Orders
-	}
-	return nil
+// Deprecated: Use ShipmentItem.ProtoReflect.Descriptor instead.
+func (*ShipmentItem) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ListOrdersResponse) GetTotal() int32 {
+func (x *ShipmentItem) GetOrderItemId() string {
 	if x != nil {
-		return x.Total
+		return x.OrderItemId
 	}
-	return 0
+	return ""
 }
 
-func (x *ListOrdersResponse) GetPage() int32 {
+func (x *ShipmentItem) GetProductId() string {
 	if x != nil {
-		return x.Page
+		return x.ProductId
 	}
-	return 0
+	return ""
 }
 
-func (x *ListOrdersResponse) GetPageSize() int32 {
+func (x *ShipmentItem) GetQuantity() int32 {
 	if x != nil {
-		return x.PageSize
+		return x.Quantity
 	}
 	return 0
 }
 
-type OrderItemRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
-	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	FrameSize     string                 `protobuf:"bytes,5,opt,name=frame_size,json=frameSize,proto3" json:"frame_size,omitempty"`
-	WheelSize     string                 `protobuf:"bytes,6,opt,name=wheel_size,json=wheelSize,proto3" json:"wheel_size,omitempty"`
-	Color         string                 `protobuf:"bytes,7,opt,name=color,proto3" json:"color,omitempty"`
-	BikeType      string                 `protobuf:"bytes,8,opt,name=bike_type,json=bikeType,proto3" json:"bike_type,omitempty"`
+type CreateShipmentRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderId        string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Carrier        string                 `protobuf:"bytes,2,opt,name=carrier,proto3" json:"carrier,omitempty"`
+	TrackingNumber string                 `protobuf:"bytes,3,opt,name=tracking_number,json=trackingNumber,proto3" json:"tracking_number,omitempty"`
+	// Leave empty to ship everything not yet shipped
+	Items []*ShipmentItem `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	// Defaults to now
+	ShippedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=shipped_at,json=shippedAt,proto3" json:"shipped_at,omitempty"`
+	Actor         *Actor                 `protobuf:"bytes,6,opt,name=actor,proto3" json:"actor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderItemRequest) Reset() {
-	*x = OrderItemRequest{}
-	mi := &file_proto_order_order_proto_msgTypes[8]
+func (x *CreateShipmentRequest) Reset() {
+	*x = CreateShipmentRequest{}
+	mi := &file_proto_order_order_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderItemRequest) String() string {
+func (x *CreateShipmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderItemRequest) ProtoMessage() {}
+func (*CreateShipmentRequest) ProtoMessage() {}
 
-func (x *OrderItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_order_order_proto_msgTypes[8]
+func (x *CreateShipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -596,98 +1931,81 @@ func (x *OrderItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderItemRequest.ProtoReflect.Descriptor instead.
-func (*OrderItemRequest) Descriptor() ([]byte, []int) {
-	return file_proto_order_order_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use CreateShipmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateShipmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *OrderItemRequest) GetProductId() string {
+func (x *CreateShipmentRequest) GetOrderId() string {
 	if x != nil {
-		return x.ProductId
+		return x.OrderId
 	}
 	return ""
 }
 
-func (x *OrderItemRequest) GetName() string {
+func (x *CreateShipmentRequest) GetCarrier() string {
 	if x != nil {
-		return x.Name
+		return x.Carrier
 	}
 	return ""
 }
 
-func (x *OrderItemRequest) GetPrice() float64 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
-}
-
-func (x *OrderItemRequest) GetQuantity() int32 {
-	if x != nil {
-		return x.Quantity
-	}
-	return 0
-}
-
-func (x *OrderItemRequest) GetFrameSize() string {
+func (x *CreateShipmentRequest) GetTrackingNumber() string {
 	if x != nil {
-		return x.FrameSize
+		return x.TrackingNumber
 	}
 	return ""
 }
 
-func (x *OrderItemRequest) GetWheelSize() string {
+func (x *CreateShipmentRequest) GetItems() []*ShipmentItem {
 	if x != nil {
-		return x.WheelSize
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-func (x *OrderItemRequest) GetColor() string {
+func (x *CreateShipmentRequest) GetShippedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Color
+		return x.ShippedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *OrderItemRequest) GetBikeType() string {
+func (x *CreateShipmentRequest) GetActor() *Actor {
 	if x != nil {
-		return x.BikeType
+		return x.Actor
 	}
-	return ""
+	return nil
 }
 
-type OrderItemResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	ProductId     string                 `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Price         float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
-	Quantity      int32                  `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	FrameSize     string                 `protobuf:"bytes,7,opt,name=frame_size,json=frameSize,proto3" json:"frame_size,omitempty"`
-	WheelSize     string                 `protobuf:"bytes,8,opt,name=wheel_size,json=wheelSize,proto3" json:"wheel_size,omitempty"`
-	Color         string                 `protobuf:"bytes,9,opt,name=color,proto3" json:"color,omitempty"`
-	BikeType      string                 `protobuf:"bytes,10,opt,name=bike_type,json=bikeType,proto3" json:"bike_type,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ShipmentResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId        string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Carrier        string                 `protobuf:"bytes,3,opt,name=carrier,proto3" json:"carrier,omitempty"`
+	TrackingNumber string                 `protobuf:"bytes,4,opt,name=tracking_number,json=trackingNumber,proto3" json:"tracking_number,omitempty"`
+	ShippedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=shipped_at,json=shippedAt,proto3" json:"shipped_at,omitempty"`
+	Items          []*ShipmentItem        `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *OrderItemResponse) Reset() {
-	*x = OrderItemResponse{}
-	mi := &file_proto_order_order_proto_msgTypes[9]
+func (x *ShipmentResponse) Reset() {
+	*x = ShipmentResponse{}
+	mi := &file_proto_order_order_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderItemResponse) String() string {
+func (x *ShipmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderItemResponse) ProtoMessage() {}
+func (*ShipmentResponse) ProtoMessage() {}
 
-func (x *OrderItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_order_order_proto_msgTypes[9]
+func (x *ShipmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -698,89 +2016,135 @@ func (x *OrderItemResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderItemResponse.ProtoReflect.Descriptor instead.
-func (*OrderItemResponse) Descriptor() ([]byte, []int) {
-	return file_proto_order_order_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ShipmentResponse.ProtoReflect.Descriptor instead.
+func (*ShipmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *OrderItemResponse) GetId() string {
+func (x *ShipmentResponse) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *OrderItemResponse) GetOrderId() string {
+func (x *ShipmentResponse) GetOrderId() string {
 	if x != nil {
 		return x.OrderId
 	}
 	return ""
 }
 
-func (x *OrderItemResponse) GetProductId() string {
+func (x *ShipmentResponse) GetCarrier() string {
 	if x != nil {
-		return x.ProductId
+		return x.Carrier
 	}
 	return ""
 }
 
-func (x *OrderItemResponse) GetName() string {
+func (x *ShipmentResponse) GetTrackingNumber() string {
 	if x != nil {
-		return x.Name
+		return x.TrackingNumber
 	}
 	return ""
 }
 
-func (x *OrderItemResponse) GetPrice() float64 {
+func (x *ShipmentResponse) GetShippedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Price
+		return x.ShippedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *OrderItemResponse) GetQuantity() int32 {
+func (x *ShipmentResponse) GetItems() []*ShipmentItem {
 	if x != nil {
-		return x.Quantity
+		return x.Items
 	}
-	return 0
+	return nil
 }
 
-func (x *OrderItemResponse) GetFrameSize() string {
+func (x *ShipmentResponse) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.FrameSize
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *OrderItemResponse) GetWheelSize() string {
-	if x != nil {
-		return x.WheelSize
-	}
-	return ""
+type ShipmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shipments     []*ShipmentResponse    `protobuf:"bytes,1,rep,name=shipments,proto3" json:"shipments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderItemResponse) GetColor() string {
+func (x *ShipmentsResponse) Reset() {
+	*x = ShipmentsResponse{}
+	mi := &file_proto_order_order_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipmentsResponse) ProtoMessage() {}
+
+func (x *ShipmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_order_proto_msgTypes[23]
 	if x != nil {
-		return x.Color
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *OrderItemResponse) GetBikeType() string {
+// Deprecated: Use ShipmentsResponse.ProtoReflect.Descriptor instead.
+func (*ShipmentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_order_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ShipmentsResponse) GetShipments() []*ShipmentResponse {
 	if x != nil {
-		return x.BikeType
+		return x.Shipments
 	}
-	return ""
+	return nil
 }
 
 var File_proto_order_order_proto protoreflect.FileDescriptor
 
 const file_proto_order_order_proto_rawDesc = "" +
 	"\n" +
-	"\x17proto/order/order.proto\x12\x05order\x1a\x1fgoogle/protobuf/timestamp.proto\"\\\n" +
+	"\x17proto/order/order.proto\x12\x05order\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17proto/money/money.proto\"\xea\x02\n" +
 	"\x12CreateOrderRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12-\n" +
-	"\x05items\x18\x02 \x03(\v2\x17.order.OrderItemRequestR\x05items\"\xa0\x02\n" +
+	"\x05items\x18\x02 \x03(\v2\x17.order.OrderItemRequestR\x05items\x12\x1f\n" +
+	"\vcoupon_code\x18\x03 \x01(\tR\n" +
+	"couponCode\x12\x1d\n" +
+	"\n" +
+	"tax_region\x18\x04 \x01(\tR\ttaxRegion\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12'\n" +
+	"\x0fidempotency_key\x18\x06 \x01(\tR\x0eidempotencyKey\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\a \x01(\tR\taddressId\x12A\n" +
+	"\x10shipping_address\x18\b \x01(\v2\x16.order.ShippingAddressR\x0fshippingAddress\x12%\n" +
+	"\x06pickup\x18\t \x01(\v2\r.order.PickupR\x06pickup\"7\n" +
+	"\x06Pickup\x12\x19\n" +
+	"\bstore_id\x18\x01 \x01(\tR\astoreId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\"\xe1\x01\n" +
+	"\x0fShippingAddress\x12%\n" +
+	"\x0erecipient_name\x18\x01 \x01(\tR\rrecipientName\x12\x14\n" +
+	"\x05line1\x18\x02 \x01(\tR\x05line1\x12\x14\n" +
+	"\x05line2\x18\x03 \x01(\tR\x05line2\x12\x12\n" +
+	"\x04city\x18\x04 \x01(\tR\x04city\x12\x16\n" +
+	"\x06region\x18\x05 \x01(\tR\x06region\x12\x1f\n" +
+	"\vpostal_code\x18\x06 \x01(\tR\n" +
+	"postalCode\x12\x18\n" +
+	"\acountry\x18\a \x01(\tR\acountry\x12\x14\n" +
+	"\x05phone\x18\b \x01(\tR\x05phone\"\xd2\a\n" +
 	"\rOrderResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12*\n" +
@@ -790,28 +2154,63 @@ const file_proto_order_order_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\" \n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12/\n" +
+	"\x13cancellation_reason\x18\t \x01(\tR\x12cancellationReason\x125\n" +
+	"\tshipments\x18\n" +
+	" \x03(\v2\x17.order.ShipmentResponseR\tshipments\x12\x1f\n" +
+	"\vcoupon_code\x18\v \x01(\tR\n" +
+	"couponCode\x12\x1a\n" +
+	"\bdiscount\x18\f \x01(\x01R\bdiscount\x12\x1a\n" +
+	"\bsubtotal\x18\r \x01(\x01R\bsubtotal\x12\x10\n" +
+	"\x03tax\x18\x0e \x01(\x01R\x03tax\x12\x1a\n" +
+	"\bshipping\x18\x0f \x01(\x01R\bshipping\x12\x1d\n" +
+	"\n" +
+	"tax_region\x18\x10 \x01(\tR\ttaxRegion\x12-\n" +
+	"\vtotal_money\x18\x11 \x01(\v2\f.money.MoneyR\n" +
+	"totalMoney\x123\n" +
+	"\x0esubtotal_money\x18\x12 \x01(\v2\f.money.MoneyR\rsubtotalMoney\x123\n" +
+	"\x0ediscount_money\x18\x13 \x01(\v2\f.money.MoneyR\rdiscountMoney\x12)\n" +
+	"\ttax_money\x18\x14 \x01(\v2\f.money.MoneyR\btaxMoney\x123\n" +
+	"\x0eshipping_money\x18\x15 \x01(\v2\f.money.MoneyR\rshippingMoney\x12\x1a\n" +
+	"\bcurrency\x18\x16 \x01(\tR\bcurrency\x12#\n" +
+	"\rexchange_rate\x18\x17 \x01(\x01R\fexchangeRate\x12A\n" +
+	"\x10shipping_address\x18\x18 \x01(\v2\x16.order.ShippingAddressR\x0fshippingAddress\x12%\n" +
+	"\x06pickup\x18\x19 \x01(\v2\r.order.PickupR\x06pickup\" \n" +
 	"\x0eOrderIDRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"(\n" +
-	"\rUserIDRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"V\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"X\n" +
+	"\x11UserOrdersRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12*\n" +
+	"\x06filter\x18\x02 \x01(\v2\x12.order.OrderFilterR\x06filter\"+\n" +
+	"\x05Actor\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\"\x92\x01\n" +
 	"\x18UpdateOrderStatusRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12*\n" +
-	"\x06status\x18\x02 \x01(\x0e2\x12.order.OrderStatusR\x06status\"\xf1\x01\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x12.order.OrderStatusR\x06status\x12\"\n" +
+	"\x05actor\x18\x03 \x01(\v2\f.order.ActorR\x05actor\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"|\n" +
+	"\x17UpdateOrderItemsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12-\n" +
+	"\x05items\x18\x02 \x03(\v2\x17.order.OrderItemRequestR\x05items\x12\"\n" +
+	"\x05actor\x18\x03 \x01(\v2\f.order.ActorR\x05actor\"\xa0\x02\n" +
 	"\vOrderFilter\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12*\n" +
-	"\x06status\x18\x02 \x01(\x0e2\x12.order.OrderStatusR\x06status\x127\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12/\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x12.order.OrderStatusH\x00R\x06status\x88\x01\x01\x127\n" +
 	"\tfrom_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bfromDate\x123\n" +
 	"\ato_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06toDate\x12\x12\n" +
 	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\"?\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageTokenB\t\n" +
+	"\a_status\"?\n" +
 	"\x11ListOrdersRequest\x12*\n" +
-	"\x06filter\x18\x01 \x01(\v2\x12.order.OrderFilterR\x06filter\"\x89\x01\n" +
+	"\x06filter\x18\x01 \x01(\v2\x12.order.OrderFilterR\x06filter\"\xb1\x01\n" +
 	"\x12ListOrdersResponse\x12,\n" +
 	"\x06orders\x18\x01 \x03(\v2\x14.order.OrderResponseR\x06orders\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xe8\x01\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tR\rnextPageToken\"\x97\x02\n" +
 	"\x10OrderItemRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
@@ -823,7 +2222,9 @@ const file_proto_order_order_proto_rawDesc = "" +
 	"\n" +
 	"wheel_size\x18\x06 \x01(\tR\twheelSize\x12\x14\n" +
 	"\x05color\x18\a \x01(\tR\x05color\x12\x1b\n" +
-	"\tbike_type\x18\b \x01(\tR\bbikeType\"\x94\x02\n" +
+	"\tbike_type\x18\b \x01(\tR\bbikeType\x12-\n" +
+	"\vprice_money\x18\t \x01(\v2\f.money.MoneyR\n" +
+	"priceMoney\"\xfc\x02\n" +
 	"\x11OrderItemResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x1d\n" +
@@ -838,20 +2239,105 @@ const file_proto_order_order_proto_rawDesc = "" +
 	"wheel_size\x18\b \x01(\tR\twheelSize\x12\x14\n" +
 	"\x05color\x18\t \x01(\tR\x05color\x12\x1b\n" +
 	"\tbike_type\x18\n" +
-	" \x01(\tR\bbikeType*O\n" +
+	" \x01(\tR\bbikeType\x12\x1f\n" +
+	"\vcategory_id\x18\v \x01(\tR\n" +
+	"categoryId\x12\x16\n" +
+	"\x06weight\x18\f \x01(\x01R\x06weight\x12-\n" +
+	"\vprice_money\x18\r \x01(\v2\f.money.MoneyR\n" +
+	"priceMoney\"m\n" +
+	"\x14CreatePaymentRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12\"\n" +
+	"\x05actor\x18\x03 \x01(\v2\f.order.ActorR\x05actor\"\"\n" +
+	"\x10PaymentIDRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"b\n" +
+	"\x14RefundPaymentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\"\n" +
+	"\x05actor\x18\x02 \x01(\v2\f.order.ActorR\x05actor\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xd3\x03\n" +
+	"\x0fPaymentResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12,\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x14.order.PaymentStatusR\x06status\x12\x16\n" +
+	"\x06method\x18\x05 \x01(\tR\x06method\x12%\n" +
+	"\x0etransaction_id\x18\x06 \x01(\tR\rtransactionId\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12'\n" +
+	"\x0frefunded_amount\x18\t \x01(\x01R\x0erefundedAmount\x12/\n" +
+	"\famount_money\x18\n" +
+	" \x01(\v2\f.money.MoneyR\vamountMoney\x12@\n" +
+	"\x15refunded_amount_money\x18\v \x01(\v2\f.money.MoneyR\x13refundedAmountMoney\"\x89\x02\n" +
+	"\x11OrderStatusChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x1f\n" +
+	"\vfrom_status\x18\x03 \x01(\tR\n" +
+	"fromStatus\x12\x1b\n" +
+	"\tto_status\x18\x04 \x01(\tR\btoStatus\x12\x19\n" +
+	"\bactor_id\x18\x05 \x01(\tR\aactorId\x12\x1d\n" +
+	"\n" +
+	"actor_role\x18\x06 \x01(\tR\tactorRole\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"J\n" +
+	"\x14OrderHistoryResponse\x122\n" +
+	"\achanges\x18\x01 \x03(\v2\x18.order.OrderStatusChangeR\achanges\"m\n" +
+	"\fShipmentItem\x12\"\n" +
+	"\rorder_item_id\x18\x01 \x01(\tR\vorderItemId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\"\xff\x01\n" +
+	"\x15CreateShipmentRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x18\n" +
+	"\acarrier\x18\x02 \x01(\tR\acarrier\x12'\n" +
+	"\x0ftracking_number\x18\x03 \x01(\tR\x0etrackingNumber\x12)\n" +
+	"\x05items\x18\x04 \x03(\v2\x13.order.ShipmentItemR\x05items\x129\n" +
+	"\n" +
+	"shipped_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tshippedAt\x12\"\n" +
+	"\x05actor\x18\x06 \x01(\v2\f.order.ActorR\x05actor\"\xa1\x02\n" +
+	"\x10ShipmentResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x18\n" +
+	"\acarrier\x18\x03 \x01(\tR\acarrier\x12'\n" +
+	"\x0ftracking_number\x18\x04 \x01(\tR\x0etrackingNumber\x129\n" +
+	"\n" +
+	"shipped_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tshippedAt\x12)\n" +
+	"\x05items\x18\x06 \x03(\v2\x13.order.ShipmentItemR\x05items\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"J\n" +
+	"\x11ShipmentsResponse\x125\n" +
+	"\tshipments\x18\x01 \x03(\v2\x17.order.ShipmentResponseR\tshipments*e\n" +
 	"\vOrderStatus\x12\v\n" +
 	"\aPENDING\x10\x00\x12\b\n" +
 	"\x04PAID\x10\x01\x12\v\n" +
 	"\aSHIPPED\x10\x02\x12\r\n" +
 	"\tDELIVERED\x10\x03\x12\r\n" +
-	"\tCANCELLED\x10\x042\xd8\x02\n" +
+	"\tCANCELLED\x10\x04\x12\x14\n" +
+	"\x10READY_FOR_PICKUP\x10\x05*\x97\x01\n" +
+	"\rPaymentStatus\x12\x13\n" +
+	"\x0fPAYMENT_PENDING\x10\x00\x12\x15\n" +
+	"\x11PAYMENT_COMPLETED\x10\x01\x12\x12\n" +
+	"\x0ePAYMENT_FAILED\x10\x02\x12\x14\n" +
+	"\x10PAYMENT_REFUNDED\x10\x03\x12\x15\n" +
+	"\x11PAYMENT_REFUNDING\x10\x04\x12\x19\n" +
+	"\x15PAYMENT_REFUND_FAILED\x10\x052\xc2\x06\n" +
 	"\fOrderService\x12>\n" +
 	"\vCreateOrder\x12\x19.order.CreateOrderRequest\x1a\x14.order.OrderResponse\x127\n" +
 	"\bGetOrder\x12\x15.order.OrderIDRequest\x1a\x14.order.OrderResponse\x12J\n" +
-	"\x11UpdateOrderStatus\x12\x1f.order.UpdateOrderStatusRequest\x1a\x14.order.OrderResponse\x12A\n" +
+	"\x11UpdateOrderStatus\x12\x1f.order.UpdateOrderStatusRequest\x1a\x14.order.OrderResponse\x12H\n" +
+	"\x10UpdateOrderItems\x12\x1e.order.UpdateOrderItemsRequest\x1a\x14.order.OrderResponse\x12A\n" +
+	"\n" +
+	"ListOrders\x12\x18.order.ListOrdersRequest\x1a\x19.order.ListOrdersResponse\x12D\n" +
+	"\rGetUserOrders\x12\x18.order.UserOrdersRequest\x1a\x19.order.ListOrdersResponse\x12D\n" +
+	"\rCreatePayment\x12\x1b.order.CreatePaymentRequest\x1a\x16.order.PaymentResponse\x12=\n" +
 	"\n" +
-	"ListOrders\x12\x18.order.ListOrdersRequest\x1a\x19.order.ListOrdersResponse\x12@\n" +
-	"\rGetUserOrders\x12\x14.order.UserIDRequest\x1a\x19.order.ListOrdersResponseB\rZ\vproto/orderb\x06proto3"
+	"GetPayment\x12\x17.order.PaymentIDRequest\x1a\x16.order.PaymentResponse\x12D\n" +
+	"\rRefundPayment\x12\x1b.order.RefundPaymentRequest\x1a\x16.order.PaymentResponse\x12E\n" +
+	"\x0fGetOrderHistory\x12\x15.order.OrderIDRequest\x1a\x1b.order.OrderHistoryResponse\x12G\n" +
+	"\x0eCreateShipment\x12\x1c.order.CreateShipmentRequest\x1a\x17.order.ShipmentResponse\x12?\n" +
+	"\fGetShipments\x12\x15.order.OrderIDRequest\x1a\x18.order.ShipmentsResponseB\rZ\vproto/orderb\x06proto3"
 
 var (
 	file_proto_order_order_proto_rawDescOnce sync.Once
@@ -865,49 +2351,111 @@ func file_proto_order_order_proto_rawDescGZIP() []byte {
 	return file_proto_order_order_proto_rawDescData
 }
 
-var file_proto_order_order_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_order_order_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_proto_order_order_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_order_order_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_proto_order_order_proto_goTypes = []any{
 	(OrderStatus)(0),                 // 0: order.OrderStatus
-	(*CreateOrderRequest)(nil),       // 1: order.CreateOrderRequest
-	(*OrderResponse)(nil),            // 2: order.OrderResponse
-	(*OrderIDRequest)(nil),           // 3: order.OrderIDRequest
-	(*UserIDRequest)(nil),            // 4: order.UserIDRequest
-	(*UpdateOrderStatusRequest)(nil), // 5: order.UpdateOrderStatusRequest
-	(*OrderFilter)(nil),              // 6: order.OrderFilter
-	(*ListOrdersRequest)(nil),        // 7: order.ListOrdersRequest
-	(*ListOrdersResponse)(nil),       // 8: order.ListOrdersResponse
-	(*OrderItemRequest)(nil),         // 9: order.OrderItemRequest
-	(*OrderItemResponse)(nil),        // 10: order.OrderItemResponse
-	(*timestamppb.Timestamp)(nil),    // 11: google.protobuf.Timestamp
+	(PaymentStatus)(0),               // 1: order.PaymentStatus
+	(*CreateOrderRequest)(nil),       // 2: order.CreateOrderRequest
+	(*Pickup)(nil),                   // 3: order.Pickup
+	(*ShippingAddress)(nil),          // 4: order.ShippingAddress
+	(*OrderResponse)(nil),            // 5: order.OrderResponse
+	(*OrderIDRequest)(nil),           // 6: order.OrderIDRequest
+	(*UserOrdersRequest)(nil),        // 7: order.UserOrdersRequest
+	(*Actor)(nil),                    // 8: order.Actor
+	(*UpdateOrderStatusRequest)(nil), // 9: order.UpdateOrderStatusRequest
+	(*UpdateOrderItemsRequest)(nil),  // 10: order.UpdateOrderItemsRequest
+	(*OrderFilter)(nil),              // 11: order.OrderFilter
+	(*ListOrdersRequest)(nil),        // 12: order.ListOrdersRequest
+	(*ListOrdersResponse)(nil),       // 13: order.ListOrdersResponse
+	(*OrderItemRequest)(nil),         // 14: order.OrderItemRequest
+	(*OrderItemResponse)(nil),        // 15: order.OrderItemResponse
+	(*CreatePaymentRequest)(nil),     // 16: order.CreatePaymentRequest
+	(*PaymentIDRequest)(nil),         // 17: order.PaymentIDRequest
+	(*RefundPaymentRequest)(nil),     // 18: order.RefundPaymentRequest
+	(*PaymentResponse)(nil),          // 19: order.PaymentResponse
+	(*OrderStatusChange)(nil),        // 20: order.OrderStatusChange
+	(*OrderHistoryResponse)(nil),     // 21: order.OrderHistoryResponse
+	(*ShipmentItem)(nil),             // 22: order.ShipmentItem
+	(*CreateShipmentRequest)(nil),    // 23: order.CreateShipmentRequest
+	(*ShipmentResponse)(nil),         // 24: order.ShipmentResponse
+	(*ShipmentsResponse)(nil),        // 25: order.ShipmentsResponse
+	(*timestamppb.Timestamp)(nil),    // 26: google.protobuf.Timestamp
+	(*money.Money)(nil),              // 27: money.Money
 }
 var file_proto_order_order_proto_depIdxs = []int32{
-	9,  // 0: order.CreateOrderRequest.items:type_name -> order.OrderItemRequest
-	0,  // 1: order.OrderResponse.status:type_name -> order.OrderStatus
-	10, // 2: order.OrderResponse.items:type_name -> order.OrderItemResponse
-	11, // 3: order.OrderResponse.created_at:type_name -> google.protobuf.Timestamp
-	11, // 4: order.OrderResponse.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 5: order.UpdateOrderStatusRequest.status:type_name -> order.OrderStatus
-	0,  // 6: order.OrderFilter.status:type_name -> order.OrderStatus
-	11, // 7: order.OrderFilter.from_date:type_name -> google.protobuf.Timestamp
-	11, // 8: order.OrderFilter.to_date:type_name -> google.protobuf.Timestamp
-	6,  // 9: order.ListOrdersRequest.filter:type_name -> order.OrderFilter
-	2,  // 10: order.ListOrdersResponse.orders:type_name -> order.OrderResponse
-	1,  // 11: order.OrderService.CreateOrder:input_type -> order.CreateOrderRequest
-	3,  // 12: order.OrderService.GetOrder:input_type -> order.OrderIDRequest
-	5,  // 13: order.OrderService.UpdateOrderStatus:input_type -> order.UpdateOrderStatusRequest
-	7,  // 14: order.OrderService.ListOrders:input_type -> order.ListOrdersRequest
-	4,  // 15: order.OrderService.GetUserOrders:input_type -> order.UserIDRequest
-	2,  // 16: order.OrderService.CreateOrder:output_type -> order.OrderResponse
-	2,  // 17: order.OrderService.GetOrder:output_type -> order.OrderResponse
-	2,  // 18: order.OrderService.UpdateOrderStatus:output_type -> order.OrderResponse
-	8,  // 19: order.OrderService.ListOrders:output_type -> order.ListOrdersResponse
-	8,  // 20: order.OrderService.GetUserOrders:output_type -> order.ListOrdersResponse
-	16, // [16:21] is the sub-list for method output_type
-	11, // [11:16] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	14, // 0: order.CreateOrderRequest.items:type_name -> order.OrderItemRequest
+	4,  // 1: order.CreateOrderRequest.shipping_address:type_name -> order.ShippingAddress
+	3,  // 2: order.CreateOrderRequest.pickup:type_name -> order.Pickup
+	0,  // 3: order.OrderResponse.status:type_name -> order.OrderStatus
+	15, // 4: order.OrderResponse.items:type_name -> order.OrderItemResponse
+	26, // 5: order.OrderResponse.created_at:type_name -> google.protobuf.Timestamp
+	26, // 6: order.OrderResponse.updated_at:type_name -> google.protobuf.Timestamp
+	24, // 7: order.OrderResponse.shipments:type_name -> order.ShipmentResponse
+	27, // 8: order.OrderResponse.total_money:type_name -> money.Money
+	27, // 9: order.OrderResponse.subtotal_money:type_name -> money.Money
+	27, // 10: order.OrderResponse.discount_money:type_name -> money.Money
+	27, // 11: order.OrderResponse.tax_money:type_name -> money.Money
+	27, // 12: order.OrderResponse.shipping_money:type_name -> money.Money
+	4,  // 13: order.OrderResponse.shipping_address:type_name -> order.ShippingAddress
+	3,  // 14: order.OrderResponse.pickup:type_name -> order.Pickup
+	11, // 15: order.UserOrdersRequest.filter:type_name -> order.OrderFilter
+	0,  // 16: order.UpdateOrderStatusRequest.status:type_name -> order.OrderStatus
+	8,  // 17: order.UpdateOrderStatusRequest.actor:type_name -> order.Actor
+	14, // 18: order.UpdateOrderItemsRequest.items:type_name -> order.OrderItemRequest
+	8,  // 19: order.UpdateOrderItemsRequest.actor:type_name -> order.Actor
+	0,  // 20: order.OrderFilter.status:type_name -> order.OrderStatus
+	26, // 21: order.OrderFilter.from_date:type_name -> google.protobuf.Timestamp
+	26, // 22: order.OrderFilter.to_date:type_name -> google.protobuf.Timestamp
+	11, // 23: order.ListOrdersRequest.filter:type_name -> order.OrderFilter
+	5,  // 24: order.ListOrdersResponse.orders:type_name -> order.OrderResponse
+	27, // 25: order.OrderItemRequest.price_money:type_name -> money.Money
+	27, // 26: order.OrderItemResponse.price_money:type_name -> money.Money
+	8,  // 27: order.CreatePaymentRequest.actor:type_name -> order.Actor
+	8,  // 28: order.RefundPaymentRequest.actor:type_name -> order.Actor
+	1,  // 29: order.PaymentResponse.status:type_name -> order.PaymentStatus
+	26, // 30: order.PaymentResponse.created_at:type_name -> google.protobuf.Timestamp
+	26, // 31: order.PaymentResponse.updated_at:type_name -> google.protobuf.Timestamp
+	27, // 32: order.PaymentResponse.amount_money:type_name -> money.Money
+	27, // 33: order.PaymentResponse.refunded_amount_money:type_name -> money.Money
+	26, // 34: order.OrderStatusChange.created_at:type_name -> google.protobuf.Timestamp
+	20, // 35: order.OrderHistoryResponse.changes:type_name -> order.OrderStatusChange
+	22, // 36: order.CreateShipmentRequest.items:type_name -> order.ShipmentItem
+	26, // 37: order.CreateShipmentRequest.shipped_at:type_name -> google.protobuf.Timestamp
+	8,  // 38: order.CreateShipmentRequest.actor:type_name -> order.Actor
+	26, // 39: order.ShipmentResponse.shipped_at:type_name -> google.protobuf.Timestamp
+	22, // 40: order.ShipmentResponse.items:type_name -> order.ShipmentItem
+	26, // 41: order.ShipmentResponse.created_at:type_name -> google.protobuf.Timestamp
+	24, // 42: order.ShipmentsResponse.shipments:type_name -> order.ShipmentResponse
+	2,  // 43: order.OrderService.CreateOrder:input_type -> order.CreateOrderRequest
+	6,  // 44: order.OrderService.GetOrder:input_type -> order.OrderIDRequest
+	9,  // 45: order.OrderService.UpdateOrderStatus:input_type -> order.UpdateOrderStatusRequest
+	10, // 46: order.OrderService.UpdateOrderItems:input_type -> order.UpdateOrderItemsRequest
+	12, // 47: order.OrderService.ListOrders:input_type -> order.ListOrdersRequest
+	7,  // 48: order.OrderService.GetUserOrders:input_type -> order.UserOrdersRequest
+	16, // 49: order.OrderService.CreatePayment:input_type -> order.CreatePaymentRequest
+	17, // 50: order.OrderService.GetPayment:input_type -> order.PaymentIDRequest
+	18, // 51: order.OrderService.RefundPayment:input_type -> order.RefundPaymentRequest
+	6,  // 52: order.OrderService.GetOrderHistory:input_type -> order.OrderIDRequest
+	23, // 53: order.OrderService.CreateShipment:input_type -> order.CreateShipmentRequest
+	6,  // 54: order.OrderService.GetShipments:input_type -> order.OrderIDRequest
+	5,  // 55: order.OrderService.CreateOrder:output_type -> order.OrderResponse
+	5,  // 56: order.OrderService.GetOrder:output_type -> order.OrderResponse
+	5,  // 57: order.OrderService.UpdateOrderStatus:output_type -> order.OrderResponse
+	5,  // 58: order.OrderService.UpdateOrderItems:output_type -> order.OrderResponse
+	13, // 59: order.OrderService.ListOrders:output_type -> order.ListOrdersResponse
+	13, // 60: order.OrderService.GetUserOrders:output_type -> order.ListOrdersResponse
+	19, // 61: order.OrderService.CreatePayment:output_type -> order.PaymentResponse
+	19, // 62: order.OrderService.GetPayment:output_type -> order.PaymentResponse
+	19, // 63: order.OrderService.RefundPayment:output_type -> order.PaymentResponse
+	21, // 64: order.OrderService.GetOrderHistory:output_type -> order.OrderHistoryResponse
+	24, // 65: order.OrderService.CreateShipment:output_type -> order.ShipmentResponse
+	25, // 66: order.OrderService.GetShipments:output_type -> order.ShipmentsResponse
+	55, // [55:67] is the sub-list for method output_type
+	43, // [43:55] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
 }
 
 func init() { file_proto_order_order_proto_init() }
@@ -915,13 +2463,14 @@ func file_proto_order_order_proto_init() {
 	if File_proto_order_order_proto != nil {
 		return
 	}
+	file_proto_order_order_proto_msgTypes[9].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_order_order_proto_rawDesc), len(file_proto_order_order_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   10,
+			NumEnums:      2,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
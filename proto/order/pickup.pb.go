@@ -0,0 +1,804 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.30.2
+// source: proto/order/pickup.proto
+
+package order
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StoreRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ignored when creating
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address       string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	DailyCapacity int32  `protobuf:"varint,4,opt,name=daily_capacity,json=dailyCapacity,proto3" json:"daily_capacity,omitempty"`
+	Active        bool   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoreRequest) Reset() {
+	*x = StoreRequest{}
+	mi := &file_proto_order_pickup_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreRequest) ProtoMessage() {}
+
+func (x *StoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_pickup_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreRequest.ProtoReflect.Descriptor instead.
+func (*StoreRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_pickup_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StoreRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StoreRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StoreRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *StoreRequest) GetDailyCapacity() int32 {
+	if x != nil {
+		return x.DailyCapacity
+	}
+	return 0
+}
+
+func (x *StoreRequest) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type StoreIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoreIDRequest) Reset() {
+	*x = StoreIDRequest{}
+	mi := &file_proto_order_pickup_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoreIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreIDRequest) ProtoMessage() {}
+
+func (x *StoreIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_pickup_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreIDRequest.ProtoReflect.Descriptor instead.
+func (*StoreIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_pickup_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StoreIDRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type StoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	DailyCapacity int32                  `protobuf:"varint,4,opt,name=daily_capacity,json=dailyCapacity,proto3" json:"daily_capacity,omitempty"`
+	Active        bool                   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoreResponse) Reset() {
+	*x = StoreResponse{}
+	mi := &file_proto_order_pickup_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreResponse) ProtoMessage() {}
+
+func (x *StoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_pickup_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreResponse.ProtoReflect.Descriptor instead.
+func (*StoreResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_pickup_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StoreResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StoreResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StoreResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *StoreResponse) GetDailyCapacity() int32 {
+	if x != nil {
+		return x.DailyCapacity
+	}
+	return 0
+}
+
+func (x *StoreResponse) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *StoreResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *StoreResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListStoresRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Also list stores that no longer take pickups
+	IncludeInactive bool `protobuf:"varint,1,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListStoresRequest) Reset() {
+	*x = ListStoresRequest{}
+	mi := &file_proto_order_pickup_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStoresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStoresRequest) ProtoMessage() {}
+
+func (x *ListStoresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_pickup_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStoresRequest.ProtoReflect.Descriptor instead.
+func (*ListStoresRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_pickup_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListStoresRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+type ListStoresResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stores        []*StoreResponse       `protobuf:"bytes,1,rep,name=stores,proto3" json:"stores,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStoresResponse) Reset() {
+	*x = ListStoresResponse{}
+	mi := &file_proto_order_pickup_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStoresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStoresResponse) ProtoMessage() {}
+
+func (x *ListStoresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_pickup_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStoresResponse.ProtoReflect.Descriptor instead.
+func (*ListStoresResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_pickup_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListStoresResponse) GetStores() []*StoreResponse {
+	if x != nil {
+		return x.Stores
+	}
+	return nil
+}
+
+type PickupSlotsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	StoreId string                 `protobuf:"bytes,1,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	// First day to list, YYYY-MM-DD; empty for the first day pickups can be booked on
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// Number of days to list; 0 for the default of two weeks
+	Days          int32 `protobuf:"varint,3,opt,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickupSlotsRequest) Reset() {
+	*x = PickupSlotsRequest{}
+	mi := &file_proto_order_pickup_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupSlotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupSlotsRequest) ProtoMessage() {}
+
+func (x *PickupSlotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_pickup_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupSlotsRequest.ProtoReflect.Descriptor instead.
+func (*PickupSlotsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_pickup_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PickupSlotsRequest) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *PickupSlotsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *PickupSlotsRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type PickupSlot struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// YYYY-MM-DD
+	Date          string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Capacity      int32  `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Booked        int32  `protobuf:"varint,3,opt,name=booked,proto3" json:"booked,omitempty"`
+	Remaining     int32  `protobuf:"varint,4,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickupSlot) Reset() {
+	*x = PickupSlot{}
+	mi := &file_proto_order_pickup_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupSlot) ProtoMessage() {}
+
+func (x *PickupSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_pickup_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupSlot.ProtoReflect.Descriptor instead.
+func (*PickupSlot) Descriptor() ([]byte, []int) {
+	return file_proto_order_pickup_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PickupSlot) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *PickupSlot) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *PickupSlot) GetBooked() int32 {
+	if x != nil {
+		return x.Booked
+	}
+	return 0
+}
+
+func (x *PickupSlot) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+type PickupSlotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StoreId       string                 `protobuf:"bytes,1,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	Slots         []*PickupSlot          `protobuf:"bytes,2,rep,name=slots,proto3" json:"slots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickupSlotsResponse) Reset() {
+	*x = PickupSlotsResponse{}
+	mi := &file_proto_order_pickup_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupSlotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupSlotsResponse) ProtoMessage() {}
+
+func (x *PickupSlotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_pickup_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupSlotsResponse.ProtoReflect.Descriptor instead.
+func (*PickupSlotsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_pickup_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PickupSlotsResponse) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *PickupSlotsResponse) GetSlots() []*PickupSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+type DailyPickupsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// YYYY-MM-DD
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// Only this store; empty for every store taking pickups
+	StoreId       string `protobuf:"bytes,2,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyPickupsRequest) Reset() {
+	*x = DailyPickupsRequest{}
+	mi := &file_proto_order_pickup_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyPickupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyPickupsRequest) ProtoMessage() {}
+
+func (x *DailyPickupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_pickup_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyPickupsRequest.ProtoReflect.Descriptor instead.
+func (*DailyPickupsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_pickup_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DailyPickupsRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DailyPickupsRequest) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+type StorePickups struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Store         *StoreResponse         `protobuf:"bytes,1,opt,name=store,proto3" json:"store,omitempty"`
+	Orders        []*OrderResponse       `protobuf:"bytes,2,rep,name=orders,proto3" json:"orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StorePickups) Reset() {
+	*x = StorePickups{}
+	mi := &file_proto_order_pickup_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StorePickups) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorePickups) ProtoMessage() {}
+
+func (x *StorePickups) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_pickup_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorePickups.ProtoReflect.Descriptor instead.
+func (*StorePickups) Descriptor() ([]byte, []int) {
+	return file_proto_order_pickup_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *StorePickups) GetStore() *StoreResponse {
+	if x != nil {
+		return x.Store
+	}
+	return nil
+}
+
+func (x *StorePickups) GetOrders() []*OrderResponse {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type DailyPickupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Stores        []*StorePickups        `protobuf:"bytes,2,rep,name=stores,proto3" json:"stores,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyPickupsResponse) Reset() {
+	*x = DailyPickupsResponse{}
+	mi := &file_proto_order_pickup_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyPickupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyPickupsResponse) ProtoMessage() {}
+
+func (x *DailyPickupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_pickup_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyPickupsResponse.ProtoReflect.Descriptor instead.
+func (*DailyPickupsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_pickup_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DailyPickupsResponse) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DailyPickupsResponse) GetStores() []*StorePickups {
+	if x != nil {
+		return x.Stores
+	}
+	return nil
+}
+
+var File_proto_order_pickup_proto protoreflect.FileDescriptor
+
+const file_proto_order_pickup_proto_rawDesc = "" +
+	"\n" +
+	"\x18proto/order/pickup.proto\x12\x05order\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17proto/order/order.proto\"\x8b\x01\n" +
+	"\fStoreRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x12%\n" +
+	"\x0edaily_capacity\x18\x04 \x01(\x05R\rdailyCapacity\x12\x16\n" +
+	"\x06active\x18\x05 \x01(\bR\x06active\" \n" +
+	"\x0eStoreIDRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x82\x02\n" +
+	"\rStoreResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x12%\n" +
+	"\x0edaily_capacity\x18\x04 \x01(\x05R\rdailyCapacity\x12\x16\n" +
+	"\x06active\x18\x05 \x01(\bR\x06active\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\">\n" +
+	"\x11ListStoresRequest\x12)\n" +
+	"\x10include_inactive\x18\x01 \x01(\bR\x0fincludeInactive\"B\n" +
+	"\x12ListStoresResponse\x12,\n" +
+	"\x06stores\x18\x01 \x03(\v2\x14.order.StoreResponseR\x06stores\"W\n" +
+	"\x12PickupSlotsRequest\x12\x19\n" +
+	"\bstore_id\x18\x01 \x01(\tR\astoreId\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x12\n" +
+	"\x04days\x18\x03 \x01(\x05R\x04days\"r\n" +
+	"\n" +
+	"PickupSlot\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x1a\n" +
+	"\bcapacity\x18\x02 \x01(\x05R\bcapacity\x12\x16\n" +
+	"\x06booked\x18\x03 \x01(\x05R\x06booked\x12\x1c\n" +
+	"\tremaining\x18\x04 \x01(\x05R\tremaining\"Y\n" +
+	"\x13PickupSlotsResponse\x12\x19\n" +
+	"\bstore_id\x18\x01 \x01(\tR\astoreId\x12'\n" +
+	"\x05slots\x18\x02 \x03(\v2\x11.order.PickupSlotR\x05slots\"D\n" +
+	"\x13DailyPickupsRequest\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x19\n" +
+	"\bstore_id\x18\x02 \x01(\tR\astoreId\"h\n" +
+	"\fStorePickups\x12*\n" +
+	"\x05store\x18\x01 \x01(\v2\x14.order.StoreResponseR\x05store\x12,\n" +
+	"\x06orders\x18\x02 \x03(\v2\x14.order.OrderResponseR\x06orders\"W\n" +
+	"\x14DailyPickupsResponse\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12+\n" +
+	"\x06stores\x18\x02 \x03(\v2\x13.order.StorePickupsR\x06stores2\x94\x03\n" +
+	"\rPickupService\x128\n" +
+	"\vCreateStore\x12\x13.order.StoreRequest\x1a\x14.order.StoreResponse\x127\n" +
+	"\bGetStore\x12\x15.order.StoreIDRequest\x1a\x14.order.StoreResponse\x128\n" +
+	"\vUpdateStore\x12\x13.order.StoreRequest\x1a\x14.order.StoreResponse\x12A\n" +
+	"\n" +
+	"ListStores\x12\x18.order.ListStoresRequest\x1a\x19.order.ListStoresResponse\x12G\n" +
+	"\x0eGetPickupSlots\x12\x19.order.PickupSlotsRequest\x1a\x1a.order.PickupSlotsResponse\x12J\n" +
+	"\x0fGetDailyPickups\x12\x1a.order.DailyPickupsRequest\x1a\x1b.order.DailyPickupsResponseB\rZ\vproto/orderb\x06proto3"
+
+var (
+	file_proto_order_pickup_proto_rawDescOnce sync.Once
+	file_proto_order_pickup_proto_rawDescData []byte
+)
+
+func file_proto_order_pickup_proto_rawDescGZIP() []byte {
+	file_proto_order_pickup_proto_rawDescOnce.Do(func() {
+		file_proto_order_pickup_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_order_pickup_proto_rawDesc), len(file_proto_order_pickup_proto_rawDesc)))
+	})
+	return file_proto_order_pickup_proto_rawDescData
+}
+
+var file_proto_order_pickup_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_proto_order_pickup_proto_goTypes = []any{
+	(*StoreRequest)(nil),          // 0: order.StoreRequest
+	(*StoreIDRequest)(nil),        // 1: order.StoreIDRequest
+	(*StoreResponse)(nil),         // 2: order.StoreResponse
+	(*ListStoresRequest)(nil),     // 3: order.ListStoresRequest
+	(*ListStoresResponse)(nil),    // 4: order.ListStoresResponse
+	(*PickupSlotsRequest)(nil),    // 5: order.PickupSlotsRequest
+	(*PickupSlot)(nil),            // 6: order.PickupSlot
+	(*PickupSlotsResponse)(nil),   // 7: order.PickupSlotsResponse
+	(*DailyPickupsRequest)(nil),   // 8: order.DailyPickupsRequest
+	(*StorePickups)(nil),          // 9: order.StorePickups
+	(*DailyPickupsResponse)(nil),  // 10: order.DailyPickupsResponse
+	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(*OrderResponse)(nil),         // 12: order.OrderResponse
+}
+var file_proto_order_pickup_proto_depIdxs = []int32{
+	11, // 0: order.StoreResponse.created_at:type_name -> google.protobuf.Timestamp
+	11, // 1: order.StoreResponse.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 2: order.ListStoresResponse.stores:type_name -> order.StoreResponse
+	6,  // 3: order.PickupSlotsResponse.slots:type_name -> order.PickupSlot
+	2,  // 4: order.StorePickups.store:type_name -> order.StoreResponse
+	12, // 5: order.StorePickups.orders:type_name -> order.OrderResponse
+	9,  // 6: order.DailyPickupsResponse.stores:type_name -> order.StorePickups
+	0,  // 7: order.PickupService.CreateStore:input_type -> order.StoreRequest
+	1,  // 8: order.PickupService.GetStore:input_type -> order.StoreIDRequest
+	0,  // 9: order.PickupService.UpdateStore:input_type -> order.StoreRequest
+	3,  // 10: order.PickupService.ListStores:input_type -> order.ListStoresRequest
+	5,  // 11: order.PickupService.GetPickupSlots:input_type -> order.PickupSlotsRequest
+	8,  // 12: order.PickupService.GetDailyPickups:input_type -> order.DailyPickupsRequest
+	2,  // 13: order.PickupService.CreateStore:output_type -> order.StoreResponse
+	2,  // 14: order.PickupService.GetStore:output_type -> order.StoreResponse
+	2,  // 15: order.PickupService.UpdateStore:output_type -> order.StoreResponse
+	4,  // 16: order.PickupService.ListStores:output_type -> order.ListStoresResponse
+	7,  // 17: order.PickupService.GetPickupSlots:output_type -> order.PickupSlotsResponse
+	10, // 18: order.PickupService.GetDailyPickups:output_type -> order.DailyPickupsResponse
+	13, // [13:19] is the sub-list for method output_type
+	7,  // [7:13] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_proto_order_pickup_proto_init() }
+func file_proto_order_pickup_proto_init() {
+	if File_proto_order_pickup_proto != nil {
+		return
+	}
+	file_proto_order_order_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_order_pickup_proto_rawDesc), len(file_proto_order_pickup_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_order_pickup_proto_goTypes,
+		DependencyIndexes: file_proto_order_pickup_proto_depIdxs,
+		MessageInfos:      file_proto_order_pickup_proto_msgTypes,
+	}.Build()
+	File_proto_order_pickup_proto = out.File
+	file_proto_order_pickup_proto_goTypes = nil
+	file_proto_order_pickup_proto_depIdxs = nil
+}
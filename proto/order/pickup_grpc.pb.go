@@ -0,0 +1,321 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.30.2
+// source: proto/order/pickup.proto
+
+package order
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PickupService_CreateStore_FullMethodName     = "/order.PickupService/CreateStore"
+	PickupService_GetStore_FullMethodName        = "/order.PickupService/GetStore"
+	PickupService_UpdateStore_FullMethodName     = "/order.PickupService/UpdateStore"
+	PickupService_ListStores_FullMethodName      = "/order.PickupService/ListStores"
+	PickupService_GetPickupSlots_FullMethodName  = "/order.PickupService/GetPickupSlots"
+	PickupService_GetDailyPickups_FullMethodName = "/order.PickupService/GetDailyPickups"
+)
+
+// PickupServiceClient is the client API for PickupService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Stores where customers collect their orders and the days they can collect them on.
+// Each store takes up to its daily capacity of pickups per day.
+type PickupServiceClient interface {
+	CreateStore(ctx context.Context, in *StoreRequest, opts ...grpc.CallOption) (*StoreResponse, error)
+	GetStore(ctx context.Context, in *StoreIDRequest, opts ...grpc.CallOption) (*StoreResponse, error)
+	UpdateStore(ctx context.Context, in *StoreRequest, opts ...grpc.CallOption) (*StoreResponse, error)
+	ListStores(ctx context.Context, in *ListStoresRequest, opts ...grpc.CallOption) (*ListStoresResponse, error)
+	// The days a store has pickups left on
+	GetPickupSlots(ctx context.Context, in *PickupSlotsRequest, opts ...grpc.CallOption) (*PickupSlotsResponse, error)
+	// The orders to be collected on a day, per store
+	GetDailyPickups(ctx context.Context, in *DailyPickupsRequest, opts ...grpc.CallOption) (*DailyPickupsResponse, error)
+}
+
+type pickupServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPickupServiceClient(cc grpc.ClientConnInterface) PickupServiceClient {
+	return &pickupServiceClient{cc}
+}
+
+func (c *pickupServiceClient) CreateStore(ctx context.Context, in *StoreRequest, opts ...grpc.CallOption) (*StoreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StoreResponse)
+	err := c.cc.Invoke(ctx, PickupService_CreateStore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pickupServiceClient) GetStore(ctx context.Context, in *StoreIDRequest, opts ...grpc.CallOption) (*StoreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StoreResponse)
+	err := c.cc.Invoke(ctx, PickupService_GetStore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pickupServiceClient) UpdateStore(ctx context.Context, in *StoreRequest, opts ...grpc.CallOption) (*StoreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StoreResponse)
+	err := c.cc.Invoke(ctx, PickupService_UpdateStore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pickupServiceClient) ListStores(ctx context.Context, in *ListStoresRequest, opts ...grpc.CallOption) (*ListStoresResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStoresResponse)
+	err := c.cc.Invoke(ctx, PickupService_ListStores_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pickupServiceClient) GetPickupSlots(ctx context.Context, in *PickupSlotsRequest, opts ...grpc.CallOption) (*PickupSlotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PickupSlotsResponse)
+	err := c.cc.Invoke(ctx, PickupService_GetPickupSlots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pickupServiceClient) GetDailyPickups(ctx context.Context, in *DailyPickupsRequest, opts ...grpc.CallOption) (*DailyPickupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DailyPickupsResponse)
+	err := c.cc.Invoke(ctx, PickupService_GetDailyPickups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PickupServiceServer is the server API for PickupService service.
+// All implementations must embed UnimplementedPickupServiceServer
+// for forward compatibility.
+//
+// Stores where customers collect their orders and the days they can collect them on.
+// Each store takes up to its daily capacity of pickups per day.
+type PickupServiceServer interface {
+	CreateStore(context.Context, *StoreRequest) (*StoreResponse, error)
+	GetStore(context.Context, *StoreIDRequest) (*StoreResponse, error)
+	UpdateStore(context.Context, *StoreRequest) (*StoreResponse, error)
+	ListStores(context.Context, *ListStoresRequest) (*ListStoresResponse, error)
+	// The days a store has pickups left on
+	GetPickupSlots(context.Context, *PickupSlotsRequest) (*PickupSlotsResponse, error)
+	// The orders to be collected on a day, per store
+	GetDailyPickups(context.Context, *DailyPickupsRequest) (*DailyPickupsResponse, error)
+	mustEmbedUnimplementedPickupServiceServer()
+}
+
+// UnimplementedPickupServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPickupServiceServer struct{}
+
+func (UnimplementedPickupServiceServer) CreateStore(context.Context, *StoreRequest) (*StoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStore not implemented")
+}
+func (UnimplementedPickupServiceServer) GetStore(context.Context, *StoreIDRequest) (*StoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStore not implemented")
+}
+func (UnimplementedPickupServiceServer) UpdateStore(context.Context, *StoreRequest) (*StoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStore not implemented")
+}
+func (UnimplementedPickupServiceServer) ListStores(context.Context, *ListStoresRequest) (*ListStoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStores not implemented")
+}
+func (UnimplementedPickupServiceServer) GetPickupSlots(context.Context, *PickupSlotsRequest) (*PickupSlotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPickupSlots not implemented")
+}
+func (UnimplementedPickupServiceServer) GetDailyPickups(context.Context, *DailyPickupsRequest) (*DailyPickupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDailyPickups not implemented")
+}
+func (UnimplementedPickupServiceServer) mustEmbedUnimplementedPickupServiceServer() {}
+func (UnimplementedPickupServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafePickupServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PickupServiceServer will
+// result in compilation errors.
+type UnsafePickupServiceServer interface {
+	mustEmbedUnimplementedPickupServiceServer()
+}
+
+func RegisterPickupServiceServer(s grpc.ServiceRegistrar, srv PickupServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPickupServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PickupService_ServiceDesc, srv)
+}
+
+func _PickupService_CreateStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PickupServiceServer).CreateStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PickupService_CreateStore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PickupServiceServer).CreateStore(ctx, req.(*StoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PickupService_GetStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoreIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PickupServiceServer).GetStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PickupService_GetStore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PickupServiceServer).GetStore(ctx, req.(*StoreIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PickupService_UpdateStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PickupServiceServer).UpdateStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PickupService_UpdateStore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PickupServiceServer).UpdateStore(ctx, req.(*StoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PickupService_ListStores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PickupServiceServer).ListStores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PickupService_ListStores_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PickupServiceServer).ListStores(ctx, req.(*ListStoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PickupService_GetPickupSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PickupSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PickupServiceServer).GetPickupSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PickupService_GetPickupSlots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PickupServiceServer).GetPickupSlots(ctx, req.(*PickupSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PickupService_GetDailyPickups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DailyPickupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PickupServiceServer).GetDailyPickups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PickupService_GetDailyPickups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PickupServiceServer).GetDailyPickups(ctx, req.(*DailyPickupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PickupService_ServiceDesc is the grpc.ServiceDesc for PickupService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PickupService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "order.PickupService",
+	HandlerType: (*PickupServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateStore",
+			Handler:    _PickupService_CreateStore_Handler,
+		},
+		{
+			MethodName: "GetStore",
+			Handler:    _PickupService_GetStore_Handler,
+		},
+		{
+			MethodName: "UpdateStore",
+			Handler:    _PickupService_UpdateStore_Handler,
+		},
+		{
+			MethodName: "ListStores",
+			Handler:    _PickupService_ListStores_Handler,
+		},
+		{
+			MethodName: "GetPickupSlots",
+			Handler:    _PickupService_GetPickupSlots_Handler,
+		},
+		{
+			MethodName: "GetDailyPickups",
+			Handler:    _PickupService_GetDailyPickups_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/order/pickup.proto",
+}
@@ -0,0 +1,842 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.30.2
+// source: proto/order/returns.proto
+
+package order
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	money "proto/money"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReturnItem struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	OrderItemId string                 `protobuf:"bytes,1,opt,name=order_item_id,json=orderItemId,proto3" json:"order_item_id,omitempty"`
+	ProductId   string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity    int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Price the item was sold for; ignored in requests
+	Price      float64      `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	PriceMoney *money.Money `protobuf:"bytes,5,opt,name=price_money,json=priceMoney,proto3" json:"price_money,omitempty"`
+	// Share of the order's discount and tax for the returned quantity; ignored in requests
+	Discount      *money.Money `protobuf:"bytes,6,opt,name=discount,proto3" json:"discount,omitempty"`
+	Tax           *money.Money `protobuf:"bytes,7,opt,name=tax,proto3" json:"tax,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnItem) Reset() {
+	*x = ReturnItem{}
+	mi := &file_proto_order_returns_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnItem) ProtoMessage() {}
+
+func (x *ReturnItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_returns_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnItem.ProtoReflect.Descriptor instead.
+func (*ReturnItem) Descriptor() ([]byte, []int) {
+	return file_proto_order_returns_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReturnItem) GetOrderItemId() string {
+	if x != nil {
+		return x.OrderItemId
+	}
+	return ""
+}
+
+func (x *ReturnItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ReturnItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReturnItem) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ReturnItem) GetPriceMoney() *money.Money {
+	if x != nil {
+		return x.PriceMoney
+	}
+	return nil
+}
+
+func (x *ReturnItem) GetDiscount() *money.Money {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *ReturnItem) GetTax() *money.Money {
+	if x != nil {
+		return x.Tax
+	}
+	return nil
+}
+
+type RequestReturnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Items         []*ReturnItem          `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestReturnRequest) Reset() {
+	*x = RequestReturnRequest{}
+	mi := &file_proto_order_returns_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestReturnRequest) ProtoMessage() {}
+
+func (x *RequestReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_returns_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestReturnRequest.ProtoReflect.Descriptor instead.
+func (*RequestReturnRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_returns_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RequestReturnRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *RequestReturnRequest) GetItems() []*ReturnItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *RequestReturnRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReturnIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnIDRequest) Reset() {
+	*x = ReturnIDRequest{}
+	mi := &file_proto_order_returns_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnIDRequest) ProtoMessage() {}
+
+func (x *ReturnIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_returns_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnIDRequest.ProtoReflect.Descriptor instead.
+func (*ReturnIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_returns_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReturnIDRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type OrderReturnsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderReturnsRequest) Reset() {
+	*x = OrderReturnsRequest{}
+	mi := &file_proto_order_returns_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderReturnsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderReturnsRequest) ProtoMessage() {}
+
+func (x *OrderReturnsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_returns_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderReturnsRequest.ProtoReflect.Descriptor instead.
+func (*OrderReturnsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_returns_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OrderReturnsRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type ListReturnsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Empty for all statuses
+	Status        string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Page          int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReturnsRequest) Reset() {
+	*x = ListReturnsRequest{}
+	mi := &file_proto_order_returns_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReturnsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReturnsRequest) ProtoMessage() {}
+
+func (x *ListReturnsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_returns_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReturnsRequest.ProtoReflect.Descriptor instead.
+func (*ListReturnsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_returns_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListReturnsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListReturnsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListReturnsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ResolveReturnRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Required when rejecting
+	Note          string `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveReturnRequest) Reset() {
+	*x = ResolveReturnRequest{}
+	mi := &file_proto_order_returns_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveReturnRequest) ProtoMessage() {}
+
+func (x *ResolveReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_returns_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveReturnRequest.ProtoReflect.Descriptor instead.
+func (*ResolveReturnRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_returns_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ResolveReturnRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ResolveReturnRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ReceiveReturnRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Put the items back into inventory; leave false for damaged items
+	Restock       bool `protobuf:"varint,2,opt,name=restock,proto3" json:"restock,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiveReturnRequest) Reset() {
+	*x = ReceiveReturnRequest{}
+	mi := &file_proto_order_returns_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveReturnRequest) ProtoMessage() {}
+
+func (x *ReceiveReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_returns_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveReturnRequest.ProtoReflect.Descriptor instead.
+func (*ReceiveReturnRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_returns_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReceiveReturnRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReceiveReturnRequest) GetRestock() bool {
+	if x != nil {
+		return x.Restock
+	}
+	return false
+}
+
+type RefundReturnRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Zero refunds the full value of the returned items
+	Amount float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Exact amount; when unset the amount field is used
+	AmountMoney   *money.Money `protobuf:"bytes,3,opt,name=amount_money,json=amountMoney,proto3" json:"amount_money,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundReturnRequest) Reset() {
+	*x = RefundReturnRequest{}
+	mi := &file_proto_order_returns_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundReturnRequest) ProtoMessage() {}
+
+func (x *RefundReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_returns_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundReturnRequest.ProtoReflect.Descriptor instead.
+func (*RefundReturnRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_returns_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RefundReturnRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RefundReturnRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RefundReturnRequest) GetAmountMoney() *money.Money {
+	if x != nil {
+		return x.AmountMoney
+	}
+	return nil
+}
+
+type ReturnResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId  string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// requested, approved, rejected, received, refunding or refunded
+	Status            string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Reason            string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Items             []*ReturnItem          `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`
+	ResolutionNote    string                 `protobuf:"bytes,7,opt,name=resolution_note,json=resolutionNote,proto3" json:"resolution_note,omitempty"`
+	Restocked         bool                   `protobuf:"varint,8,opt,name=restocked,proto3" json:"restocked,omitempty"`
+	RefundAmount      float64                `protobuf:"fixed64,9,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`
+	PaymentId         string                 `protobuf:"bytes,10,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	RefundAmountMoney *money.Money           `protobuf:"bytes,13,opt,name=refund_amount_money,json=refundAmountMoney,proto3" json:"refund_amount_money,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ReturnResponse) Reset() {
+	*x = ReturnResponse{}
+	mi := &file_proto_order_returns_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnResponse) ProtoMessage() {}
+
+func (x *ReturnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_returns_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnResponse.ProtoReflect.Descriptor instead.
+func (*ReturnResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_returns_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReturnResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReturnResponse) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ReturnResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReturnResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReturnResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReturnResponse) GetItems() []*ReturnItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ReturnResponse) GetResolutionNote() string {
+	if x != nil {
+		return x.ResolutionNote
+	}
+	return ""
+}
+
+func (x *ReturnResponse) GetRestocked() bool {
+	if x != nil {
+		return x.Restocked
+	}
+	return false
+}
+
+func (x *ReturnResponse) GetRefundAmount() float64 {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return 0
+}
+
+func (x *ReturnResponse) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *ReturnResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ReturnResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ReturnResponse) GetRefundAmountMoney() *money.Money {
+	if x != nil {
+		return x.RefundAmountMoney
+	}
+	return nil
+}
+
+type ReturnsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Returns       []*ReturnResponse      `protobuf:"bytes,1,rep,name=returns,proto3" json:"returns,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnsResponse) Reset() {
+	*x = ReturnsResponse{}
+	mi := &file_proto_order_returns_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnsResponse) ProtoMessage() {}
+
+func (x *ReturnsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_returns_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnsResponse.ProtoReflect.Descriptor instead.
+func (*ReturnsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_returns_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReturnsResponse) GetReturns() []*ReturnResponse {
+	if x != nil {
+		return x.Returns
+	}
+	return nil
+}
+
+func (x *ReturnsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+var File_proto_order_returns_proto protoreflect.FileDescriptor
+
+const file_proto_order_returns_proto_rawDesc = "" +
+	"\n" +
+	"\x19proto/order/returns.proto\x12\x05order\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17proto/money/money.proto\"\xfa\x01\n" +
+	"\n" +
+	"ReturnItem\x12\"\n" +
+	"\rorder_item_id\x18\x01 \x01(\tR\vorderItemId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x01R\x05price\x12-\n" +
+	"\vprice_money\x18\x05 \x01(\v2\f.money.MoneyR\n" +
+	"priceMoney\x12(\n" +
+	"\bdiscount\x18\x06 \x01(\v2\f.money.MoneyR\bdiscount\x12\x1e\n" +
+	"\x03tax\x18\a \x01(\v2\f.money.MoneyR\x03tax\"r\n" +
+	"\x14RequestReturnRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12'\n" +
+	"\x05items\x18\x02 \x03(\v2\x11.order.ReturnItemR\x05items\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"!\n" +
+	"\x0fReturnIDRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"0\n" +
+	"\x13OrderReturnsRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"]\n" +
+	"\x12ListReturnsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\":\n" +
+	"\x14ResolveReturnRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04note\x18\x02 \x01(\tR\x04note\"@\n" +
+	"\x14ReceiveReturnRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\arestock\x18\x02 \x01(\bR\arestock\"n\n" +
+	"\x13RefundReturnRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12/\n" +
+	"\famount_money\x18\x03 \x01(\v2\f.money.MoneyR\vamountMoney\"\xec\x03\n" +
+	"\x0eReturnResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12'\n" +
+	"\x05items\x18\x06 \x03(\v2\x11.order.ReturnItemR\x05items\x12'\n" +
+	"\x0fresolution_note\x18\a \x01(\tR\x0eresolutionNote\x12\x1c\n" +
+	"\trestocked\x18\b \x01(\bR\trestocked\x12#\n" +
+	"\rrefund_amount\x18\t \x01(\x01R\frefundAmount\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\n" +
+	" \x01(\tR\tpaymentId\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12<\n" +
+	"\x13refund_amount_money\x18\r \x01(\v2\f.money.MoneyR\x11refundAmountMoney\"X\n" +
+	"\x0fReturnsResponse\x12/\n" +
+	"\areturns\x18\x01 \x03(\v2\x15.order.ReturnResponseR\areturns\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total2\xaa\x04\n" +
+	"\rReturnService\x12C\n" +
+	"\rRequestReturn\x12\x1b.order.RequestReturnRequest\x1a\x15.order.ReturnResponse\x12:\n" +
+	"\tGetReturn\x12\x16.order.ReturnIDRequest\x1a\x15.order.ReturnResponse\x12E\n" +
+	"\x0fGetOrderReturns\x12\x1a.order.OrderReturnsRequest\x1a\x16.order.ReturnsResponse\x12@\n" +
+	"\vListReturns\x12\x19.order.ListReturnsRequest\x1a\x16.order.ReturnsResponse\x12C\n" +
+	"\rApproveReturn\x12\x1b.order.ResolveReturnRequest\x1a\x15.order.ReturnResponse\x12B\n" +
+	"\fRejectReturn\x12\x1b.order.ResolveReturnRequest\x1a\x15.order.ReturnResponse\x12C\n" +
+	"\rReceiveReturn\x12\x1b.order.ReceiveReturnRequest\x1a\x15.order.ReturnResponse\x12A\n" +
+	"\fRefundReturn\x12\x1a.order.RefundReturnRequest\x1a\x15.order.ReturnResponseB\rZ\vproto/orderb\x06proto3"
+
+var (
+	file_proto_order_returns_proto_rawDescOnce sync.Once
+	file_proto_order_returns_proto_rawDescData []byte
+)
+
+func file_proto_order_returns_proto_rawDescGZIP() []byte {
+	file_proto_order_returns_proto_rawDescOnce.Do(func() {
+		file_proto_order_returns_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_order_returns_proto_rawDesc), len(file_proto_order_returns_proto_rawDesc)))
+	})
+	return file_proto_order_returns_proto_rawDescData
+}
+
+var file_proto_order_returns_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_proto_order_returns_proto_goTypes = []any{
+	(*ReturnItem)(nil),            // 0: order.ReturnItem
+	(*RequestReturnRequest)(nil),  // 1: order.RequestReturnRequest
+	(*ReturnIDRequest)(nil),       // 2: order.ReturnIDRequest
+	(*OrderReturnsRequest)(nil),   // 3: order.OrderReturnsRequest
+	(*ListReturnsRequest)(nil),    // 4: order.ListReturnsRequest
+	(*ResolveReturnRequest)(nil),  // 5: order.ResolveReturnRequest
+	(*ReceiveReturnRequest)(nil),  // 6: order.ReceiveReturnRequest
+	(*RefundReturnRequest)(nil),   // 7: order.RefundReturnRequest
+	(*ReturnResponse)(nil),        // 8: order.ReturnResponse
+	(*ReturnsResponse)(nil),       // 9: order.ReturnsResponse
+	(*money.Money)(nil),           // 10: money.Money
+	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+}
+var file_proto_order_returns_proto_depIdxs = []int32{
+	10, // 0: order.ReturnItem.price_money:type_name -> money.Money
+	10, // 1: order.ReturnItem.discount:type_name -> money.Money
+	10, // 2: order.ReturnItem.tax:type_name -> money.Money
+	0,  // 3: order.RequestReturnRequest.items:type_name -> order.ReturnItem
+	10, // 4: order.RefundReturnRequest.amount_money:type_name -> money.Money
+	0,  // 5: order.ReturnResponse.items:type_name -> order.ReturnItem
+	11, // 6: order.ReturnResponse.created_at:type_name -> google.protobuf.Timestamp
+	11, // 7: order.ReturnResponse.updated_at:type_name -> google.protobuf.Timestamp
+	10, // 8: order.ReturnResponse.refund_amount_money:type_name -> money.Money
+	8,  // 9: order.ReturnsResponse.returns:type_name -> order.ReturnResponse
+	1,  // 10: order.ReturnService.RequestReturn:input_type -> order.RequestReturnRequest
+	2,  // 11: order.ReturnService.GetReturn:input_type -> order.ReturnIDRequest
+	3,  // 12: order.ReturnService.GetOrderReturns:input_type -> order.OrderReturnsRequest
+	4,  // 13: order.ReturnService.ListReturns:input_type -> order.ListReturnsRequest
+	5,  // 14: order.ReturnService.ApproveReturn:input_type -> order.ResolveReturnRequest
+	5,  // 15: order.ReturnService.RejectReturn:input_type -> order.ResolveReturnRequest
+	6,  // 16: order.ReturnService.ReceiveReturn:input_type -> order.ReceiveReturnRequest
+	7,  // 17: order.ReturnService.RefundReturn:input_type -> order.RefundReturnRequest
+	8,  // 18: order.ReturnService.RequestReturn:output_type -> order.ReturnResponse
+	8,  // 19: order.ReturnService.GetReturn:output_type -> order.ReturnResponse
+	9,  // 20: order.ReturnService.GetOrderReturns:output_type -> order.ReturnsResponse
+	9,  // 21: order.ReturnService.ListReturns:output_type -> order.ReturnsResponse
+	8,  // 22: order.ReturnService.ApproveReturn:output_type -> order.ReturnResponse
+	8,  // 23: order.ReturnService.RejectReturn:output_type -> order.ReturnResponse
+	8,  // 24: order.ReturnService.ReceiveReturn:output_type -> order.ReturnResponse
+	8,  // 25: order.ReturnService.RefundReturn:output_type -> order.ReturnResponse
+	18, // [18:26] is the sub-list for method output_type
+	10, // [10:18] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_proto_order_returns_proto_init() }
+func file_proto_order_returns_proto_init() {
+	if File_proto_order_returns_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_order_returns_proto_rawDesc), len(file_proto_order_returns_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_order_returns_proto_goTypes,
+		DependencyIndexes: file_proto_order_returns_proto_depIdxs,
+		MessageInfos:      file_proto_order_returns_proto_msgTypes,
+	}.Build()
+	File_proto_order_returns_proto = out.File
+	file_proto_order_returns_proto_goTypes = nil
+	file_proto_order_returns_proto_depIdxs = nil
+}
@@ -51,9 +51,11 @@ func main() {
 
 	// Initialize repositories
 	userRepo := repository.NewPostgresUserRepository(db)
+	addressRepo := repository.NewPostgresAddressRepository(db)
 
 	// Initialize services with cache
 	userService := service.NewUserService(userRepo, cfg.Auth.Secret, cfg.Auth.ExpiryMinutes, redisCache)
+	addressService := service.NewAddressService(addressRepo)
 
 	// Initialize gRPC server
 	lis, err := net.Listen("tcp", ":"+cfg.Server.GrpcPort)
@@ -67,6 +69,10 @@ func main() {
 	userHandler := handler.NewUserGrpcHandler(userService)
 	user.RegisterUserServiceServer(grpcServer, userHandler)
 
+	// Register address service handler
+	addressHandler := handler.NewAddressGrpcHandler(addressService)
+	user.RegisterAddressServiceServer(grpcServer, addressHandler)
+
 	// Enable reflection for tools like grpcurl
 	reflection.Register(grpcServer)
 
@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"time"
+)
+
+// Address is an entry in a user's address book. Orders keep a copy of the address they
+// ship to, so changing an address does not change past orders.
+type Address struct {
+	ID            string    `json:"id"`
+	UserID        string    `json:"user_id"`
+	Label         string    `json:"label"`
+	RecipientName string    `json:"recipient_name"`
+	Line1         string    `json:"line1"`
+	Line2         string    `json:"line2"`
+	City          string    `json:"city"`
+	Region        string    `json:"region"`
+	PostalCode    string    `json:"postal_code"`
+	Country       string    `json:"country"`
+	Phone         string    `json:"phone"`
+	IsDefault     bool      `json:"is_default"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
@@ -0,0 +1,152 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	pb "proto/user"
+	"user-service/internal/domain"
+	"user-service/internal/repository"
+	"user-service/internal/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type AddressGrpcHandler struct {
+	pb.UnimplementedAddressServiceServer
+	addressService service.AddressService
+}
+
+func NewAddressGrpcHandler(addressService service.AddressService) *AddressGrpcHandler {
+	return &AddressGrpcHandler{
+		addressService: addressService,
+	}
+}
+
+func (h *AddressGrpcHandler) AddAddress(ctx context.Context, req *pb.AddressRequest) (*pb.Address, error) {
+	log.Printf("Received AddAddress request for user ID: %s", req.UserId)
+
+	address, err := h.addressService.AddAddress(ctx, mapAddressFromProto(req))
+	if err != nil {
+		log.Printf("Failed to add address: %v", err)
+		return nil, addressError("add address", err)
+	}
+
+	return mapAddressToProto(address), nil
+}
+
+func (h *AddressGrpcHandler) GetAddress(ctx context.Context, req *pb.AddressIDRequest) (*pb.Address, error) {
+	log.Printf("Received GetAddress request for user ID: %s", req.UserId)
+
+	address, err := h.addressService.GetAddress(ctx, req.UserId, req.Id)
+	if err != nil {
+		log.Printf("Failed to get address: %v", err)
+		return nil, addressError("get address", err)
+	}
+
+	return mapAddressToProto(address), nil
+}
+
+func (h *AddressGrpcHandler) ListAddresses(ctx context.Context, req *pb.ListAddressesRequest) (*pb.ListAddressesResponse, error) {
+	log.Printf("Received ListAddresses request for user ID: %s", req.UserId)
+
+	addresses, err := h.addressService.ListAddresses(ctx, req.UserId)
+	if err != nil {
+		log.Printf("Failed to list addresses: %v", err)
+		return nil, addressError("list addresses", err)
+	}
+
+	response := &pb.ListAddressesResponse{}
+	for _, address := range addresses {
+		response.Addresses = append(response.Addresses, mapAddressToProto(address))
+	}
+
+	return response, nil
+}
+
+func (h *AddressGrpcHandler) UpdateAddress(ctx context.Context, req *pb.AddressRequest) (*pb.Address, error) {
+	log.Printf("Received UpdateAddress request for address ID: %s", req.Id)
+
+	address, err := h.addressService.UpdateAddress(ctx, mapAddressFromProto(req))
+	if err != nil {
+		log.Printf("Failed to update address: %v", err)
+		return nil, addressError("update address", err)
+	}
+
+	return mapAddressToProto(address), nil
+}
+
+func (h *AddressGrpcHandler) DeleteAddress(ctx context.Context, req *pb.AddressIDRequest) (*pb.DeleteAddressResponse, error) {
+	log.Printf("Received DeleteAddress request for address ID: %s", req.Id)
+
+	if err := h.addressService.DeleteAddress(ctx, req.UserId, req.Id); err != nil {
+		log.Printf("Failed to delete address: %v", err)
+		return nil, addressError("delete address", err)
+	}
+
+	return &pb.DeleteAddressResponse{
+		Success: true,
+		Message: "Address deleted successfully",
+	}, nil
+}
+
+func (h *AddressGrpcHandler) SetDefault(ctx context.Context, req *pb.AddressIDRequest) (*pb.Address, error) {
+	log.Printf("Received SetDefault request for address ID: %s", req.Id)
+
+	address, err := h.addressService.SetDefault(ctx, req.UserId, req.Id)
+	if err != nil {
+		log.Printf("Failed to set default address: %v", err)
+		return nil, addressError("set default address", err)
+	}
+
+	return mapAddressToProto(address), nil
+}
+
+func addressError(action string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidAddress):
+		return status.Errorf(codes.InvalidArgument, "failed to %s: %v", action, err)
+	case errors.Is(err, repository.ErrAddressNotFound):
+		return status.Errorf(codes.NotFound, "failed to %s: %v", action, err)
+	}
+	return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+}
+
+func mapAddressFromProto(req *pb.AddressRequest) domain.Address {
+	return domain.Address{
+		ID:            req.Id,
+		UserID:        req.UserId,
+		Label:         req.Label,
+		RecipientName: req.RecipientName,
+		Line1:         req.Line1,
+		Line2:         req.Line2,
+		City:          req.City,
+		Region:        req.Region,
+		PostalCode:    req.PostalCode,
+		Country:       req.Country,
+		Phone:         req.Phone,
+		IsDefault:     req.IsDefault,
+	}
+}
+
+func mapAddressToProto(address domain.Address) *pb.Address {
+	return &pb.Address{
+		Id:            address.ID,
+		UserId:        address.UserID,
+		Label:         address.Label,
+		RecipientName: address.RecipientName,
+		Line1:         address.Line1,
+		Line2:         address.Line2,
+		City:          address.City,
+		Region:        address.Region,
+		PostalCode:    address.PostalCode,
+		Country:       address.Country,
+		Phone:         address.Phone,
+		IsDefault:     address.IsDefault,
+		CreatedAt:     timestamppb.New(address.CreatedAt),
+		UpdatedAt:     timestamppb.New(address.UpdatedAt),
+	}
+}
@@ -0,0 +1,326 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"user-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+var ErrAddressNotFound = errors.New("address not found")
+
+type AddressRepository interface {
+	// Create adds the address, making it the default if it asks to be or is the user's first
+	Create(ctx context.Context, address domain.Address) (domain.Address, error)
+	GetByID(ctx context.Context, userID, id string) (domain.Address, error)
+	GetDefault(ctx context.Context, userID string) (domain.Address, error)
+	// List returns the user's addresses, the default one first
+	List(ctx context.Context, userID string) ([]domain.Address, error)
+	// Update changes the address; it becomes the default if it asks to, but an update never
+	// takes the default away
+	Update(ctx context.Context, address domain.Address) (domain.Address, error)
+	// Delete removes the address; if it was the default, the most recently changed remaining
+	// address takes its place
+	Delete(ctx context.Context, userID, id string) error
+	SetDefault(ctx context.Context, userID, id string) (domain.Address, error)
+}
+
+type PostgresAddressRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresAddressRepository(db *sql.DB) AddressRepository {
+	return &PostgresAddressRepository{
+		db: db,
+	}
+}
+
+const addressColumns = `id, user_id, COALESCE(label, ''), recipient_name, line1, COALESCE(line2, ''), city,
+		       COALESCE(region, ''), postal_code, country, COALESCE(phone, ''), is_default, created_at, updated_at`
+
+func (r *PostgresAddressRepository) Create(ctx context.Context, address domain.Address) (domain.Address, error) {
+	if address.UserID == "" {
+		return domain.Address{}, errors.New("user ID is required")
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.Address{}, errors.New("failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	var hasAddresses bool
+	err = tx.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM addresses WHERE user_id = $1)`, address.UserID).Scan(&hasAddresses)
+	if err != nil {
+		return domain.Address{}, errors.New("failed to check existing addresses")
+	}
+
+	if !hasAddresses {
+		address.IsDefault = true
+	}
+	if address.IsDefault {
+		if err := clearDefaultAddress(ctx, tx, address.UserID); err != nil {
+			return domain.Address{}, err
+		}
+	}
+
+	address.ID = uuid.New().String()
+	address.CreatedAt = time.Now()
+	address.UpdatedAt = address.CreatedAt
+
+	query := `
+		INSERT INTO addresses (id, user_id, label, recipient_name, line1, line2, city, region, postal_code,
+		                       country, phone, is_default, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)`
+
+	_, err = tx.ExecContext(
+		ctx,
+		query,
+		address.ID,
+		address.UserID,
+		nullString(address.Label),
+		address.RecipientName,
+		address.Line1,
+		nullString(address.Line2),
+		address.City,
+		nullString(address.Region),
+		address.PostalCode,
+		address.Country,
+		nullString(address.Phone),
+		address.IsDefault,
+		address.CreatedAt,
+		address.UpdatedAt,
+	)
+	if err != nil {
+		return domain.Address{}, errors.New("failed to create address")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.Address{}, errors.New("failed to commit transaction")
+	}
+
+	return address, nil
+}
+
+func (r *PostgresAddressRepository) GetByID(ctx context.Context, userID, id string) (domain.Address, error) {
+	if userID == "" || id == "" {
+		return domain.Address{}, errors.New("user ID and address ID are required")
+	}
+
+	query := `SELECT ` + addressColumns + ` FROM addresses WHERE id = $1 AND user_id = $2`
+
+	return scanAddress(r.db.QueryRowContext(ctx, query, id, userID))
+}
+
+func (r *PostgresAddressRepository) GetDefault(ctx context.Context, userID string) (domain.Address, error) {
+	if userID == "" {
+		return domain.Address{}, errors.New("user ID is required")
+	}
+
+	query := `SELECT ` + addressColumns + ` FROM addresses WHERE user_id = $1 AND is_default`
+
+	return scanAddress(r.db.QueryRowContext(ctx, query, userID))
+}
+
+func (r *PostgresAddressRepository) List(ctx context.Context, userID string) ([]domain.Address, error) {
+	if userID == "" {
+		return nil, errors.New("user ID is required")
+	}
+
+	query := `
+		SELECT ` + addressColumns + `
+		FROM addresses
+		WHERE user_id = $1
+		ORDER BY is_default DESC, created_at`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, errors.New("failed to list addresses")
+	}
+	defer rows.Close()
+
+	var addresses []domain.Address
+	for rows.Next() {
+		address, err := scanAddress(rows)
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, address)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.New("failed to list addresses")
+	}
+
+	return addresses, nil
+}
+
+func (r *PostgresAddressRepository) Update(ctx context.Context, address domain.Address) (domain.Address, error) {
+	if address.UserID == "" || address.ID == "" {
+		return domain.Address{}, errors.New("user ID and address ID are required")
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.Address{}, errors.New("failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	if address.IsDefault {
+		if err := clearDefaultAddress(ctx, tx, address.UserID); err != nil {
+			return domain.Address{}, err
+		}
+	}
+
+	query := `
+		UPDATE addresses
+		SET label = $1, recipient_name = $2, line1 = $3, line2 = $4, city = $5, region = $6,
+		    postal_code = $7, country = $8, phone = $9, is_default = is_default OR $10, updated_at = $11
+		WHERE id = $12 AND user_id = $13
+		RETURNING ` + addressColumns
+
+	updated, err := scanAddress(tx.QueryRowContext(
+		ctx,
+		query,
+		nullString(address.Label),
+		address.RecipientName,
+		address.Line1,
+		nullString(address.Line2),
+		address.City,
+		nullString(address.Region),
+		address.PostalCode,
+		address.Country,
+		nullString(address.Phone),
+		address.IsDefault,
+		time.Now(),
+		address.ID,
+		address.UserID,
+	))
+	if err != nil {
+		return domain.Address{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.Address{}, errors.New("failed to commit transaction")
+	}
+
+	return updated, nil
+}
+
+func (r *PostgresAddressRepository) Delete(ctx context.Context, userID, id string) error {
+	if userID == "" || id == "" {
+		return errors.New("user ID and address ID are required")
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.New("failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	var wasDefault bool
+	err = tx.QueryRowContext(ctx,
+		`DELETE FROM addresses WHERE id = $1 AND user_id = $2 RETURNING is_default`,
+		id, userID,
+	).Scan(&wasDefault)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrAddressNotFound
+		}
+		return errors.New("failed to delete address")
+	}
+
+	if wasDefault {
+		query := `
+			UPDATE addresses SET is_default = TRUE
+			WHERE id = (SELECT id FROM addresses WHERE user_id = $1 ORDER BY updated_at DESC LIMIT 1)`
+
+		if _, err := tx.ExecContext(ctx, query, userID); err != nil {
+			return errors.New("failed to set default address")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.New("failed to commit transaction")
+	}
+
+	return nil
+}
+
+func (r *PostgresAddressRepository) SetDefault(ctx context.Context, userID, id string) (domain.Address, error) {
+	if userID == "" || id == "" {
+		return domain.Address{}, errors.New("user ID and address ID are required")
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.Address{}, errors.New("failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	if err := clearDefaultAddress(ctx, tx, userID); err != nil {
+		return domain.Address{}, err
+	}
+
+	query := `
+		UPDATE addresses SET is_default = TRUE, updated_at = $1
+		WHERE id = $2 AND user_id = $3
+		RETURNING ` + addressColumns
+
+	address, err := scanAddress(tx.QueryRowContext(ctx, query, time.Now(), id, userID))
+	if err != nil {
+		return domain.Address{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.Address{}, errors.New("failed to commit transaction")
+	}
+
+	return address, nil
+}
+
+func clearDefaultAddress(ctx context.Context, tx *sql.Tx, userID string) error {
+	if _, err := tx.ExecContext(ctx, `UPDATE addresses SET is_default = FALSE WHERE user_id = $1 AND is_default`, userID); err != nil {
+		return errors.New("failed to clear default address")
+	}
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanAddress(row rowScanner) (domain.Address, error) {
+	var address domain.Address
+	err := row.Scan(
+		&address.ID,
+		&address.UserID,
+		&address.Label,
+		&address.RecipientName,
+		&address.Line1,
+		&address.Line2,
+		&address.City,
+		&address.Region,
+		&address.PostalCode,
+		&address.Country,
+		&address.Phone,
+		&address.IsDefault,
+		&address.CreatedAt,
+		&address.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.Address{}, ErrAddressNotFound
+		}
+		return domain.Address{}, errors.New("failed to scan address")
+	}
+	return address, nil
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"user-service/internal/domain"
+	"user-service/internal/repository"
+)
+
+// ErrInvalidAddress is returned for addresses missing a required field.
+var ErrInvalidAddress = errors.New("invalid address")
+
+// maxAddresses caps the size of an address book.
+const maxAddresses = 20
+
+type AddressService interface {
+	AddAddress(ctx context.Context, address domain.Address) (domain.Address, error)
+	// GetAddress returns one of the user's addresses, or the default one if id is empty
+	GetAddress(ctx context.Context, userID, id string) (domain.Address, error)
+	ListAddresses(ctx context.Context, userID string) ([]domain.Address, error)
+	UpdateAddress(ctx context.Context, address domain.Address) (domain.Address, error)
+	DeleteAddress(ctx context.Context, userID, id string) error
+	SetDefault(ctx context.Context, userID, id string) (domain.Address, error)
+}
+
+type addressService struct {
+	addressRepo repository.AddressRepository
+}
+
+func NewAddressService(addressRepo repository.AddressRepository) AddressService {
+	return &addressService{
+		addressRepo: addressRepo,
+	}
+}
+
+func (s *addressService) AddAddress(ctx context.Context, address domain.Address) (domain.Address, error) {
+	address, err := normalizeAddress(address)
+	if err != nil {
+		return domain.Address{}, err
+	}
+
+	addresses, err := s.addressRepo.List(ctx, address.UserID)
+	if err != nil {
+		return domain.Address{}, err
+	}
+	if len(addresses) >= maxAddresses {
+		return domain.Address{}, fmt.Errorf("%w: an address book holds at most %d addresses", ErrInvalidAddress, maxAddresses)
+	}
+
+	return s.addressRepo.Create(ctx, address)
+}
+
+func (s *addressService) GetAddress(ctx context.Context, userID, id string) (domain.Address, error) {
+	if id == "" {
+		return s.addressRepo.GetDefault(ctx, userID)
+	}
+	return s.addressRepo.GetByID(ctx, userID, id)
+}
+
+func (s *addressService) ListAddresses(ctx context.Context, userID string) ([]domain.Address, error) {
+	return s.addressRepo.List(ctx, userID)
+}
+
+func (s *addressService) UpdateAddress(ctx context.Context, address domain.Address) (domain.Address, error) {
+	address, err := normalizeAddress(address)
+	if err != nil {
+		return domain.Address{}, err
+	}
+	return s.addressRepo.Update(ctx, address)
+}
+
+func (s *addressService) DeleteAddress(ctx context.Context, userID, id string) error {
+	return s.addressRepo.Delete(ctx, userID, id)
+}
+
+func (s *addressService) SetDefault(ctx context.Context, userID, id string) (domain.Address, error) {
+	return s.addressRepo.SetDefault(ctx, userID, id)
+}
+
+// normalizeAddress trims the address and checks that it has the fields needed to deliver to it.
+func normalizeAddress(address domain.Address) (domain.Address, error) {
+	for _, field := range []*string{&address.Label, &address.RecipientName, &address.Line1, &address.Line2,
+		&address.City, &address.Region, &address.PostalCode, &address.Country, &address.Phone} {
+		*field = strings.TrimSpace(*field)
+	}
+	address.Country = strings.ToUpper(address.Country)
+
+	switch {
+	case address.RecipientName == "":
+		return domain.Address{}, fmt.Errorf("%w: recipient name is required", ErrInvalidAddress)
+	case address.Line1 == "":
+		return domain.Address{}, fmt.Errorf("%w: address line 1 is required", ErrInvalidAddress)
+	case address.City == "":
+		return domain.Address{}, fmt.Errorf("%w: city is required", ErrInvalidAddress)
+	case address.PostalCode == "":
+		return domain.Address{}, fmt.Errorf("%w: postal code is required", ErrInvalidAddress)
+	case !isCountryCode(address.Country):
+		return domain.Address{}, fmt.Errorf("%w: country must be a two-letter ISO code", ErrInvalidAddress)
+	}
+
+	return address, nil
+}
+
+func isCountryCode(country string) bool {
+	if len(country) != 2 {
+		return false
+	}
+	for _, r := range country {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}